@@ -1,6 +1,7 @@
 package main
 
 import (
+	"encoding/json"
 	"flag"
 	"fmt"
 	"os"
@@ -10,17 +11,24 @@ import (
 	log "github.com/sirupsen/logrus"
 
 	"github.com/joho/godotenv"
-	dbsqlite "github.com/talesmud/talesmud/pkg/db/sqlite"
 	"github.com/talesmud/talesmud/pkg/importer"
 	"github.com/talesmud/talesmud/pkg/repository"
 	"github.com/talesmud/talesmud/pkg/server"
+	"github.com/talesmud/talesmud/pkg/validator"
 )
 
 func main() {
 	// Parse command-line flags
 	importFolder := flag.String("import", "", "Import world data from folder (e.g., mvp-rpg-1)")
-	verbose := flag.Bool("verbose", false, "Enable verbose output during import")
+	exportFolder := flag.String("export", "", "Export world data to folder in the import layout (e.g., mvp-rpg-1)")
+	verbose := flag.Bool("verbose", false, "Enable verbose output during import or export")
 	dryRun := flag.Bool("dry-run", false, "Validate import data without making changes")
+	merge := flag.Bool("merge", false, "Update world data by ID and keep entities missing in the import folder")
+	prune := flag.Bool("prune", false, "With -merge, delete stored entities missing in the import folder")
+	dbDriver := flag.String("db", "", "Database backend: sqlite or postgres (overrides DB_DRIVER)")
+	validate := flag.Bool("validate", false, "Check the stored world for dangling references, unreachable rooms, one-way exits and orphaned templates")
+	jsonOutput := flag.Bool("json", false, "With -validate, print the report as JSON")
+	startRoom := flag.String("start-room", validator.DefaultStartRoomID, "With -validate, the room reachability is checked from")
 	flag.Parse()
 
 	// Load .env file
@@ -41,27 +49,40 @@ func main() {
 	}
 	log.SetLevel(level)
 
-	// Get SQLite path
-	sqlitePath := os.Getenv("SQLITE_PATH")
-	if sqlitePath == "" {
-		sqlitePath = "talesmud.db"
+	// Database configuration, the flag takes precedence over DB_DRIVER
+	dbConfig := repository.ConfigFromEnv()
+	if *dbDriver != "" {
+		dbConfig.Driver = strings.ToLower(*dbDriver)
+		// the server reads its configuration from the environment
+		os.Setenv("DB_DRIVER", dbConfig.Driver)
+	}
+
+	if *validate {
+		runValidate(dbConfig, *startRoom, *jsonOutput)
+		return
 	}
 
 	// Handle import command
 	if *importFolder != "" {
-		runImport(*importFolder, sqlitePath, *verbose, *dryRun)
+		runImport(*importFolder, dbConfig, *verbose, *dryRun, *merge, *prune)
+		return
+	}
+
+	// Handle export command
+	if *exportFolder != "" {
+		runExport(*exportFolder, dbConfig, *verbose)
 		return
 	}
 
 	// Start the server
 	fmt.Println("Starting tales server...")
-	fmt.Printf("SQLite database: %v\n", sqlitePath)
+	fmt.Printf("Database: %v\n", dbConfig)
 
 	srv := server.NewApp()
 	srv.Run()
 }
 
-func runImport(folderName, sqlitePath string, verbose, dryRun bool) {
+func runImport(folderName string, dbConfig repository.Config, verbose, dryRun, merge, prune bool) {
 	importPath := filepath.Join("import", folderName)
 
 	// Validate import folder exists
@@ -73,24 +94,25 @@ func runImport(folderName, sqlitePath string, verbose, dryRun bool) {
 	fmt.Println("TalesMUD World Importer")
 	fmt.Println("===========================================")
 	fmt.Printf("Import folder: %s\n", importPath)
-	fmt.Printf("Database: %s\n", sqlitePath)
+	fmt.Printf("Database: %s\n", dbConfig)
 	fmt.Printf("Verbose: %v\n", verbose)
 	fmt.Printf("Dry-run: %v\n", dryRun)
+	fmt.Printf("Merge: %v (prune: %v)\n", merge, prune)
 	fmt.Println("-------------------------------------------")
 
 	// Initialize database
-	client, err := dbsqlite.Open(sqlitePath)
+	repos, err := repository.Open(dbConfig)
 	if err != nil {
 		log.Fatalf("Failed to open database: %v", err)
 	}
-	defer client.Close()
-
-	repos := repository.NewSQLiteFactory(client)
+	defer repos.Close()
 
 	// Create and run importer
 	imp := importer.New(repos, importPath)
 	imp.SetVerbose(verbose)
 	imp.SetDryRun(dryRun)
+	imp.SetMerge(merge)
+	imp.SetPrune(prune)
 
 	result, err := imp.Import()
 	if err != nil {
@@ -105,6 +127,8 @@ func runImport(folderName, sqlitePath string, verbose, dryRun bool) {
 	fmt.Printf("  Loot Tables: %d\n", result.LootTablesImported)
 	fmt.Printf("  NPCs:        %d\n", result.NPCsImported)
 	fmt.Printf("  Dialogs:     %d\n", result.DialogsImported)
+	fmt.Printf("  Quests:      %d\n", result.QuestsImported)
+	fmt.Printf("  Skills:      %d\n", result.SkillsImported)
 	fmt.Printf("  Rooms:       %d\n", result.RoomsImported)
 	fmt.Printf("  Assets:      %d\n", result.AssetsImported)
 	fmt.Printf("  Characters:  %d relocated\n", result.CharactersRelocated)
@@ -113,6 +137,17 @@ func runImport(folderName, sqlitePath string, verbose, dryRun bool) {
 	if result.Backup != "" {
 		fmt.Printf("  Backup:      %s\n", result.Backup)
 	}
+	if !dryRun && result.ScriptsImported > 0 {
+		fmt.Println("  Note:        a server that is already running loads the imported event scripts on restart")
+	}
+
+	if dryRun || merge {
+		printChanges(result.Changes)
+	}
+	if result.Validation != nil {
+		fmt.Println("-------------------------------------------")
+		printValidation(result.Validation)
+	}
 
 	if len(result.Errors) > 0 {
 		fmt.Println("-------------------------------------------")
@@ -132,3 +167,133 @@ func runImport(folderName, sqlitePath string, verbose, dryRun bool) {
 		os.Exit(1)
 	}
 }
+
+func runExport(folderName string, dbConfig repository.Config, verbose bool) {
+	exportPath := filepath.Join("import", folderName)
+
+	fmt.Println("===========================================")
+	fmt.Println("TalesMUD World Exporter")
+	fmt.Println("===========================================")
+	fmt.Printf("Export folder: %s\n", exportPath)
+	fmt.Printf("Database: %s\n", dbConfig)
+	fmt.Printf("Verbose: %v\n", verbose)
+	fmt.Println("-------------------------------------------")
+
+	repos, err := repository.Open(dbConfig)
+	if err != nil {
+		log.Fatalf("Failed to open database: %v", err)
+	}
+	defer repos.Close()
+
+	exp := importer.NewExporter(repos, exportPath)
+	exp.SetVerbose(verbose)
+
+	result, err := exp.Export()
+	if err != nil {
+		log.Fatalf("Export failed: %v", err)
+	}
+
+	fmt.Println("-------------------------------------------")
+	fmt.Println("Export Results:")
+	fmt.Printf("  Scripts:     %d\n", result.ScriptsExported)
+	fmt.Printf("  Items:       %d\n", result.ItemsExported)
+	fmt.Printf("  Loot Tables: %d\n", result.LootTablesExported)
+	fmt.Printf("  NPCs:        %d\n", result.NPCsExported)
+	fmt.Printf("  Dialogs:     %d\n", result.DialogsExported)
+	fmt.Printf("  Quests:      %d\n", result.QuestsExported)
+	fmt.Printf("  Skills:      %d\n", result.SkillsExported)
+	fmt.Printf("  Rooms:       %d\n", result.RoomsExported)
+	fmt.Printf("  Assets:      %d\n", result.AssetsExported)
+	fmt.Printf("  Duration:    %v\n", result.Duration)
+
+	if len(result.Errors) > 0 {
+		fmt.Println("-------------------------------------------")
+		fmt.Printf("Errors (%d):\n", len(result.Errors))
+		for _, e := range result.Errors {
+			fmt.Printf("  - %s\n", e)
+		}
+	}
+
+	fmt.Println("===========================================")
+	if len(result.Errors) == 0 {
+		fmt.Println("Export completed successfully!")
+	} else {
+		fmt.Println("Export completed with errors.")
+		os.Exit(1)
+	}
+}
+
+func runValidate(dbConfig repository.Config, startRoomID string, jsonOutput bool) {
+	repos, err := repository.Open(dbConfig)
+	if err != nil {
+		log.Fatalf("Failed to open database: %v", err)
+	}
+	defer repos.Close()
+
+	world, err := validator.Load(repos)
+	if err != nil {
+		log.Fatalf("Validation failed: %v", err)
+	}
+	report := validator.Validate(world, startRoomID)
+
+	if jsonOutput {
+		data, err := json.MarshalIndent(report, "", "  ")
+		if err != nil {
+			log.Fatalf("Failed to encode report: %v", err)
+		}
+		fmt.Println(string(data))
+	} else {
+		fmt.Println("===========================================")
+		fmt.Println("TalesMUD World Validator")
+		fmt.Println("===========================================")
+		fmt.Printf("Database: %s\n", dbConfig)
+		fmt.Println("-------------------------------------------")
+		printValidation(report)
+		fmt.Println("===========================================")
+	}
+
+	if report.HasErrors() {
+		os.Exit(1)
+	}
+}
+
+// printValidation prints the issues of a validation report, one per line
+func printValidation(report *validator.Report) {
+	fmt.Printf("Validation: %d errors, %d warnings, %d infos\n", report.Errors, report.Warnings, report.Infos)
+	for _, issue := range report.Issues {
+		name := ""
+		if issue.Name != "" {
+			name = " (" + issue.Name + ")"
+		}
+		fmt.Printf("  [%-7s] %s %s%s: %s\n", issue.Severity, issue.Kind, issue.ID, name, issue.Message)
+	}
+}
+
+// printChanges prints the per-entity diff of the import, kept entities are only counted
+func printChanges(changes []importer.Change) {
+	fmt.Println("-------------------------------------------")
+	fmt.Println("Changes:")
+
+	kept := 0
+	for _, c := range changes {
+		var marker string
+		switch c.Type {
+		case importer.ChangeAdded:
+			marker = "+"
+		case importer.ChangeChanged:
+			marker = "~"
+		case importer.ChangeRemoved:
+			marker = "-"
+		default:
+			kept++
+			continue
+		}
+		fmt.Printf("  %s %-10s %-12s %s\n", marker, c.Kind, c.ID, c.Name)
+	}
+	if len(changes) == kept {
+		fmt.Println("  (none)")
+	}
+	if kept > 0 {
+		fmt.Printf("  %d stored entities not in the import folder are kept\n", kept)
+	}
+}
@@ -0,0 +1,50 @@
+package db
+
+import "database/sql"
+
+// Collections lists the tables used by the repositories, every table stores one JSON document per entity.
+var Collections = []string{
+	"users",
+	"characters",
+	"charactertemplates",
+	"rooms",
+	"items",
+	"scripts",
+	"npcs",
+	"npc_spawners",
+	"dialogs",
+	"conversations",
+	"parties",
+	"loot_tables",
+	"quests",
+	"skills",
+	"server_settings",
+	"world_snapshots",
+	"channels",
+	"mail",
+	"audit_log",
+}
+
+// Dialect describes the SQL differences between the supported database backends.
+type Dialect interface {
+	// Name returns the name of the backend, e.g. sqlite or postgres.
+	Name() string
+	// Placeholder returns the bind variable for the n-th query argument, starting at 1.
+	Placeholder(n int) string
+	// JSONField returns an expression extracting a field of the data document as text,
+	// path is the placeholder bound to the JSONPath argument.
+	JSONField(path string) string
+	// JSONPath converts a dotted field key into the path argument used by JSONField.
+	JSONPath(key string) interface{}
+	// JSONValue converts a query value into an argument comparable to JSONField.
+	JSONValue(value interface{}) interface{}
+	// Upsert returns a statement inserting or replacing the document with the given id.
+	Upsert(table string) string
+}
+
+// Client is a database connection used by the repositories.
+type Client interface {
+	DB() *sql.DB
+	Dialect() Dialect
+	Close() error
+}
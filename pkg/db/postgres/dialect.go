@@ -0,0 +1,44 @@
+package postgres
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/talesmud/talesmud/pkg/db"
+)
+
+// Dialect implements db.Dialect using the JSONB operators.
+type Dialect struct{}
+
+// Name returns the backend name.
+func (Dialect) Name() string { return "postgres" }
+
+// Placeholder returns a numbered bind variable like $1.
+func (Dialect) Placeholder(n int) string { return fmt.Sprintf("$%d", n) }
+
+// JSONField extracts a field of the data document as text.
+func (Dialect) JSONField(path string) string { return fmt.Sprintf("(data #>> %s::text[])", path) }
+
+// JSONPath converts a dotted key into a text array literal like {key,sub}.
+func (Dialect) JSONPath(key string) interface{} {
+	return "{" + strings.ReplaceAll(key, ".", ",") + "}"
+}
+
+// JSONValue converts the value to the text representation returned by #>>.
+func (Dialect) JSONValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case nil:
+		return nil
+	case string:
+		return v
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// Upsert returns an INSERT ... ON CONFLICT statement.
+func (Dialect) Upsert(table string) string {
+	return fmt.Sprintf("INSERT INTO %s (id, data) VALUES ($1, $2) ON CONFLICT (id) DO UPDATE SET data = EXCLUDED.data", table)
+}
+
+var _ db.Dialect = Dialect{}
@@ -0,0 +1,63 @@
+package postgres
+
+import (
+	"database/sql"
+	"fmt"
+
+	_ "github.com/lib/pq"
+
+	"github.com/talesmud/talesmud/pkg/db"
+)
+
+// Client wraps a PostgreSQL connection pool and handles initialization.
+type Client struct {
+	db *sql.DB
+}
+
+// Open connects to a PostgreSQL database, dsn is a URL or key=value connection string.
+func Open(dsn string) (*Client, error) {
+	conn, err := sql.Open("postgres", dsn)
+	if err != nil {
+		return nil, err
+	}
+	conn.SetMaxOpenConns(10)
+	conn.SetMaxIdleConns(5)
+
+	if err := conn.Ping(); err != nil {
+		_ = conn.Close()
+		return nil, fmt.Errorf("postgres connection failed: %w", err)
+	}
+
+	client := &Client{db: conn}
+	if err := client.InitSchema(); err != nil {
+		_ = conn.Close()
+		return nil, err
+	}
+	return client, nil
+}
+
+// DB returns the underlying sql.DB.
+func (c *Client) DB() *sql.DB {
+	return c.db
+}
+
+// Dialect returns the PostgreSQL SQL dialect.
+func (c *Client) Dialect() db.Dialect {
+	return Dialect{}
+}
+
+// Close closes the connection pool.
+func (c *Client) Close() error {
+	return c.db.Close()
+}
+
+// InitSchema creates the tables used by the repositories, documents are stored as JSONB.
+func (c *Client) InitSchema() error {
+	for _, table := range db.Collections {
+		stmt := fmt.Sprintf("CREATE TABLE IF NOT EXISTS %s (id TEXT PRIMARY KEY, data JSONB NOT NULL);", table)
+		if _, err := c.db.Exec(stmt); err != nil {
+			return fmt.Errorf("postgres schema init failed: %w", err)
+		}
+	}
+	return nil
+}
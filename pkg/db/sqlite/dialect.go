@@ -0,0 +1,32 @@
+package sqlite
+
+import (
+	"fmt"
+
+	"github.com/talesmud/talesmud/pkg/db"
+)
+
+// Dialect implements db.Dialect using the JSON1 extension.
+type Dialect struct{}
+
+// Name returns the backend name.
+func (Dialect) Name() string { return "sqlite" }
+
+// Placeholder returns a positional bind variable.
+func (Dialect) Placeholder(n int) string { return "?" }
+
+// JSONField extracts a field of the data document.
+func (Dialect) JSONField(path string) string { return fmt.Sprintf("json_extract(data, %s)", path) }
+
+// JSONPath converts a dotted key into a JSON path like $.key.
+func (Dialect) JSONPath(key string) interface{} { return "$." + key }
+
+// JSONValue returns the value unchanged, json_extract returns native types.
+func (Dialect) JSONValue(value interface{}) interface{} { return value }
+
+// Upsert returns an INSERT OR REPLACE statement.
+func (Dialect) Upsert(table string) string {
+	return fmt.Sprintf("INSERT OR REPLACE INTO %s (id, data) VALUES (?, ?)", table)
+}
+
+var _ db.Dialect = Dialect{}
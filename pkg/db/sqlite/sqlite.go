@@ -7,6 +7,8 @@ import (
 
 	log "github.com/sirupsen/logrus"
 	_ "modernc.org/sqlite"
+
+	"github.com/talesmud/talesmud/pkg/db"
 )
 
 // Client wraps a SQLite connection and handles initialization.
@@ -44,6 +46,11 @@ func (c *Client) DB() *sql.DB {
 	return c.db
 }
 
+// Dialect returns the SQLite SQL dialect.
+func (c *Client) Dialect() db.Dialect {
+	return Dialect{}
+}
+
 // Close closes the database connection.
 func (c *Client) Close() error {
 	return c.db.Close()
@@ -65,22 +72,8 @@ func (c *Client) applyPragmas() error {
 
 // InitSchema creates the tables used by the repositories.
 func (c *Client) InitSchema() error {
-	stmts := []string{
-		`CREATE TABLE IF NOT EXISTS users (id TEXT PRIMARY KEY, data TEXT NOT NULL);`,
-		`CREATE TABLE IF NOT EXISTS characters (id TEXT PRIMARY KEY, data TEXT NOT NULL);`,
-		`CREATE TABLE IF NOT EXISTS charactertemplates (id TEXT PRIMARY KEY, data TEXT NOT NULL);`,
-		`CREATE TABLE IF NOT EXISTS rooms (id TEXT PRIMARY KEY, data TEXT NOT NULL);`,
-		`CREATE TABLE IF NOT EXISTS items (id TEXT PRIMARY KEY, data TEXT NOT NULL);`,
-		`CREATE TABLE IF NOT EXISTS scripts (id TEXT PRIMARY KEY, data TEXT NOT NULL);`,
-		`CREATE TABLE IF NOT EXISTS npcs (id TEXT PRIMARY KEY, data TEXT NOT NULL);`,
-		`CREATE TABLE IF NOT EXISTS npc_spawners (id TEXT PRIMARY KEY, data TEXT NOT NULL);`,
-		`CREATE TABLE IF NOT EXISTS dialogs (id TEXT PRIMARY KEY, data TEXT NOT NULL);`,
-		`CREATE TABLE IF NOT EXISTS conversations (id TEXT PRIMARY KEY, data TEXT NOT NULL);`,
-		`CREATE TABLE IF NOT EXISTS parties (id TEXT PRIMARY KEY, data TEXT NOT NULL);`,
-		`CREATE TABLE IF NOT EXISTS loot_tables (id TEXT PRIMARY KEY, data TEXT NOT NULL);`,
-		`CREATE TABLE IF NOT EXISTS server_settings (id TEXT PRIMARY KEY, data TEXT NOT NULL);`,
-	}
-	for _, stmt := range stmts {
+	for _, table := range db.Collections {
+		stmt := fmt.Sprintf("CREATE TABLE IF NOT EXISTS %s (id TEXT PRIMARY KEY, data TEXT NOT NULL);", table)
 		if _, err := c.db.Exec(stmt); err != nil {
 			return fmt.Errorf("sqlite schema init failed: %w", err)
 		}
@@ -0,0 +1,25 @@
+package audit
+
+import (
+	"time"
+
+	"github.com/talesmud/talesmud/pkg/entities"
+)
+
+// Entry ... a record of a staff command used in game
+type Entry struct {
+	*entities.Entity `bson:",inline"`
+
+	Time     time.Time `bson:"time" json:"time"`
+	UserID   string    `bson:"userId" json:"userId"`
+	UserName string    `bson:"userName" json:"userName"`
+	Role     string    `bson:"role" json:"role"`
+
+	CharacterID   string `bson:"characterId,omitempty" json:"characterId,omitempty"`
+	CharacterName string `bson:"characterName,omitempty" json:"characterName,omitempty"`
+	RoomID        string `bson:"roomId,omitempty" json:"roomId,omitempty"`
+
+	// Command is the command key, Input the full line the staff member entered
+	Command string `bson:"command" json:"command"`
+	Input   string `bson:"input" json:"input"`
+}
@@ -0,0 +1,87 @@
+package channels
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/talesmud/talesmud/pkg/entities"
+)
+
+// DefaultHistorySize is the number of lines replayed on login if a channel sets no history size
+const DefaultHistorySize = 20
+
+// Channel ... a named chat channel players join and leave, players talk on it with ".<name> <text>"
+type Channel struct {
+	*entities.Entity `bson:",inline"`
+
+	Name        string `bson:"name,omitempty" json:"name"`
+	Description string `bson:"description,omitempty" json:"description"`
+
+	// AutoJoin channels are joined by characters that never joined or left a channel
+	AutoJoin bool  `bson:"autoJoin" json:"autoJoin"`
+	MinLevel int32 `bson:"minLevel,omitempty" json:"minLevel,omitempty"`
+	// MaxLevel limits channels like newbie to low level characters (0 = no limit)
+	MaxLevel int32 `bson:"maxLevel,omitempty" json:"maxLevel,omitempty"`
+
+	// HistorySize is the number of lines kept and replayed on login (0 = DefaultHistorySize, -1 = none)
+	HistorySize int32 `bson:"historySize,omitempty" json:"historySize,omitempty"`
+}
+
+// Line is a message sent on a channel
+type Line struct {
+	Time   time.Time `json:"time"`
+	Sender string    `json:"sender"`
+	Text   string    `json:"text"`
+}
+
+// Key returns the lowercase channel name used for talking and memberships
+func (c *Channel) Key() string {
+	return strings.ToLower(c.Name)
+}
+
+// Validate checks the channel name and level limits
+func (c *Channel) Validate() error {
+	name := strings.TrimSpace(c.Name)
+	if name == "" {
+		return errors.New("channel name is required")
+	}
+	if strings.ContainsAny(name, " \t\n") {
+		return errors.New("channel name must be a single word")
+	}
+	if strings.HasPrefix(name, ".") {
+		return errors.New("channel name must not start with '.'")
+	}
+	if c.MaxLevel > 0 && c.MaxLevel < c.MinLevel {
+		return errors.New("maxLevel must not be lower than minLevel")
+	}
+	return nil
+}
+
+// CanJoin returns true if a character of the level may use the channel
+func (c *Channel) CanJoin(level int32) bool {
+	if level < c.MinLevel {
+		return false
+	}
+	return c.MaxLevel == 0 || level <= c.MaxLevel
+}
+
+// History returns the number of lines kept for the channel
+func (c *Channel) History() int {
+	switch {
+	case c.HistorySize < 0:
+		return 0
+	case c.HistorySize == 0:
+		return DefaultHistorySize
+	}
+	return int(c.HistorySize)
+}
+
+// Defaults returns the channels every world starts with
+func Defaults() []*Channel {
+	return []*Channel{
+		{Name: "ooc", Description: "Out of character chat for everyone", AutoJoin: true},
+		{Name: "newbie", Description: "Questions and help for new players", AutoJoin: true},
+		{Name: "trade", Description: "Buying and selling between players", AutoJoin: true},
+	}
+}
@@ -0,0 +1,37 @@
+package characters
+
+import "strings"
+
+// IsInChannel returns true if the character listens to the channel
+func (c *Character) IsInChannel(name string) bool {
+	for _, channel := range c.Channels {
+		if strings.EqualFold(channel, name) {
+			return true
+		}
+	}
+	return false
+}
+
+// JoinChannel adds the channel to the character, returns false if it was already joined
+func (c *Character) JoinChannel(name string) bool {
+	if c.IsInChannel(name) {
+		return false
+	}
+	c.Channels = append(c.Channels, strings.ToLower(name))
+	return true
+}
+
+// LeaveChannel removes the channel from the character, returns false if it was not joined
+func (c *Character) LeaveChannel(name string) bool {
+	remaining := make([]string, 0, len(c.Channels))
+	for _, channel := range c.Channels {
+		if !strings.EqualFold(channel, name) {
+			remaining = append(remaining, channel)
+		}
+	}
+	if len(remaining) == len(c.Channels) {
+		return false
+	}
+	c.Channels = remaining
+	return true
+}
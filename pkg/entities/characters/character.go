@@ -1,10 +1,13 @@
 package characters
 
 import (
+	"strings"
 	"time"
 
 	"github.com/talesmud/talesmud/pkg/entities"
+	"github.com/talesmud/talesmud/pkg/entities/effects"
 	"github.com/talesmud/talesmud/pkg/entities/items"
+	"github.com/talesmud/talesmud/pkg/entities/quests"
 	"github.com/talesmud/talesmud/pkg/entities/traits"
 )
 
@@ -41,6 +44,13 @@ type Character struct {
 	CurrentHitPoints int32 `json:"currentHitPoints"`
 	MaxHitPoints     int32 `json:"maxHitPoints"`
 
+	// Resources consumed by skills, regenerated out of combat
+	CurrentMana        int32     `json:"currentMana"`
+	MaxMana            int32     `json:"maxMana"`
+	CurrentStamina     int32     `json:"currentStamina"`
+	MaxStamina         int32     `json:"maxStamina"`
+	ResourcesUpdatedAt time.Time `bson:"resourcesUpdatedAt,omitempty" json:"resourcesUpdatedAt,omitempty"`
+
 	XP    int32 `json:"xp"`
 	Level int32 `json:"level"`
 	Gold  int64 `json:"gold"`
@@ -59,6 +69,21 @@ type Character struct {
 	// Respawn binding - room where player respawns on death
 	BoundRoomID string `bson:"boundRoomId,omitempty" json:"boundRoomId,omitempty"`
 
+	// Status effects - buffs, debuffs and damage over time, round based effects only last for one combat
+	StatusEffects effects.Effects `bson:"statusEffects,omitempty" json:"statusEffects,omitempty"`
+
+	// Skill cooldowns - skill ID to the time the skill is ready again
+	SkillCooldowns map[string]time.Time `bson:"skillCooldowns,omitempty" json:"skillCooldowns,omitempty"`
+
+	// Quest log - accepted, completed and failed quests
+	QuestLog quests.QuestLog `bson:"questLog,omitempty" json:"questLog,omitempty"`
+
+	// Level history - one record per level-up, used to tune the XP curve
+	LevelHistory []LevelUpRecord `bson:"levelHistory,omitempty" json:"levelHistory,omitempty"`
+
+	// Chat channels the character listens to, nil until the character joined the auto join channels
+	Channels []string `bson:"channels" json:"channels"`
+
 	// track alltime stats in character object but dont expose as json by default
 	AllTimeStats struct {
 		PlayersKilled   int32 `bson:"playersKilled" json:"playersKilled"`
@@ -67,10 +92,16 @@ type Character struct {
 	} `bson:"allTimeStats" json:"_"`
 }
 
-// GetAttribute returns the value of an attribute by its short name (STR, DEX, etc.)
+// GetAttribute returns the value of an attribute by its short name (STR, DEX, etc.), case insensitive
+// Includes buffs and debuffs from active status effects
 func (c *Character) GetAttribute(short string) int32 {
+	return c.GetBaseAttribute(short) + c.StatusEffects.AttributeBonus(short, time.Now())
+}
+
+// GetBaseAttribute returns the value of an attribute without status effects
+func (c *Character) GetBaseAttribute(short string) int32 {
 	for _, attr := range c.Attributes {
-		if attr.Short == short {
+		if strings.EqualFold(attr.Short, short) {
 			return attr.Value
 		}
 	}
@@ -83,6 +114,12 @@ func (c *Character) GetAttributeModifier(short string) int {
 	return int(value-10) / 2
 }
 
+// GetBaseAttributeModifier returns the modifier for an attribute without status effects
+func (c *Character) GetBaseAttributeModifier(short string) int {
+	value := c.GetBaseAttribute(short)
+	return int(value-10) / 2
+}
+
 // GetSTRMod returns the strength modifier
 func (c *Character) GetSTRMod() int {
 	return c.GetAttributeModifier("STR")
@@ -93,6 +130,16 @@ func (c *Character) GetDEXMod() int {
 	return c.GetAttributeModifier("DEX")
 }
 
+// GetINTMod returns the intelligence modifier
+func (c *Character) GetINTMod() int {
+	return c.GetAttributeModifier("INT")
+}
+
+// GetWISMod returns the wisdom modifier
+func (c *Character) GetWISMod() int {
+	return c.GetAttributeModifier("WIS")
+}
+
 // GetCONMod returns the constitution modifier
 func (c *Character) GetCONMod() int {
 	return c.GetAttributeModifier("CON")
@@ -0,0 +1,284 @@
+package characters
+
+import (
+	"errors"
+	"math"
+	"strings"
+	"time"
+)
+
+// ProgressionCurve defines how much total XP is required to reach a level
+// If XPTable is set it takes precedence over the formula: XPTable[i] is the total XP required for level i+2
+type ProgressionCurve struct {
+	// BaseXP is the XP required to reach level 2
+	BaseXP int32 `bson:"baseXp" json:"baseXp"`
+	// Exponent controls how steep the curve grows: BaseXP * (level-1)^Exponent
+	Exponent float64 `bson:"exponent" json:"exponent"`
+	// MaxLevel is the level cap, 0 means no cap
+	MaxLevel int32   `bson:"maxLevel" json:"maxLevel"`
+	XPTable  []int32 `bson:"xpTable,omitempty" json:"xpTable,omitempty"`
+}
+
+// LevelLimit is the highest level any curve can reach, it also bounds the level-up loops
+const LevelLimit int32 = 1000
+
+// DefaultProgressionCurve returns the default XP curve
+func DefaultProgressionCurve() *ProgressionCurve {
+	return &ProgressionCurve{
+		BaseXP:   100,
+		Exponent: 1.5,
+		MaxLevel: 50,
+	}
+}
+
+// Validate returns an error if the curve can't be used: a table has to be strictly increasing,
+// a formula needs a positive BaseXP and Exponent
+func (p *ProgressionCurve) Validate() error {
+	if p.MaxLevel < 0 {
+		return errors.New("maxLevel must not be negative")
+	}
+	if len(p.XPTable) > 0 {
+		previous := int32(0)
+		for _, xp := range p.XPTable {
+			if xp <= previous {
+				return errors.New("xpTable must be strictly increasing and positive")
+			}
+			previous = xp
+		}
+		return nil
+	}
+	if p.BaseXP <= 0 {
+		return errors.New("baseXp must be positive")
+	}
+	if p.Exponent <= 0 || math.IsNaN(p.Exponent) || math.IsInf(p.Exponent, 0) {
+		return errors.New("exponent must be positive")
+	}
+	return nil
+}
+
+// XPForLevel returns the total XP required to reach the given level, at most math.MaxInt32
+func (p *ProgressionCurve) XPForLevel(level int32) int32 {
+	if level <= 1 {
+		return 0
+	}
+	if len(p.XPTable) > 0 {
+		if int(level-2) < len(p.XPTable) {
+			return p.XPTable[level-2]
+		}
+		return math.MaxInt32
+	}
+	xp := math.Round(float64(p.BaseXP) * math.Pow(float64(level-1), p.Exponent))
+	if xp >= math.MaxInt32 || math.IsNaN(xp) {
+		return math.MaxInt32
+	}
+	return int32(xp)
+}
+
+// LevelForXP returns the level reached with the given total XP
+func (p *ProgressionCurve) LevelForXP(xp int32) int32 {
+	level := int32(1)
+	for !p.IsMaxLevel(level) && xp >= p.XPForLevel(level+1) {
+		level++
+	}
+	return level
+}
+
+// IsMaxLevel returns true if no further level can be reached
+func (p *ProgressionCurve) IsMaxLevel(level int32) bool {
+	if level >= LevelLimit || p.XPForLevel(level+1) == math.MaxInt32 {
+		return true
+	}
+	if p.MaxLevel > 0 && level >= p.MaxLevel {
+		return true
+	}
+	return len(p.XPTable) > 0 && int(level-1) >= len(p.XPTable)
+}
+
+// LevelGrowth defines the stat gains per level
+type LevelGrowth struct {
+	HitPoints int32 `json:"hitPoints"`
+	Mana      int32 `json:"mana,omitempty"`
+	Stamina   int32 `json:"stamina,omitempty"`
+	// Attributes maps attribute short names to the gain per level
+	Attributes map[string]int32 `json:"attributes,omitempty"`
+}
+
+// TODO: Move this to Database or YML files
+var (
+	// ClassGrowth contains the stat gains per level for each class ID
+	ClassGrowth = map[string]LevelGrowth{
+		ClassWarrior.ID: {HitPoints: 8, Stamina: 4, Attributes: map[string]int32{"str": 1, "sta": 1}},
+		ClassRanger.ID:  {HitPoints: 6, Mana: 1, Stamina: 3, Attributes: map[string]int32{"dex": 1, "sta": 1}},
+		ClassHunter.ID:  {HitPoints: 6, Mana: 1, Stamina: 3, Attributes: map[string]int32{"dex": 1, "wis": 1}},
+		ClassRogue.ID:   {HitPoints: 5, Stamina: 4, Attributes: map[string]int32{"dex": 2}},
+		ClassWizard.ID:  {HitPoints: 3, Mana: 5, Stamina: 1, Attributes: map[string]int32{"int": 2}},
+		"cleric":        {HitPoints: 5, Mana: 4, Stamina: 2, Attributes: map[string]int32{"wis": 1, "sta": 1}},
+	}
+
+	// RaceGrowth contains additional stat gains per level for each race ID
+	RaceGrowth = map[string]LevelGrowth{
+		RaceDwarf.ID: {HitPoints: 2},
+		RaceHuman.ID: {HitPoints: 1},
+		RaceElve.ID:  {Attributes: map[string]int32{"dex": 1}},
+	}
+
+	// DefaultGrowth is used for classes without a growth definition
+	DefaultGrowth = LevelGrowth{HitPoints: 5, Mana: 2, Stamina: 2}
+)
+
+// GrowthFor returns the combined class and race stat gains per level
+func GrowthFor(class Class, race Race) LevelGrowth {
+	classGrowth, ok := ClassGrowth[class.ID]
+	if !ok {
+		classGrowth = DefaultGrowth
+	}
+	raceGrowth := RaceGrowth[race.ID]
+
+	growth := LevelGrowth{
+		HitPoints:  classGrowth.HitPoints + raceGrowth.HitPoints,
+		Mana:       classGrowth.Mana + raceGrowth.Mana,
+		Stamina:    classGrowth.Stamina + raceGrowth.Stamina,
+		Attributes: make(map[string]int32),
+	}
+	for short, value := range classGrowth.Attributes {
+		growth.Attributes[short] += value
+	}
+	for short, value := range raceGrowth.Attributes {
+		growth.Attributes[short] += value
+	}
+	return growth
+}
+
+// LevelUpRecord is an entry in the level history of a character
+type LevelUpRecord struct {
+	Level int32 `bson:"level" json:"level"`
+	// XP is the total XP of the character when the level was reached
+	XP int32 `bson:"xp" json:"xp"`
+	// Source describes what granted the XP (combat, quest, script)
+	Source          string           `bson:"source,omitempty" json:"source,omitempty"`
+	HitPointsGained int32            `bson:"hitPointsGained" json:"hitPointsGained"`
+	AttributeGains  map[string]int32 `bson:"attributeGains,omitempty" json:"attributeGains,omitempty"`
+	// SecondsSincePrevious is the time it took to reach this level from the previous one
+	SecondsSincePrevious int64     `bson:"secondsSincePrevious" json:"secondsSincePrevious"`
+	ReachedAt            time.Time `bson:"reachedAt" json:"reachedAt"`
+}
+
+// GainXP adds XP to the character and applies all level-ups reached on the curve
+// Returns the level-ups in ascending order, the caller persists the character
+func (c *Character) GainXP(amount int32, curve *ProgressionCurve, source string) []LevelUpRecord {
+	if amount <= 0 {
+		return nil
+	}
+	if c.XP > math.MaxInt32-amount {
+		c.XP = math.MaxInt32
+	} else {
+		c.XP += amount
+	}
+	if c.Level < 1 {
+		c.Level = 1
+	}
+
+	levelUps := make([]LevelUpRecord, 0)
+	for !curve.IsMaxLevel(c.Level) && c.XP >= curve.XPForLevel(c.Level+1) {
+		levelUps = append(levelUps, c.levelUp(source))
+	}
+	return levelUps
+}
+
+// levelUp increases the level by one, applies the class and race growth and records the level-up
+func (c *Character) levelUp(source string) LevelUpRecord {
+	growth := GrowthFor(c.Class, c.Race)
+	now := time.Now()
+
+	c.Level++
+	c.MaxHitPoints += growth.HitPoints
+	c.MaxMana += growth.Mana
+	c.MaxStamina += growth.Stamina
+	// a level-up fully heals the character and refills the resources
+	c.CurrentHitPoints = c.MaxHitPoints
+	c.CurrentMana = c.MaxMana
+	c.CurrentStamina = c.MaxStamina
+
+	gains := make(map[string]int32)
+	for i := range c.Attributes {
+		for short, value := range growth.Attributes {
+			if strings.EqualFold(c.Attributes[i].Short, short) {
+				c.Attributes[i].Value += value
+				gains[c.Attributes[i].Short] = value
+			}
+		}
+	}
+
+	previous := c.Created
+	if len(c.LevelHistory) > 0 {
+		previous = c.LevelHistory[len(c.LevelHistory)-1].ReachedAt
+	}
+	var seconds int64
+	if !previous.IsZero() {
+		seconds = int64(now.Sub(previous).Seconds())
+	}
+
+	record := LevelUpRecord{
+		Level:                c.Level,
+		XP:                   c.XP,
+		Source:               source,
+		HitPointsGained:      growth.HitPoints,
+		AttributeGains:       gains,
+		SecondsSincePrevious: seconds,
+		ReachedAt:            now,
+	}
+	c.LevelHistory = append(c.LevelHistory, record)
+	return record
+}
+
+// SetLevel moves the character to the level and sets the XP to the start of that level
+// Raising the level applies the regular level-ups, lowering it reverts the recorded gains of the removed levels
+func (c *Character) SetLevel(level int32, curve *ProgressionCurve, source string) []LevelUpRecord {
+	if level < 1 {
+		level = 1
+	}
+	if curve.MaxLevel > 0 && level > curve.MaxLevel {
+		level = curve.MaxLevel
+	}
+	if level > LevelLimit {
+		level = LevelLimit
+	}
+	if c.Level < 1 {
+		c.Level = 1
+	}
+
+	if level > c.Level {
+		return c.GainXP(curve.XPForLevel(level)-c.XP, curve, source)
+	}
+
+	growth := GrowthFor(c.Class, c.Race)
+	for c.Level > level {
+		if n := len(c.LevelHistory); n > 0 && c.LevelHistory[n-1].Level == c.Level {
+			record := c.LevelHistory[n-1]
+			c.MaxHitPoints -= record.HitPointsGained
+			for i := range c.Attributes {
+				c.Attributes[i].Value -= record.AttributeGains[c.Attributes[i].Short]
+			}
+			c.LevelHistory = c.LevelHistory[:n-1]
+		} else {
+			c.MaxHitPoints -= growth.HitPoints
+		}
+		c.MaxMana -= growth.Mana
+		c.MaxStamina -= growth.Stamina
+		c.Level--
+	}
+	if c.MaxHitPoints < 1 {
+		c.MaxHitPoints = 1
+	}
+	if c.MaxMana < 0 {
+		c.MaxMana = 0
+	}
+	if c.MaxStamina < 0 {
+		c.MaxStamina = 0
+	}
+	c.XP = curve.XPForLevel(level)
+	c.CurrentHitPoints = c.MaxHitPoints
+	c.CurrentMana = c.MaxMana
+	c.CurrentStamina = c.MaxStamina
+	return nil
+}
@@ -0,0 +1,128 @@
+package characters
+
+import (
+	"time"
+
+	"github.com/talesmud/talesmud/pkg/entities/skills"
+)
+
+// resources regenerate out of combat by a percentage of the maximum per tick
+const (
+	resourceRegenTick    = 6 * time.Second
+	resourceRegenPercent = 5
+)
+
+// InitResources calculates mana and stamina pools for characters that have none yet and fills them up
+func (c *Character) InitResources() {
+	if c.MaxMana > 0 || c.MaxStamina > 0 {
+		return
+	}
+
+	growth := GrowthFor(c.Class, c.Race)
+	levels := c.Level - 1
+	if levels < 0 {
+		levels = 0
+	}
+
+	c.MaxMana = 5 + 2*c.GetBaseAttribute("int") + levels*growth.Mana
+	c.MaxStamina = 5 + 2*c.GetBaseAttribute("sta") + levels*growth.Stamina
+	c.CurrentMana = c.MaxMana
+	c.CurrentStamina = c.MaxStamina
+	c.ResourcesUpdatedAt = time.Now()
+}
+
+// Resource returns the current and maximum value of a resource pool
+func (c *Character) Resource(resourceType skills.ResourceType) (current int32, max int32) {
+	switch resourceType {
+	case skills.ResourceTypeMana:
+		return c.CurrentMana, c.MaxMana
+	case skills.ResourceTypeStamina:
+		return c.CurrentStamina, c.MaxStamina
+	}
+	return 0, 0
+}
+
+// SpendResource removes the amount from the resource pool, returns false if there is not enough left
+func (c *Character) SpendResource(resourceType skills.ResourceType, amount int32) bool {
+	if amount <= 0 {
+		return true
+	}
+	switch resourceType {
+	case skills.ResourceTypeMana:
+		if c.CurrentMana < amount {
+			return false
+		}
+		c.CurrentMana -= amount
+	case skills.ResourceTypeStamina:
+		if c.CurrentStamina < amount {
+			return false
+		}
+		c.CurrentStamina -= amount
+	}
+	return true
+}
+
+// RestoreResource adds the amount to the resource pool up to the maximum, returns the restored amount
+func (c *Character) RestoreResource(resourceType skills.ResourceType, amount int32) int32 {
+	var current, max *int32
+	switch resourceType {
+	case skills.ResourceTypeMana:
+		current, max = &c.CurrentMana, &c.MaxMana
+	case skills.ResourceTypeStamina:
+		current, max = &c.CurrentStamina, &c.MaxStamina
+	default:
+		return 0
+	}
+
+	before := *current
+	*current += amount
+	if *current > *max {
+		*current = *max
+	}
+	return *current - before
+}
+
+// RegenerateResources restores mana and stamina for the time passed since the last update
+// Characters in combat do not regenerate, their pools are tracked by the combat instance
+func (c *Character) RegenerateResources(now time.Time) {
+	if c.ResourcesUpdatedAt.IsZero() || c.InCombat {
+		c.ResourcesUpdatedAt = now
+		return
+	}
+
+	ticks := int32(now.Sub(c.ResourcesUpdatedAt) / resourceRegenTick)
+	if ticks <= 0 {
+		return
+	}
+	c.ResourcesUpdatedAt = c.ResourcesUpdatedAt.Add(time.Duration(ticks) * resourceRegenTick)
+
+	c.RestoreResource(skills.ResourceTypeMana, regenAmount(c.MaxMana, ticks))
+	c.RestoreResource(skills.ResourceTypeStamina, regenAmount(c.MaxStamina, ticks))
+}
+
+func regenAmount(max, ticks int32) int32 {
+	perTick := max * resourceRegenPercent / 100
+	if perTick < 1 {
+		perTick = 1
+	}
+	return perTick * ticks
+}
+
+// SkillReadyIn returns the remaining cooldown of a skill, 0 if the skill is ready
+func (c *Character) SkillReadyIn(skillID string, now time.Time) time.Duration {
+	if readyAt, ok := c.SkillCooldowns[skillID]; ok && readyAt.After(now) {
+		return readyAt.Sub(now)
+	}
+	return 0
+}
+
+// StartCooldown puts a skill on cooldown
+func (c *Character) StartCooldown(skill *skills.Skill, now time.Time) {
+	if skill.CooldownSec <= 0 {
+		return
+	}
+	if c.SkillCooldowns == nil {
+		c.SkillCooldowns = make(map[string]time.Time)
+	}
+	c.SkillCooldowns[skill.ID] = now.Add(skill.Cooldown())
+}
@@ -1,9 +1,11 @@
 package combat
 
 import (
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/talesmud/talesmud/pkg/entities/effects"
 )
 
 // CombatState represents the current state of a combat instance
@@ -34,6 +36,8 @@ const (
 	CombatActionDefend  CombatAction = "defend"
 	CombatActionItem    CombatAction = "item"
 	CombatActionFlee    CombatAction = "flee"
+	CombatActionSkill   CombatAction = "skill"
+	CombatActionEffect  CombatAction = "effect" // Status effect tick (damage over time)
 	CombatActionTimeout CombatAction = "timeout" // Forced defend due to timeout
 )
 
@@ -56,14 +60,62 @@ type CombatantRef struct {
 	STRMod int `json:"strMod"`
 	DEXMod int `json:"dexMod"`
 	CONMod int `json:"conMod"`
+	INTMod int `json:"intMod"`
+	WISMod int `json:"wisMod"`
+
+	// Skill resources and cooldowns, synced back to the character when combat ends
+	Level          int32                `json:"level"`
+	CurrentMana    int32                `json:"currentMana"`
+	MaxMana        int32                `json:"maxMana"`
+	CurrentStamina int32                `json:"currentStamina"`
+	MaxStamina     int32                `json:"maxStamina"`
+	SkillCooldowns map[string]time.Time `json:"skillCooldowns,omitempty"`
 
 	// Status effects
-	DefenseBonus int32 `json:"defenseBonus"` // From defend action
+	DefenseBonus int32           `json:"defenseBonus"` // From defend action
+	Effects      effects.Effects `json:"effects,omitempty"`
 
 	// Auto-attack system
 	AutoAttackTargetID string       `json:"autoAttackTargetId,omitempty"` // Persistent target for auto-attacks
 	QueuedAction       CombatAction `json:"queuedAction,omitempty"`      // Next action override (flee, defend, attack)
 	QueuedTargetID     string       `json:"queuedTargetId,omitempty"`    // Target for queued attack
+	QueuedSkillID      string       `json:"queuedSkillId,omitempty"`     // Skill for queued skill action
+}
+
+// Modifier returns the attribute modifier by short name (str, dex, con, int, wis) including status effects
+func (c *CombatantRef) Modifier(short string) int {
+	bonus := int(c.Effects.AttributeBonus(short, time.Now())) / 2
+	switch strings.ToLower(short) {
+	case "str":
+		return c.STRMod + bonus
+	case "dex":
+		return c.DEXMod + bonus
+	case "con", "sta":
+		return c.CONMod + bonus
+	case "int":
+		return c.INTMod + bonus
+	case "wis":
+		return c.WISMod + bonus
+	}
+	return 0
+}
+
+// EffectiveAttack returns the attack power including status effects
+func (c *CombatantRef) EffectiveAttack() int32 {
+	attack := c.AttackPower + c.Effects.AttributeBonus(effects.StatAttack, time.Now())
+	if attack < 1 {
+		return 1
+	}
+	return attack
+}
+
+// EffectiveDefense returns the defense including status effects, without the defend bonus
+func (c *CombatantRef) EffectiveDefense() int32 {
+	defense := c.Defense + c.Effects.AttributeBonus(effects.StatDefense, time.Now())
+	if defense < 0 {
+		return 0
+	}
+	return defense
 }
 
 // CombatLogEntry represents a single action in the combat log
@@ -103,6 +103,11 @@ func (c *Conversation) UpdateInteraction() {
 	c.LastInteracted = time.Now()
 }
 
+// InteractedWithin returns true if the player interacted with the conversation within the given duration
+func (c *Conversation) InteractedWithin(d time.Duration, now time.Time) bool {
+	return now.Sub(c.LastInteracted) < d
+}
+
 // SetContext sets a context variable for dialog rendering
 func (c *Conversation) SetContext(key, value string) {
 	if c.Context == nil {
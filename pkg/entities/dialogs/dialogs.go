@@ -7,7 +7,6 @@ import (
 
 	"github.com/hoisie/mustache"
 	log "github.com/sirupsen/logrus"
-	"github.com/stretchr/testify/assert"
 	"github.com/talesmud/talesmud/pkg/entities"
 	"gopkg.in/yaml.v3"
 )
@@ -100,31 +99,38 @@ func Options(dialogs ...*Dialog) DialogOptions {
 	return dialogs
 }
 
+// GetText returns the text of the node, randomly selected from Text and AlternateTexts
 func (d *Dialog) GetText() string {
+	return d.SelectText(0)
+}
 
-	//TODO: add logic for ordered texts
-	randTextCount := 1
-	if d.AlternateTexts != nil {
-		randTextCount += len(d.AlternateTexts)
-	}
-
-	randTextID := rand.Intn(randTextCount)
+// Texts returns Text followed by all AlternateTexts
+func (d *Dialog) Texts() []string {
+	return append([]string{d.Text}, d.AlternateTexts...)
+}
 
-	if randTextID == 0 {
-		return d.Text
+// SelectText returns the text shown for the given visit count of the node (including the current visit)
+// With OrderedTexts the first visit shows Text, the next ones the AlternateTexts in order, starting over after the last one
+// Otherwise the text is randomly selected
+func (d *Dialog) SelectText(visits int) string {
+	texts := d.Texts()
+	if d.OrderedTexts != nil && *d.OrderedTexts {
+		if visits < 1 {
+			visits = 1
+		}
+		return texts[(visits-1)%len(texts)]
 	}
-
-	assert.NotNil(nil, d.AlternateTexts)
-	return d.AlternateTexts[randTextID-1]
+	return texts[rand.Intn(len(texts))]
 }
 
+// Render renders the text of the node for the visit count in the state with the state context
 func (d *Dialog) Render(state *DialogState) string {
 	// iterate over all state.DynamicContext and add them to the state.Context
 	for k, v := range state.DynamicContext {
 		state.Context[k] = v()
 	}
 
-	return mustache.Render(d.GetText(), state.Context)
+	return mustache.Render(d.SelectText(state.DialogVisited[d.NodeID]), state.Context)
 }
 
 func (d *Dialog) RenderPlain(state *DialogState) string {
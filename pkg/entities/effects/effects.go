@@ -0,0 +1,358 @@
+package effects
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+// EffectType defines how a status effect is processed
+type EffectType string
+
+// effect types
+const (
+	EffectTypePoison  EffectType = "poison"  // damage per tick, stacks
+	EffectTypeBleed   EffectType = "bleed"   // damage per tick
+	EffectTypeStun    EffectType = "stun"    // skips the turn of the affected combatant
+	EffectTypeHaste   EffectType = "haste"   // one additional attack per turn
+	EffectTypeBuff    EffectType = "buff"    // raises an attribute or combat stat
+	EffectTypeDebuff  EffectType = "debuff"  // lowers an attribute or combat stat
+	EffectTypeStealth EffectType = "stealth" // aggressive enemies don't notice the affected character
+)
+
+// StackRule defines what happens when an effect is applied while it is already active
+type StackRule string
+
+// stack rules
+const (
+	StackRuleRefresh StackRule = "refresh" // resets the duration, keeps the higher magnitude (default)
+	StackRuleStack   StackRule = "stack"   // adds a stack up to MaxStacks and resets the duration
+	StackRuleIgnore  StackRule = "ignore"  // the new application is ignored
+)
+
+// stat names that can be used as buff/debuff attribute besides the character attributes
+const (
+	StatAttack  = "attack"
+	StatDefense = "defense"
+)
+
+// StatusEffect is a temporary effect on a character, NPC or combatant
+// The duration is either counted in combat rounds (Rounds) or in real time (DurationSec)
+type StatusEffect struct {
+	// ID identifies the effect for stacking, defaults to the type
+	ID   string     `bson:"id" json:"id"`
+	Name string     `bson:"name,omitempty" json:"name,omitempty"`
+	Type EffectType `bson:"type" json:"type"`
+
+	// Magnitude is the damage per tick for poison/bleed or the attribute change for buff/debuff
+	Magnitude int32 `bson:"magnitude,omitempty" json:"magnitude,omitempty"`
+	// Attribute is the short attribute name (str, dex, ...) or stat (attack, defense) changed by buff/debuff
+	Attribute string `bson:"attribute,omitempty" json:"attribute,omitempty"`
+
+	Stacking  StackRule `bson:"stacking,omitempty" json:"stacking,omitempty"`
+	Stacks    int32     `bson:"stacks,omitempty" json:"stacks,omitempty"`
+	MaxStacks int32     `bson:"maxStacks,omitempty" json:"maxStacks,omitempty"`
+
+	Rounds      int32     `bson:"rounds,omitempty" json:"rounds,omitempty"`
+	DurationSec int32     `bson:"durationSec,omitempty" json:"durationSec,omitempty"`
+	ExpiresAt   time.Time `bson:"expiresAt,omitempty" json:"expiresAt,omitempty"`
+
+	// Source of the effect, damage ticks are credited to it
+	SourceID   string `bson:"sourceId,omitempty" json:"sourceId,omitempty"`
+	SourceName string `bson:"sourceName,omitempty" json:"sourceName,omitempty"`
+}
+
+// Presets contains the predefined effects that items and scripts can reference by ID
+var Presets = map[string]StatusEffect{
+	"poison":  {ID: "poison", Name: "Poisoned", Type: EffectTypePoison, Magnitude: 2, Rounds: 5, Stacking: StackRuleStack, MaxStacks: 5},
+	"bleed":   {ID: "bleed", Name: "Bleeding", Type: EffectTypeBleed, Magnitude: 3, Rounds: 3, Stacking: StackRuleRefresh},
+	"stun":    {ID: "stun", Name: "Stunned", Type: EffectTypeStun, Rounds: 1, Stacking: StackRuleIgnore},
+	"haste":   {ID: "haste", Name: "Hasted", Type: EffectTypeHaste, Rounds: 3, Stacking: StackRuleRefresh},
+	"stealth": {ID: "stealth", Name: "Hidden", Type: EffectTypeStealth, DurationSec: 60, Stacking: StackRuleRefresh},
+}
+
+// Preset returns a copy of a predefined effect
+func Preset(id string) (StatusEffect, bool) {
+	effect, ok := Presets[strings.ToLower(id)]
+	return effect, ok
+}
+
+// DisplayName returns the name of the effect, falls back to the type
+func (e *StatusEffect) DisplayName() string {
+	if e.Name != "" {
+		return e.Name
+	}
+	return string(e.Type)
+}
+
+// IsRoundBased returns true if the duration is counted in combat rounds
+func (e *StatusEffect) IsRoundBased() bool {
+	return e.Rounds > 0
+}
+
+// IsExpired returns true if the effect has run out
+func (e *StatusEffect) IsExpired(now time.Time) bool {
+	if e.IsRoundBased() {
+		return false
+	}
+	return !e.ExpiresAt.IsZero() && !now.Before(e.ExpiresAt)
+}
+
+// IsDamageOverTime returns true if the effect deals damage each tick
+func (e *StatusEffect) IsDamageOverTime() bool {
+	return e.Type == EffectTypePoison || e.Type == EffectTypeBleed
+}
+
+// TickDamage returns the damage dealt each tick
+func (e *StatusEffect) TickDamage() int32 {
+	if !e.IsDamageOverTime() {
+		return 0
+	}
+	return e.Magnitude * e.stacks()
+}
+
+// AttributeChange returns the change of the given attribute or stat
+func (e *StatusEffect) AttributeChange(attribute string) int32 {
+	if !strings.EqualFold(e.Attribute, attribute) {
+		return 0
+	}
+	switch e.Type {
+	case EffectTypeBuff:
+		return e.Magnitude * e.stacks()
+	case EffectTypeDebuff:
+		return -e.Magnitude * e.stacks()
+	}
+	return 0
+}
+
+// Remaining returns a human readable remaining duration
+func (e *StatusEffect) Remaining(now time.Time) string {
+	if e.IsRoundBased() {
+		if e.Rounds == 1 {
+			return "1 round"
+		}
+		return strconv.Itoa(int(e.Rounds)) + " rounds"
+	}
+	if e.ExpiresAt.IsZero() {
+		return "permanent"
+	}
+	return strconv.Itoa(int(e.ExpiresAt.Sub(now).Seconds())+1) + "s"
+}
+
+func (e *StatusEffect) stacks() int32 {
+	if e.Stacks < 1 {
+		return 1
+	}
+	return e.Stacks
+}
+
+// Effects is a list of active status effects
+type Effects []StatusEffect
+
+// Apply adds the effect according to its stack rule, returns false if the application was ignored
+func (list *Effects) Apply(effect StatusEffect, now time.Time) bool {
+	if effect.ID == "" {
+		effect.ID = string(effect.Type)
+	}
+	if effect.Stacks < 1 {
+		effect.Stacks = 1
+	}
+	if !effect.IsRoundBased() && effect.DurationSec > 0 {
+		effect.ExpiresAt = now.Add(time.Duration(effect.DurationSec) * time.Second)
+	}
+
+	for i := range *list {
+		active := &(*list)[i]
+		if active.ID != effect.ID || active.IsExpired(now) {
+			continue
+		}
+
+		switch effect.Stacking {
+		case StackRuleIgnore:
+			return false
+		case StackRuleStack:
+			if active.MaxStacks == 0 || active.Stacks < active.MaxStacks {
+				active.Stacks++
+			}
+		default:
+			if effect.Magnitude > active.Magnitude {
+				active.Magnitude = effect.Magnitude
+			}
+		}
+		active.Rounds = effect.Rounds
+		active.ExpiresAt = effect.ExpiresAt
+		active.SourceID = effect.SourceID
+		active.SourceName = effect.SourceName
+		return true
+	}
+
+	*list = append(*list, effect)
+	return true
+}
+
+// Remove removes all effects with the given ID or type, returns true if an effect was removed
+func (list *Effects) Remove(idOrType string) bool {
+	kept := make(Effects, 0, len(*list))
+	for _, effect := range *list {
+		if effect.ID != idOrType && string(effect.Type) != idOrType {
+			kept = append(kept, effect)
+		}
+	}
+	removed := len(kept) != len(*list)
+	*list = kept
+	return removed
+}
+
+// Has returns true if an effect of the given type is active
+func (list Effects) Has(effectType EffectType, now time.Time) bool {
+	for i := range list {
+		if list[i].Type == effectType && !list[i].IsExpired(now) {
+			return true
+		}
+	}
+	return false
+}
+
+// AttributeBonus returns the sum of all buffs and debuffs on the attribute or stat
+func (list Effects) AttributeBonus(attribute string, now time.Time) int32 {
+	var bonus int32
+	for i := range list {
+		if !list[i].IsExpired(now) {
+			bonus += list[i].AttributeChange(attribute)
+		}
+	}
+	return bonus
+}
+
+// Prune removes all expired real time effects and returns them
+func (list *Effects) Prune(now time.Time) Effects {
+	kept := make(Effects, 0, len(*list))
+	expired := make(Effects, 0)
+	for _, effect := range *list {
+		if effect.IsExpired(now) {
+			expired = append(expired, effect)
+		} else {
+			kept = append(kept, effect)
+		}
+	}
+	*list = kept
+	return expired
+}
+
+// EndRound counts down all round based effects and removes and returns the ones that ran out
+func (list *Effects) EndRound() Effects {
+	kept := make(Effects, 0, len(*list))
+	expired := make(Effects, 0)
+	for _, effect := range *list {
+		if effect.IsRoundBased() {
+			effect.Rounds--
+			if effect.Rounds <= 0 {
+				expired = append(expired, effect)
+				continue
+			}
+		}
+		kept = append(kept, effect)
+	}
+	*list = kept
+	return expired
+}
+
+// WithoutRoundBased returns the effects that outlast combat
+func (list Effects) WithoutRoundBased() Effects {
+	kept := make(Effects, 0, len(list))
+	for _, effect := range list {
+		if !effect.IsRoundBased() {
+			kept = append(kept, effect)
+		}
+	}
+	return kept
+}
+
+// Copy returns a copy of the list
+func (list Effects) Copy() Effects {
+	result := make(Effects, len(list))
+	copy(result, list)
+	return result
+}
+
+// Summary returns a short description like "Poisoned x2 (3 rounds), Hasted (1 round)"
+func (list Effects) Summary(now time.Time) string {
+	parts := make([]string, 0, len(list))
+	for i := range list {
+		if list[i].IsExpired(now) {
+			continue
+		}
+		part := list[i].DisplayName()
+		if list[i].Stacks > 1 {
+			part += " x" + strconv.Itoa(int(list[i].Stacks))
+		}
+		parts = append(parts, part+" ("+list[i].Remaining(now)+")")
+	}
+	return strings.Join(parts, ", ")
+}
+
+// EffectTypes returns all available effect types
+func EffectTypes() []EffectType {
+	return []EffectType{EffectTypePoison, EffectTypeBleed, EffectTypeStun, EffectTypeHaste, EffectTypeBuff, EffectTypeDebuff, EffectTypeStealth}
+}
+
+// Parse builds an effect from a preset ID or a map of effect fields (item attributes, Lua tables)
+// A map with the ID of a preset starts from the preset and overrides the given fields
+func Parse(value interface{}) (StatusEffect, bool) {
+	switch v := value.(type) {
+	case string:
+		return Preset(v)
+	case map[string]interface{}:
+		effect := StatusEffect{}
+		if id, ok := v["id"].(string); ok {
+			if preset, found := Preset(id); found {
+				effect = preset
+			}
+			effect.ID = id
+		}
+		if name, ok := v["name"].(string); ok {
+			effect.Name = name
+		}
+		if t, ok := v["type"].(string); ok {
+			effect.Type = EffectType(strings.ToLower(t))
+		}
+		if attribute, ok := v["attribute"].(string); ok {
+			effect.Attribute = attribute
+		}
+		if stacking, ok := v["stacking"].(string); ok {
+			effect.Stacking = StackRule(strings.ToLower(stacking))
+		}
+		if n, ok := toInt32(v["magnitude"]); ok {
+			effect.Magnitude = n
+		}
+		if n, ok := toInt32(v["maxStacks"]); ok {
+			effect.MaxStacks = n
+		}
+		if n, ok := toInt32(v["rounds"]); ok {
+			effect.Rounds = n
+		}
+		if n, ok := toInt32(v["durationSec"]); ok {
+			effect.DurationSec = n
+			effect.Rounds = 0
+		}
+		if effect.Type == "" {
+			return effect, false
+		}
+		return effect, true
+	}
+	return StatusEffect{}, false
+}
+
+func toInt32(value interface{}) (int32, bool) {
+	switch v := value.(type) {
+	case float64:
+		return int32(v), true
+	case int:
+		return int32(v), true
+	case int32:
+		return v, true
+	case int64:
+		return int32(v), true
+	}
+	return 0, false
+}
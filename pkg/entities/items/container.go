@@ -0,0 +1,92 @@
+package items
+
+import (
+	"errors"
+	"strings"
+)
+
+// IsContainer returns true if other items can be stored in the item
+func (item *Item) IsContainer() bool {
+	return item.Slot == ItemSlotContainer || item.MaxItems > 0 || len(item.Items) > 0
+}
+
+// HasLock returns true if the container can be locked with a key
+func (item *Item) HasLock() bool {
+	return item.LockedBy != ""
+}
+
+// IsContainerFull returns true if the container holds MaxItems items (0 = unlimited)
+func (item *Item) IsContainerFull() bool {
+	return item.MaxItems > 0 && int32(len(item.Items)) >= item.MaxItems
+}
+
+// contents wraps the container items into an inventory to share its stacking and search logic
+func (item *Item) contents() *Inventory {
+	return &Inventory{
+		Size:  item.MaxItems,
+		Items: item.Items,
+	}
+}
+
+// PutItem stores an item in the container, stackable items are merged with an existing stack
+func (item *Item) PutItem(content *Item) error {
+	if content == nil {
+		return errors.New("cannot put nil item")
+	}
+	if content.ID != "" && content.ID == item.ID {
+		return errors.New("cannot put a container into itself")
+	}
+
+	inv := item.contents()
+	if err := inv.AddItem(content); err != nil {
+		if inv.IsFull() {
+			return errors.New("container is full")
+		}
+		return err
+	}
+	item.Items = inv.Items
+	return nil
+}
+
+// TakeItem removes an item by ID from the container and returns it
+func (item *Item) TakeItem(itemID string) (*Item, error) {
+	inv := item.contents()
+	taken, err := inv.RemoveItem(itemID)
+	if err != nil {
+		return nil, errors.New("item not found in container")
+	}
+	item.Items = inv.Items
+	return taken, nil
+}
+
+// FindContainedItem finds an item in the container by name or target name
+func (item *Item) FindContainedItem(name string) *Item {
+	inv := item.contents()
+	if found := inv.FindItemByName(name); found != nil {
+		return found
+	}
+	return inv.FindItemByTargetName(name)
+}
+
+// IsKeyFor returns true if the item opens the lock of the container
+// LockedBy may contain the template ID, the ID or the name of the key
+func (item *Item) IsKeyFor(container *Item) bool {
+	if !container.HasLock() {
+		return false
+	}
+	lockedBy := container.LockedBy
+	if item.TemplateID != "" && item.TemplateID == lockedBy {
+		return true
+	}
+	return item.ID == lockedBy || strings.EqualFold(item.Name, lockedBy)
+}
+
+// FindKeyFor returns the first item in the inventory that opens the lock of the container
+func (inv *Inventory) FindKeyFor(container *Item) *Item {
+	for _, item := range inv.Items {
+		if item.IsKeyFor(container) {
+			return item
+		}
+	}
+	return nil
+}
@@ -0,0 +1,51 @@
+package mail
+
+import (
+	"time"
+
+	"github.com/talesmud/talesmud/pkg/entities"
+	"github.com/talesmud/talesmud/pkg/entities/items"
+)
+
+// MaxAttachments is the maximum number of item attachments of a mail
+const MaxAttachments = 5
+
+// Mail ... a message between characters, gold and items are held in escrow until the recipient collects them
+type Mail struct {
+	*entities.Entity `bson:",inline"`
+
+	FromCharacterID string `bson:"fromCharacterId" json:"fromCharacterId"`
+	FromName        string `bson:"fromName" json:"fromName"`
+	ToCharacterID   string `bson:"toCharacterId" json:"toCharacterId"`
+	ToName          string `bson:"toName" json:"toName"`
+
+	Body string    `bson:"body,omitempty" json:"body,omitempty"`
+	Sent time.Time `bson:"sent" json:"sent"`
+	Read bool      `bson:"read" json:"read"`
+
+	// Attachments removed from the sender, collected at a post office
+	Gold  int64       `bson:"gold,omitempty" json:"gold,omitempty"`
+	Items items.Items `bson:"items,omitempty" json:"items,omitempty"`
+}
+
+// Preview returns the start of the mail body for mail lists
+func (m *Mail) Preview() string {
+	runes := []rune(m.Body)
+	if len(runes) <= 40 {
+		return m.Body
+	}
+	return string(runes[:40]) + "..."
+}
+
+// HasAttachments returns true if gold or items wait for the recipient
+func (m *Mail) HasAttachments() bool {
+	return m.Gold > 0 || len(m.Items) > 0
+}
+
+// TakeAttachments removes gold and items from the mail and returns them
+func (m *Mail) TakeAttachments() (int64, items.Items) {
+	gold, attached := m.Gold, m.Items
+	m.Gold = 0
+	m.Items = nil
+	return gold, attached
+}
@@ -8,13 +8,13 @@ type Range struct {
 
 // CreatureType constants for NPC classification
 const (
-	CreatureTypeBeast     = "beast"     // Animals, insects, natural creatures
-	CreatureTypeHumanoid  = "humanoid"  // Goblins, orcs, bandits - use Race/Class on NPC
-	CreatureTypeUndead    = "undead"    // Skeletons, zombies, ghosts
-	CreatureTypeElemental = "elemental" // Fire, water, earth, air beings
-	CreatureTypeConstruct = "construct" // Golems, animated objects
-	CreatureTypeDemon     = "demon"     // Demons, devils, otherworldly beings
-	CreatureTypeDragon    = "dragon"    // Dragons and dragonkin
+	CreatureTypeBeast      = "beast"      // Animals, insects, natural creatures
+	CreatureTypeHumanoid   = "humanoid"   // Goblins, orcs, bandits - use Race/Class on NPC
+	CreatureTypeUndead     = "undead"     // Skeletons, zombies, ghosts
+	CreatureTypeElemental  = "elemental"  // Fire, water, earth, air beings
+	CreatureTypeConstruct  = "construct"  // Golems, animated objects
+	CreatureTypeDemon      = "demon"      // Demons, devils, otherworldly beings
+	CreatureTypeDragon     = "dragon"     // Dragons and dragonkin
 	CreatureTypeAberration = "aberration" // Unnatural, eldritch creatures
 )
 
@@ -28,6 +28,9 @@ const (
 	CombatStyleAgile  = "agile"  // Fast, evasive, hit-and-run
 )
 
+// DefaultAggroLevelGap is the level difference above which aggressive enemies ignore players
+const DefaultAggroLevelGap int32 = 5
+
 // EnemyTrait contains enemy-specific configuration for NPCs
 type EnemyTrait struct {
 	// Classification
@@ -48,10 +51,12 @@ type EnemyTrait struct {
 	AttackSpeed float64 `json:"attackSpeed"`
 
 	// Behavior Configuration
-	// AggroRadius is how many rooms away the NPC can detect players (0 = passive, must be attacked first)
+	// AggroRadius is how many rooms away (by exit distance) the NPC can detect players (0 = own room only)
 	AggroRadius int `json:"aggroRadius"`
-	// AggroOnSight if true, NPC will auto-attack players on sight within aggro radius
+	// AggroOnSight if true, NPC will auto-attack players on sight within aggro radius (false = passive, must be attacked first)
 	AggroOnSight bool `json:"aggroOnSight"`
+	// AggroLevelGap ignores players this many levels above the NPC (0 = DefaultAggroLevelGap, negative = never ignore)
+	AggroLevelGap int32 `json:"aggroLevelGap,omitempty"`
 	// CallForHelp if true, NPC will alert nearby enemies when attacked
 	CallForHelp bool `json:"callForHelp"`
 	// FleeThreshold is HP percentage at which NPC attempts to flee (0 = never flee)
@@ -77,3 +82,15 @@ type EnemyTrait struct {
 	// OnFleeScript runs when NPC starts fleeing
 	OnFleeScript string `json:"onFleeScript,omitempty"`
 }
+
+// IgnoresLevel returns true if a player of the given level is too strong to be attacked on sight
+func (t *EnemyTrait) IgnoresLevel(playerLevel, npcLevel int32) bool {
+	gap := t.AggroLevelGap
+	if gap < 0 {
+		return false
+	}
+	if gap == 0 {
+		gap = DefaultAggroLevelGap
+	}
+	return playerLevel-npcLevel >= gap
+}
@@ -1,6 +1,9 @@
 package npc
 
-import "time"
+import (
+	"math/rand"
+	"time"
+)
 
 // MerchantTrait contains merchant-specific configuration for NPCs
 type MerchantTrait struct {
@@ -13,9 +16,15 @@ type MerchantTrait struct {
 	// RestockMinutes is how often the merchant restocks inventory (0 = never)
 	RestockMinutes int32 `json:"restockMinutes,omitempty"`
 
+	// RestockVariance randomly shortens or extends each restock interval by up to this many minutes
+	RestockVariance int32 `json:"restockVariance,omitempty"`
+
 	// LastRestock tracks when inventory was last restocked
 	LastRestock time.Time `json:"lastRestock,omitempty"`
 
+	// NextRestock is the scheduled time of the next restock including the variance
+	NextRestock time.Time `json:"nextRestock,omitempty"`
+
 	// BuyMultiplier adjusts buy prices (1.0 = normal, 1.2 = 20% more expensive)
 	BuyMultiplier float64 `json:"buyMultiplier"`
 
@@ -107,15 +116,49 @@ func (mt *MerchantTrait) CanBuyItem(itemType string, itemTags []string) bool {
 	return true
 }
 
+// Copy returns a deep copy of the trait so spawned instances don't share their stock with the template
+func (mt *MerchantTrait) Copy() *MerchantTrait {
+	if mt == nil {
+		return nil
+	}
+	copied := *mt
+	copied.Inventory = append([]MerchantItem(nil), mt.Inventory...)
+	copied.AcceptedTypes = append([]string(nil), mt.AcceptedTypes...)
+	copied.RejectedTags = append([]string(nil), mt.RejectedTags...)
+	return &copied
+}
+
 // NeedsRestock checks if merchant inventory needs restocking
 func (mt *MerchantTrait) NeedsRestock() bool {
 	if mt.RestockMinutes <= 0 {
 		return false
 	}
-	return time.Since(mt.LastRestock) >= time.Duration(mt.RestockMinutes)*time.Minute
+	if mt.NextRestock.IsZero() {
+		return time.Since(mt.LastRestock) >= time.Duration(mt.RestockMinutes)*time.Minute
+	}
+	return !time.Now().Before(mt.NextRestock)
+}
+
+// ScheduleRestock sets the next restock time, the interval is varied by up to RestockVariance minutes
+func (mt *MerchantTrait) ScheduleRestock(from time.Time) {
+	if mt.RestockMinutes <= 0 {
+		mt.NextRestock = time.Time{}
+		return
+	}
+
+	interval := time.Duration(mt.RestockMinutes) * time.Minute
+	if mt.RestockVariance > 0 {
+		variance := time.Duration(mt.RestockVariance) * time.Minute
+		interval += time.Duration(rand.Int63n(int64(2*variance)+1)) - variance
+	}
+	// never restock more often than once a minute
+	if interval < time.Minute {
+		interval = time.Minute
+	}
+	mt.NextRestock = from.Add(interval)
 }
 
-// Restock refills merchant inventory to max quantities
+// Restock refills merchant inventory to max quantities and schedules the next restock
 func (mt *MerchantTrait) Restock() {
 	for i := range mt.Inventory {
 		if mt.Inventory[i].MaxQuantity > 0 {
@@ -123,6 +166,32 @@ func (mt *MerchantTrait) Restock() {
 		}
 	}
 	mt.LastRestock = time.Now()
+	mt.ScheduleRestock(mt.LastRestock)
+}
+
+// TakeStock removes sold items from the stock, returns false if not enough are in stock
+func (mt *MerchantTrait) TakeStock(templateID string, quantity int32) bool {
+	item := mt.FindInventoryItem(templateID)
+	if item == nil {
+		return false
+	}
+	if item.Quantity < 0 {
+		return true
+	}
+	if item.Quantity < quantity {
+		return false
+	}
+	item.Quantity -= quantity
+	return true
+}
+
+// ReturnStock puts items taken with TakeStock back, e.g. if the sale failed
+func (mt *MerchantTrait) ReturnStock(templateID string, quantity int32) {
+	item := mt.FindInventoryItem(templateID)
+	if item == nil || item.Quantity < 0 {
+		return
+	}
+	item.Quantity += quantity
 }
 
 // FindInventoryItem finds a merchant item by template ID
@@ -5,6 +5,7 @@ import (
 
 	"github.com/talesmud/talesmud/pkg/entities"
 	"github.com/talesmud/talesmud/pkg/entities/characters"
+	"github.com/talesmud/talesmud/pkg/entities/effects"
 	"github.com/talesmud/talesmud/pkg/entities/traits"
 )
 
@@ -41,6 +42,10 @@ type NPC struct {
 	WanderRadius int `bson:"wanderRadius,omitempty" json:"wanderRadius,omitempty"`
 	// PatrolPath is an ordered list of room IDs for patrol behavior
 	PatrolPath []string `bson:"patrolPath,omitempty" json:"patrolPath,omitempty"`
+	// PatrolMode is "loop" (restart at the first room, default) or "pingpong" (walk the path back and forth)
+	PatrolMode PatrolMode `bson:"patrolMode,omitempty" json:"patrolMode,omitempty"`
+	// MoveInterval is the minimum time between two moves when wandering or patrolling (0 = game default)
+	MoveInterval time.Duration `bson:"moveInterval,omitempty" json:"moveInterval,omitempty"`
 
 	// State Tracking
 	// IsDead indicates the NPC is currently dead and awaiting respawn
@@ -50,9 +55,19 @@ type NPC struct {
 	// State is the FSM state: "idle", "combat", "patrol", "dead", "fleeing"
 	State string `bson:"state" json:"state"`
 
+	// Movement tracking
+	// PatrolIndex is the index of the patrol path room the NPC is at or heading to
+	PatrolIndex int `bson:"patrolIndex,omitempty" json:"patrolIndex,omitempty"`
+	// PatrolReverse is true while a ping-pong patrol walks the path backwards
+	PatrolReverse bool `bson:"patrolReverse,omitempty" json:"patrolReverse,omitempty"`
+	// LastMoveTime is when the NPC last moved to another room
+	LastMoveTime time.Time `bson:"lastMoveTime,omitempty" json:"lastMoveTime,omitempty"`
+
 	// Combat state
 	InCombat         bool   `bson:"inCombat" json:"inCombat"`
 	CombatInstanceID string `bson:"combatInstanceId,omitempty" json:"combatInstanceId,omitempty"`
+	// StatusEffects are the active buffs, debuffs and damage over time effects
+	StatusEffects effects.Effects `bson:"statusEffects,omitempty" json:"statusEffects,omitempty"`
 
 	// DialogID references the main interactive dialog for this NPC (stored in dialogs collection)
 	DialogID string `bson:"dialogID,omitempty" json:"dialogID,omitempty"`
@@ -69,6 +84,15 @@ type NPC struct {
 	Updated time.Time `bson:"updated,omitempty" json:"updated,omitempty"`
 }
 
+// PatrolMode defines how an NPC walks its patrol path
+type PatrolMode string
+
+// patrol modes
+const (
+	PatrolModeLoop     PatrolMode = "loop"
+	PatrolModePingPong PatrolMode = "pingpong"
+)
+
 // IsEnemy returns true if this NPC has enemy behavior
 func (npc *NPC) IsEnemy() bool {
 	return npc.EnemyTrait != nil
@@ -115,3 +139,51 @@ func (npc *NPC) ShouldRespawn() bool {
 	}
 	return time.Since(npc.DeathTime) >= npc.RespawnTime
 }
+
+// IsPatrolling returns true if this NPC follows a patrol path
+func (npc *NPC) IsPatrolling() bool {
+	return len(npc.PatrolPath) > 0
+}
+
+// IsWandering returns true if this NPC roams around its spawn room
+func (npc *NPC) IsWandering() bool {
+	return npc.WanderRadius > 0 && !npc.IsPatrolling()
+}
+
+// NextPatrolStep returns the index of the next patrol room and the walking direction after the step
+// An NPC that is not at its current patrol room first walks back to it
+func (npc *NPC) NextPatrolStep() (index int, reverse bool) {
+	count := len(npc.PatrolPath)
+	if count == 0 {
+		return 0, false
+	}
+
+	index, reverse = npc.PatrolIndex, npc.PatrolReverse
+	if index < 0 || index >= count {
+		return 0, false
+	}
+	if npc.CurrentRoomID != npc.PatrolPath[index] || count == 1 {
+		return index, reverse
+	}
+
+	if npc.PatrolMode != PatrolModePingPong {
+		return (index + 1) % count, false
+	}
+
+	if reverse && index == 0 {
+		reverse = false
+	} else if !reverse && index == count-1 {
+		reverse = true
+	}
+	if reverse {
+		return index - 1, true
+	}
+	return index + 1, false
+}
+
+// ResetMovement clears the patrol progress, used on respawn at the spawn room
+func (npc *NPC) ResetMovement() {
+	npc.PatrolIndex = 0
+	npc.PatrolReverse = false
+	npc.LastMoveTime = time.Time{}
+}
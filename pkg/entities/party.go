@@ -23,4 +23,74 @@ type Party struct {
 	Name       string    `json:"name"`
 	Created    time.Time `json:"created,omitempty"`
 	Characters []string  `json:"characters,omitempty"`
+
+	// LeaderID is the character that can invite and kick members
+	LeaderID string `json:"leaderId,omitempty"`
+	// Invites contains the IDs of invited characters that did not accept yet
+	Invites []string `json:"invites,omitempty"`
+}
+
+// HasMember returns true if the character is a member of the party
+func (party *Party) HasMember(characterID string) bool {
+	return containsString(party.Characters, characterID)
+}
+
+// IsInvited returns true if the character has a pending invite
+func (party *Party) IsInvited(characterID string) bool {
+	return containsString(party.Invites, characterID)
+}
+
+// IsLeader returns true if the character leads the party
+func (party *Party) IsLeader(characterID string) bool {
+	return party.LeaderID == characterID
+}
+
+// AddInvite adds a pending invite for the character
+func (party *Party) AddInvite(characterID string) {
+	if !party.IsInvited(characterID) {
+		party.Invites = append(party.Invites, characterID)
+	}
+}
+
+// RemoveInvite removes a pending invite of the character
+func (party *Party) RemoveInvite(characterID string) {
+	party.Invites = removeString(party.Invites, characterID)
+}
+
+// AddMember adds the character to the party and removes a pending invite
+func (party *Party) AddMember(characterID string) {
+	party.RemoveInvite(characterID)
+	if !party.HasMember(characterID) {
+		party.Characters = append(party.Characters, characterID)
+	}
+}
+
+// RemoveMember removes the character from the party, the next member becomes leader if the leader left
+func (party *Party) RemoveMember(characterID string) {
+	party.Characters = removeString(party.Characters, characterID)
+	if party.IsLeader(characterID) {
+		party.LeaderID = ""
+		if len(party.Characters) > 0 {
+			party.LeaderID = party.Characters[0]
+		}
+	}
+}
+
+func containsString(list []string, value string) bool {
+	for _, entry := range list {
+		if entry == value {
+			return true
+		}
+	}
+	return false
+}
+
+func removeString(list []string, value string) []string {
+	result := make([]string, 0, len(list))
+	for _, entry := range list {
+		if entry != value {
+			result = append(result, entry)
+		}
+	}
+	return result
 }
@@ -0,0 +1,101 @@
+package quests
+
+import (
+	"github.com/talesmud/talesmud/pkg/entities"
+)
+
+// ObjectiveType defines what a player has to do to fulfill a quest objective
+type ObjectiveType string
+
+const (
+	// ObjectiveTypeKill requires killing NPCs spawned from a template (or a unique NPC)
+	ObjectiveTypeKill ObjectiveType = "kill"
+	// ObjectiveTypeCollect requires picking up items created from a template
+	ObjectiveTypeCollect ObjectiveType = "collect"
+	// ObjectiveTypeVisit requires entering a room
+	ObjectiveTypeVisit ObjectiveType = "visit"
+	// ObjectiveTypeTalk requires talking to an NPC
+	ObjectiveTypeTalk ObjectiveType = "talk"
+)
+
+// ObjectiveTypes returns all available objective types
+func ObjectiveTypes() []ObjectiveType {
+	return []ObjectiveType{
+		ObjectiveTypeKill,
+		ObjectiveTypeCollect,
+		ObjectiveTypeVisit,
+		ObjectiveTypeTalk,
+	}
+}
+
+// Objective is a single step of a quest
+type Objective struct {
+	// ID identifies the objective within the quest
+	ID string `bson:"id" json:"id"`
+	// Type is the kind of objective
+	Type ObjectiveType `bson:"type" json:"type"`
+	// TargetID is the NPC template, item template or room ID depending on the type
+	TargetID string `bson:"targetId" json:"targetId"`
+	// Count is how often the objective has to be fulfilled (defaults to 1)
+	Count int32 `bson:"count,omitempty" json:"count,omitempty"`
+	// Description is shown in the quest journal
+	Description string `bson:"description,omitempty" json:"description,omitempty"`
+}
+
+// RequiredCount returns the number of times the objective has to be fulfilled
+func (o *Objective) RequiredCount() int32 {
+	if o.Count <= 0 {
+		return 1
+	}
+	return o.Count
+}
+
+// Rewards are granted when a quest is completed
+type Rewards struct {
+	XP              int32    `bson:"xp,omitempty" json:"xp,omitempty"`
+	Gold            int64    `bson:"gold,omitempty" json:"gold,omitempty"`
+	ItemTemplateIDs []string `bson:"itemTemplateIds,omitempty" json:"itemTemplateIds,omitempty"`
+}
+
+// Quest defines a quest that characters can accept
+type Quest struct {
+	*entities.Entity `bson:",inline"`
+
+	Name        string `bson:"name" json:"name"`
+	Description string `bson:"description,omitempty" json:"description,omitempty"`
+
+	// GiverNPCID is the NPC (template or unique NPC) offering the quest, empty quests can only be started by scripts
+	GiverNPCID string `bson:"giverNpcId,omitempty" json:"giverNpcId,omitempty"`
+	// MinLevel is the minimum character level required to accept the quest
+	MinLevel int32 `bson:"minLevel,omitempty" json:"minLevel,omitempty"`
+	// PrerequisiteQuestIDs must be completed before the quest can be accepted
+	PrerequisiteQuestIDs []string `bson:"prerequisiteQuestIds,omitempty" json:"prerequisiteQuestIds,omitempty"`
+	// Repeatable quests can be accepted again after completion
+	Repeatable bool `bson:"repeatable,omitempty" json:"repeatable,omitempty"`
+
+	Objectives []Objective `bson:"objectives" json:"objectives"`
+	Rewards    Rewards     `bson:"rewards" json:"rewards"`
+
+	Tags []string `bson:"tags,omitempty" json:"tags,omitempty"`
+}
+
+// Quests type alias for slice of Quest pointers
+type Quests []*Quest
+
+// NewQuest creates a new quest with default values
+func NewQuest() *Quest {
+	return &Quest{
+		Entity:     entities.NewEntity(),
+		Objectives: make([]Objective, 0),
+	}
+}
+
+// GetObjective returns the objective with the given ID
+func (q *Quest) GetObjective(id string) *Objective {
+	for i := range q.Objectives {
+		if q.Objectives[i].ID == id {
+			return &q.Objectives[i]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,104 @@
+package quests
+
+import (
+	"time"
+)
+
+// QuestState is the state of a quest in a character's quest log
+type QuestState string
+
+const (
+	// QuestStateActive quest has been accepted and is in progress
+	QuestStateActive QuestState = "active"
+	// QuestStateCompleted all objectives were fulfilled and rewards were granted
+	QuestStateCompleted QuestState = "completed"
+	// QuestStateFailed quest was failed (e.g. by a script)
+	QuestStateFailed QuestState = "failed"
+)
+
+// QuestProgress tracks the progress of a single quest for a character
+type QuestProgress struct {
+	QuestID string     `bson:"questId" json:"questId"`
+	State   QuestState `bson:"state" json:"state"`
+
+	// Objectives maps objective IDs to the current count
+	Objectives map[string]int32 `bson:"objectives" json:"objectives"`
+
+	StartedAt   time.Time `bson:"startedAt" json:"startedAt"`
+	CompletedAt time.Time `bson:"completedAt,omitempty" json:"completedAt,omitempty"`
+}
+
+// NewQuestProgress creates progress tracking for a newly accepted quest
+func NewQuestProgress(questID string) *QuestProgress {
+	return &QuestProgress{
+		QuestID:    questID,
+		State:      QuestStateActive,
+		Objectives: make(map[string]int32),
+		StartedAt:  time.Now(),
+	}
+}
+
+// IsObjectiveDone returns true if the objective reached its required count
+func (p *QuestProgress) IsObjectiveDone(objective *Objective) bool {
+	return p.Objectives[objective.ID] >= objective.RequiredCount()
+}
+
+// IsComplete returns true if all objectives of the quest are done
+func (p *QuestProgress) IsComplete(quest *Quest) bool {
+	for i := range quest.Objectives {
+		if !p.IsObjectiveDone(&quest.Objectives[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// QuestLog contains the quests of a character
+type QuestLog []*QuestProgress
+
+// Find returns the progress of a quest, nil if the quest was never accepted
+func (l QuestLog) Find(questID string) *QuestProgress {
+	for _, p := range l {
+		if p.QuestID == questID {
+			return p
+		}
+	}
+	return nil
+}
+
+// Active returns all quests currently in progress
+func (l QuestLog) Active() []*QuestProgress {
+	return l.withState(QuestStateActive)
+}
+
+// Completed returns all completed quests
+func (l QuestLog) Completed() []*QuestProgress {
+	return l.withState(QuestStateCompleted)
+}
+
+// HasCompleted returns true if the quest was completed at least once
+func (l QuestLog) HasCompleted(questID string) bool {
+	p := l.Find(questID)
+	return p != nil && p.State == QuestStateCompleted
+}
+
+func (l QuestLog) withState(state QuestState) []*QuestProgress {
+	result := make([]*QuestProgress, 0)
+	for _, p := range l {
+		if p.State == state {
+			result = append(result, p)
+		}
+	}
+	return result
+}
+
+// Remove removes the progress of a quest from the log
+func (l QuestLog) Remove(questID string) QuestLog {
+	result := make(QuestLog, 0, len(l))
+	for _, p := range l {
+		if p.QuestID != questID {
+			result = append(result, p)
+		}
+	}
+	return result
+}
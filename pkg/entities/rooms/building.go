@@ -0,0 +1,162 @@
+package rooms
+
+import (
+	"errors"
+	"strings"
+)
+
+// oppositeDirections maps every exit direction to the direction leading back
+var oppositeDirections = map[string]string{
+	"north":     "south",
+	"south":     "north",
+	"east":      "west",
+	"west":      "east",
+	"northeast": "southwest",
+	"southwest": "northeast",
+	"northwest": "southeast",
+	"southeast": "northwest",
+	"up":        "down",
+	"down":      "up",
+	"in":        "out",
+	"out":       "in",
+}
+
+// OppositeDirection returns the direction leading back, empty if the exit name is no direction
+func OppositeDirection(direction string) string {
+	return oppositeDirections[strings.ToLower(direction)]
+}
+
+// DirectionOffset returns the coordinate change of a step in the direction, false if the exit name is no direction
+func DirectionOffset(direction string) (x, y, z int32, ok bool) {
+	switch strings.ToLower(direction) {
+	case "north":
+		return 0, 1, 0, true
+	case "south":
+		return 0, -1, 0, true
+	case "east":
+		return 1, 0, 0, true
+	case "west":
+		return -1, 0, 0, true
+	case "northeast":
+		return 1, 1, 0, true
+	case "northwest":
+		return -1, 1, 0, true
+	case "southeast":
+		return 1, -1, 0, true
+	case "southwest":
+		return -1, -1, 0, true
+	case "up":
+		return 0, 0, 1, true
+	case "down":
+		return 0, 0, -1, true
+	}
+	return 0, 0, 0, false
+}
+
+// FindExit returns the exit with the name (case insensitive), nil if there is none
+func (room *Room) FindExit(name string) *Exit {
+	if room.Exits == nil {
+		return nil
+	}
+	for i := range *room.Exits {
+		if strings.EqualFold((*room.Exits)[i].Name, name) {
+			return &(*room.Exits)[i]
+		}
+	}
+	return nil
+}
+
+// AddExit adds an exit, fails if the room already has an exit with the name
+func (room *Room) AddExit(exit Exit) error {
+	if room.FindExit(exit.Name) != nil {
+		return errors.New("exit already exists")
+	}
+	if room.Exits == nil {
+		room.Exits = &Exits{}
+	}
+	modified := append(*room.Exits, exit)
+	room.Exits = &modified
+	return nil
+}
+
+// RemoveExit removes the exit with the name and returns it, nil if there is none
+func (room *Room) RemoveExit(name string) *Exit {
+	exit := room.FindExit(name)
+	if exit == nil {
+		return nil
+	}
+	removed := *exit
+
+	exitsNew := make(Exits, 0, len(*room.Exits))
+	for _, e := range *room.Exits {
+		if !strings.EqualFold(e.Name, name) {
+			exitsNew = append(exitsNew, e)
+		}
+	}
+	room.Exits = &exitsNew
+	return &removed
+}
+
+// FindAction returns the action with the name (case insensitive), nil if there is none
+func (room *Room) FindAction(name string) *Action {
+	if room.Actions == nil {
+		return nil
+	}
+	for i := range *room.Actions {
+		if strings.EqualFold((*room.Actions)[i].Name, name) {
+			return &(*room.Actions)[i]
+		}
+	}
+	return nil
+}
+
+// SetAction adds the action or replaces the action with the same name
+func (room *Room) SetAction(action Action) {
+	if existing := room.FindAction(action.Name); existing != nil {
+		*existing = action
+		return
+	}
+	if room.Actions == nil {
+		room.Actions = &Actions{}
+	}
+	modified := append(*room.Actions, action)
+	room.Actions = &modified
+}
+
+// RemoveAction removes the action with the name, returns false if there is none
+func (room *Room) RemoveAction(name string) bool {
+	if room.FindAction(name) == nil {
+		return false
+	}
+	actionsNew := make(Actions, 0, len(*room.Actions))
+	for _, a := range *room.Actions {
+		if !strings.EqualFold(a.Name, name) {
+			actionsNew = append(actionsNew, a)
+		}
+	}
+	room.Actions = &actionsNew
+	return true
+}
+
+// SetMood sets the mood the client shows for the room, an empty mood removes it
+func (room *Room) SetMood(mood string) {
+	if room.Meta == nil {
+		if mood == "" {
+			return
+		}
+		room.Meta = &struct {
+			Mood       string `bson:"mood,omitempty" json:"mood,omitempty"`
+			Background string `bson:"background,omitempty" json:"background,omitempty"`
+		}{}
+	}
+	room.Meta.Mood = mood
+}
+
+// SetCoords places the room on the grid
+func (room *Room) SetCoords(x, y, z int32) {
+	room.Coords = &struct {
+		X int32 `bson:"x" json:"x"`
+		Y int32 `bson:"y" json:"y"`
+		Z int32 `bson:"z" json:"z"`
+	}{X: x, Y: y, Z: z}
+}
@@ -0,0 +1,114 @@
+package rooms
+
+import (
+	"strings"
+	"time"
+)
+
+// DoorState type
+type DoorState string
+
+const (
+	DoorStateOpen   DoorState = "open"
+	DoorStateClosed DoorState = "closed"
+	DoorStateLocked DoorState = "locked"
+)
+
+// Door makes an exit closable and lockable, both sides of an exit pair share the door state
+type Door struct {
+	// Name is used in messages and to target the door, defaults to "door"
+	Name   string `bson:"name,omitempty" json:"name,omitempty"`
+	Closed bool   `bson:"closed" json:"closed"`
+	Locked bool   `bson:"locked" json:"locked"`
+	// KeyID is the item template ID of the key, doors without key can't be locked or unlocked
+	KeyID string `bson:"keyId,omitempty" json:"keyId,omitempty"`
+	// PickDifficulty is the DC of the pick lock check (1d20 + DEX modifier), 0 = can't be picked
+	PickDifficulty int32 `bson:"pickDifficulty,omitempty" json:"pickDifficulty,omitempty"`
+
+	// the door goes back to its default state ResetMinutes after the last change (0 = never)
+	DefaultClosed bool      `bson:"defaultClosed" json:"defaultClosed"`
+	DefaultLocked bool      `bson:"defaultLocked" json:"defaultLocked"`
+	ResetMinutes  int32     `bson:"resetMinutes,omitempty" json:"resetMinutes,omitempty"`
+	ChangedAt     time.Time `bson:"changedAt,omitempty" json:"changedAt,omitempty"`
+}
+
+// DisplayName returns the name of the door used in messages
+func (door *Door) DisplayName() string {
+	if door.Name == "" {
+		return "door"
+	}
+	return door.Name
+}
+
+// State returns the current door state
+func (door *Door) State() DoorState {
+	if door.Locked {
+		return DoorStateLocked
+	}
+	if door.Closed {
+		return DoorStateClosed
+	}
+	return DoorStateOpen
+}
+
+// SetState changes the door state and remembers when it changed
+func (door *Door) SetState(closed, locked bool, now time.Time) {
+	door.Closed = closed
+	door.Locked = closed && locked
+	door.ChangedAt = now
+}
+
+// NeedsReset returns true if the reset time passed and the door is not in its default state
+func (door *Door) NeedsReset(now time.Time) bool {
+	if door.ResetMinutes <= 0 {
+		return false
+	}
+	if door.Closed == door.DefaultClosed && door.Locked == door.DefaultLocked {
+		return false
+	}
+	return now.Sub(door.ChangedAt) >= time.Duration(door.ResetMinutes)*time.Minute
+}
+
+// Reset puts the door back into its default state, returns false if it did not need a reset
+func (door *Door) Reset(now time.Time) bool {
+	if !door.NeedsReset(now) {
+		return false
+	}
+	door.SetState(door.DefaultClosed, door.DefaultLocked, now)
+	return true
+}
+
+// CanPass returns true if characters can walk through the exit
+func (exit *Exit) CanPass() bool {
+	return exit.Door == nil || !exit.Door.Closed
+}
+
+// FindDoor returns the exit with a door matching the exit name or the door name
+func (room *Room) FindDoor(name string) *Exit {
+	if room.Exits == nil {
+		return nil
+	}
+	name = strings.ToLower(strings.TrimSpace(name))
+	for i, exit := range *room.Exits {
+		if exit.Door == nil {
+			continue
+		}
+		if strings.ToLower(exit.Name) == name || strings.ToLower(exit.Door.DisplayName()) == name {
+			return &(*room.Exits)[i]
+		}
+	}
+	return nil
+}
+
+// ExitTo returns the first exit leading to the target room
+func (room *Room) ExitTo(targetID string) *Exit {
+	if room.Exits == nil {
+		return nil
+	}
+	for i, exit := range *room.Exits {
+		if exit.Target == targetID {
+			return &(*room.Exits)[i]
+		}
+	}
+	return nil
+}
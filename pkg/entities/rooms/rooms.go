@@ -2,6 +2,7 @@ package rooms
 
 import (
 	"errors"
+	"strings"
 
 	"github.com/talesmud/talesmud/pkg/entities"
 	"github.com/talesmud/talesmud/pkg/entities/traits"
@@ -44,6 +45,7 @@ type Exit struct {
 	Type        RoomExitType           `bson:"type,omitempty" json:"type,omitempty"`
 	Hidden      bool                   `bson:"hidden,omitempty" json:"hidden,omitempty"`
 	Target      string                 `bson:"target,omitempty" json:"target,omitempty"`
+	Door        *Door                  `bson:"door,omitempty" json:"door,omitempty"`
 	Params      map[string]interface{} `bson:"params,omitempty" json:"params"`
 }
 
@@ -107,6 +109,19 @@ type Room struct {
 //Rooms type
 type Rooms []*Room
 
+// TagPostOffice marks rooms where mail attachments can be collected
+const TagPostOffice = "post_office"
+
+//HasTag returns true if the room has the tag, case insensitive
+func (room *Room) HasTag(tag string) bool {
+	for _, t := range room.Tags {
+		if strings.EqualFold(t, tag) {
+			return true
+		}
+	}
+	return false
+}
+
 //GetExit ...
 func (room *Room) GetExit(exit string) (Exit, bool) {
 
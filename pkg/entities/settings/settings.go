@@ -1,12 +1,18 @@
 package settings
 
-import "github.com/talesmud/talesmud/pkg/entities"
+import (
+	"github.com/talesmud/talesmud/pkg/entities"
+	"github.com/talesmud/talesmud/pkg/entities/characters"
+)
 
 // ServerSettings holds global server configuration.
 type ServerSettings struct {
 	*entities.Entity `json:",inline"`
 	ServerName       string `json:"serverName"`
 	About            string `json:"about"`
+
+	// Progression is the XP curve used for level-ups, the default curve is used if not set
+	Progression *characters.ProgressionCurve `json:"progression,omitempty"`
 }
 
 // NewDefaultServerSettings returns settings with default values.
@@ -14,8 +20,17 @@ func NewDefaultServerSettings() *ServerSettings {
 	e := entities.NewEntity()
 	e.ID = "server-settings"
 	return &ServerSettings{
-		Entity:     e,
-		ServerName: "TalesMUD",
-		About:      "",
+		Entity:      e,
+		ServerName:  "TalesMUD",
+		About:       "",
+		Progression: characters.DefaultProgressionCurve(),
+	}
+}
+
+// ProgressionCurve returns the configured XP curve or the default curve if none or an invalid one is set
+func (s *ServerSettings) ProgressionCurve() *characters.ProgressionCurve {
+	if s == nil || s.Progression == nil || s.Progression.Validate() != nil {
+		return characters.DefaultProgressionCurve()
 	}
+	return s.Progression
 }
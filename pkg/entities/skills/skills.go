@@ -1,6 +1,51 @@
 package skills
 
-import "github.com/talesmud/talesmud/pkg/entities"
+import (
+	"math/rand"
+	"time"
+
+	"github.com/talesmud/talesmud/pkg/entities"
+)
+
+// ResourceType is the resource pool a skill consumes
+type ResourceType string
+
+// resource types
+const (
+	ResourceTypeNone    ResourceType = ""
+	ResourceTypeMana    ResourceType = "mana"
+	ResourceTypeStamina ResourceType = "stamina"
+)
+
+// TargetType defines who a skill can be used on
+type TargetType string
+
+// target types
+const (
+	TargetTypeEnemy      TargetType = "enemy"       // single opponent
+	TargetTypeAllEnemies TargetType = "all_enemies" // every living opponent
+	TargetTypeSelf       TargetType = "self"        // the caster
+	TargetTypeAlly       TargetType = "ally"        // the caster or a party member
+)
+
+// EffectType defines what a skill does to its targets
+type EffectType string
+
+// effect types
+const (
+	EffectTypeDamage EffectType = "damage"
+	EffectTypeHeal   EffectType = "heal"
+)
+
+// Formula calculates the amount of damage or healing:
+// Base + 1dDice + AttributeMultiplier * attribute modifier + LevelMultiplier * level
+type Formula struct {
+	Base                int32   `bson:"base" json:"base"`
+	Dice                int32   `bson:"dice,omitempty" json:"dice,omitempty"`
+	Attribute           string  `bson:"attribute,omitempty" json:"attribute,omitempty"` // short attribute name (str, dex, int, wis)
+	AttributeMultiplier float64 `bson:"attributeMultiplier,omitempty" json:"attributeMultiplier,omitempty"`
+	LevelMultiplier     float64 `bson:"levelMultiplier,omitempty" json:"levelMultiplier,omitempty"`
+}
 
 //Skill ... type
 type Skill struct {
@@ -8,4 +53,74 @@ type Skill struct {
 
 	Name        string `bson:"name,omitempty" json:"name"`
 	Description string `bson:"description,omitempty" json:"description"`
+
+	// Requirements, an empty class list makes the skill available to all classes
+	ClassIDs []string `bson:"classIds,omitempty" json:"classIds,omitempty"`
+	MinLevel int32    `bson:"minLevel,omitempty" json:"minLevel,omitempty"`
+
+	// Costs
+	ResourceType ResourceType `bson:"resourceType,omitempty" json:"resourceType,omitempty"`
+	Cost         int32        `bson:"cost,omitempty" json:"cost,omitempty"`
+	CooldownSec  int32        `bson:"cooldownSec,omitempty" json:"cooldownSec,omitempty"`
+
+	// Effect
+	Target        TargetType `bson:"target" json:"target"`
+	Effect        EffectType `bson:"effect" json:"effect"`
+	Formula       Formula    `bson:"formula" json:"formula"`
+	IgnoreDefense bool       `bson:"ignoreDefense,omitempty" json:"ignoreDefense,omitempty"`
+
+	// ScriptID is an optional Lua script executed after the skill resolved
+	ScriptID string `bson:"scriptId,omitempty" json:"scriptId,omitempty"`
+
+	Tags []string `bson:"tags,omitempty" json:"tags,omitempty"`
+}
+
+// IsHostile returns true if the skill targets opponents
+func (s *Skill) IsHostile() bool {
+	return s.Target == TargetTypeEnemy || s.Target == TargetTypeAllEnemies
+}
+
+// IsAvailableTo returns true if a character with the given class and level can use the skill
+func (s *Skill) IsAvailableTo(classID string, level int32) bool {
+	if level < s.MinLevel {
+		return false
+	}
+	if len(s.ClassIDs) == 0 {
+		return true
+	}
+	for _, id := range s.ClassIDs {
+		if id == classID {
+			return true
+		}
+	}
+	return false
+}
+
+// RollAmount rolls the damage or healing amount for a caster with the given attribute modifier and level
+func (s *Skill) RollAmount(attributeModifier int, level int32) int32 {
+	amount := float64(s.Formula.Base)
+	if s.Formula.Dice > 0 {
+		amount += float64(rand.Int31n(s.Formula.Dice) + 1)
+	}
+	amount += s.Formula.AttributeMultiplier * float64(attributeModifier)
+	amount += s.Formula.LevelMultiplier * float64(level)
+	if amount < 1 {
+		return 1
+	}
+	return int32(amount)
+}
+
+// Cooldown returns the cooldown duration of the skill
+func (s *Skill) Cooldown() time.Duration {
+	return time.Duration(s.CooldownSec) * time.Second
+}
+
+// TargetTypes returns all available target types
+func TargetTypes() []TargetType {
+	return []TargetType{TargetTypeEnemy, TargetTypeAllEnemies, TargetTypeSelf, TargetTypeAlly}
+}
+
+// EffectTypes returns all available effect types
+func EffectTypes() []EffectType {
+	return []EffectType{EffectTypeDamage, EffectTypeHeal}
 }
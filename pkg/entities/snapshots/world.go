@@ -0,0 +1,46 @@
+package snapshots
+
+import (
+	"time"
+
+	"github.com/talesmud/talesmud/pkg/entities"
+	"github.com/talesmud/talesmud/pkg/entities/combat"
+	npc "github.com/talesmud/talesmud/pkg/entities/npcs"
+)
+
+// WorldSnapshotID is the ID of the singleton world snapshot
+const WorldSnapshotID = "world-snapshot"
+
+// SpawnerSnapshot contains the runtime state of an NPC spawner
+type SpawnerSnapshot struct {
+	ActiveInstances []string  `json:"activeInstances"`
+	LastSpawnTime   time.Time `json:"lastSpawnTime"`
+}
+
+// WorldSnapshot contains the live world state that is kept in memory while the server runs
+// (NPC instances, spawner state and active fights) so it can be restored after a restart
+type WorldSnapshot struct {
+	*entities.Entity `json:",inline"`
+
+	SavedAt time.Time `json:"savedAt"`
+
+	// NPCInstances contains spawned instances and registered residents including dead ones awaiting respawn
+	NPCInstances []*npc.NPC `json:"npcInstances"`
+	// Spawners maps spawner IDs to their runtime state
+	Spawners map[string]*SpawnerSnapshot `json:"spawners"`
+	// CombatInstances contains all active fights
+	CombatInstances []*combat.CombatInstance `json:"combatInstances"`
+}
+
+// NewWorldSnapshot creates an empty world snapshot
+func NewWorldSnapshot() *WorldSnapshot {
+	e := entities.NewEntity()
+	e.ID = WorldSnapshotID
+	return &WorldSnapshot{
+		Entity:          e,
+		SavedAt:         time.Now(),
+		NPCInstances:    make([]*npc.NPC, 0),
+		Spawners:        make(map[string]*SpawnerSnapshot),
+		CombatInstances: make([]*combat.CombatInstance, 0),
+	}
+}
@@ -0,0 +1,147 @@
+package trades
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// MaxTradeItems limits the number of items one side can offer
+const MaxTradeItems = 10
+
+// TradeItem is an inventory item offered in a trade, stacks are traded as a whole
+type TradeItem struct {
+	ItemID   string `json:"itemId"`
+	Name     string `json:"name"`
+	Quantity int32  `json:"quantity,omitempty"`
+}
+
+// TradeSide contains the offer of one character
+type TradeSide struct {
+	CharacterID string      `json:"characterId"`
+	UserID      string      `json:"userId"`
+	Name        string      `json:"name"`
+	Items       []TradeItem `json:"items"`
+	Gold        int64       `json:"gold"`
+	Confirmed   bool        `json:"confirmed"`
+}
+
+// Trade is a trade window between two players in the same room
+// It starts as a request of the initiator and opens once the partner accepts it
+type Trade struct {
+	ID        string    `json:"id"`
+	RoomID    string    `json:"roomId"`
+	Initiator TradeSide `json:"initiator"`
+	Partner   TradeSide `json:"partner"`
+	// Open is set once the partner accepted the trade request
+	Open    bool      `json:"open"`
+	Created time.Time `json:"created"`
+}
+
+// NewTrade creates a trade request of the initiator to the partner
+func NewTrade(roomID string, initiator, partner TradeSide) *Trade {
+	initiator.Items = make([]TradeItem, 0)
+	partner.Items = make([]TradeItem, 0)
+	return &Trade{
+		ID:        uuid.New().String(),
+		RoomID:    roomID,
+		Initiator: initiator,
+		Partner:   partner,
+		Created:   time.Now(),
+	}
+}
+
+// Involves returns true if the character takes part in the trade
+func (t *Trade) Involves(characterID string) bool {
+	return t.Initiator.CharacterID == characterID || t.Partner.CharacterID == characterID
+}
+
+// Side returns the offer of the character, nil if the character does not take part
+func (t *Trade) Side(characterID string) *TradeSide {
+	switch characterID {
+	case t.Initiator.CharacterID:
+		return &t.Initiator
+	case t.Partner.CharacterID:
+		return &t.Partner
+	}
+	return nil
+}
+
+// OtherSide returns the offer of the trade partner of the character
+func (t *Trade) OtherSide(characterID string) *TradeSide {
+	switch characterID {
+	case t.Initiator.CharacterID:
+		return &t.Partner
+	case t.Partner.CharacterID:
+		return &t.Initiator
+	}
+	return nil
+}
+
+// AddItem adds an item to the offer of the character, changing an offer resets both confirmations
+func (t *Trade) AddItem(characterID string, item TradeItem) error {
+	side := t.Side(characterID)
+	if side == nil {
+		return errors.New("not part of this trade")
+	}
+	for _, offered := range side.Items {
+		if offered.ItemID == item.ItemID {
+			return errors.New("already offered")
+		}
+	}
+	if len(side.Items) >= MaxTradeItems {
+		return errors.New("you can't offer more items")
+	}
+	side.Items = append(side.Items, item)
+	t.ResetConfirmations()
+	return nil
+}
+
+// RemoveItem removes an offered item by name prefix and returns it
+func (t *Trade) RemoveItem(characterID string, name string) *TradeItem {
+	side := t.Side(characterID)
+	if side == nil {
+		return nil
+	}
+	nameLower := strings.ToLower(name)
+	for i, offered := range side.Items {
+		if strings.HasPrefix(strings.ToLower(offered.Name), nameLower) {
+			side.Items = append(side.Items[:i], side.Items[i+1:]...)
+			t.ResetConfirmations()
+			return &offered
+		}
+	}
+	return nil
+}
+
+// SetGold sets the gold offered by the character
+func (t *Trade) SetGold(characterID string, gold int64) {
+	if side := t.Side(characterID); side != nil && side.Gold != gold {
+		side.Gold = gold
+		t.ResetConfirmations()
+	}
+}
+
+// Confirm confirms the current offers for the character, returns true if both sides confirmed
+func (t *Trade) Confirm(characterID string) bool {
+	if side := t.Side(characterID); side != nil {
+		side.Confirmed = true
+	}
+	return t.Initiator.Confirmed && t.Partner.Confirmed
+}
+
+// ResetConfirmations clears both confirmations so nobody agrees to an offer they did not see
+func (t *Trade) ResetConfirmations() {
+	t.Initiator.Confirmed = false
+	t.Partner.Confirmed = false
+}
+
+// Copy returns a copy of the trade that can be read without holding the trade manager lock
+func (t *Trade) Copy() *Trade {
+	copied := *t
+	copied.Initiator.Items = append([]TradeItem(nil), t.Initiator.Items...)
+	copied.Partner.Items = append([]TradeItem(nil), t.Partner.Items...)
+	return &copied
+}
@@ -1,6 +1,10 @@
 package entities
 
-import "time"
+import (
+	"time"
+
+	"golang.org/x/crypto/bcrypt"
+)
 
 // Role constants for access levels
 const (
@@ -45,6 +49,9 @@ type User struct {
 
 	// BannedEmail stores the email at the time of banning (for email-based ban enforcement)
 	BannedEmail string `json:"bannedEmail,omitempty"`
+
+	// TelnetPasswordHash is the bcrypt hash of the password used for telnet logins (web logins use Auth0)
+	TelnetPasswordHash string `json:"telnetPasswordHash,omitempty"`
 }
 
 // NewUser creates a new user
@@ -70,3 +77,37 @@ func (u *User) IsCreator() bool {
 	role := u.GetRole()
 	return role == RoleCreator || role == RoleAdmin
 }
+
+// HasRole returns true if the user has the role or a higher one (player < creator < admin)
+func (u *User) HasRole(role string) bool {
+	switch role {
+	case RoleAdmin:
+		return u.IsAdmin()
+	case RoleCreator:
+		return u.IsCreator()
+	}
+	return true
+}
+
+// SetTelnetPassword hashes and stores the password used for telnet logins
+func (u *User) SetTelnetPassword(password string) error {
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return err
+	}
+	u.TelnetPasswordHash = string(hash)
+	return nil
+}
+
+// HasTelnetPassword returns true if the user can log in via telnet
+func (u *User) HasTelnetPassword() bool {
+	return u.TelnetPasswordHash != ""
+}
+
+// CheckTelnetPassword compares the given password with the stored telnet password hash
+func (u *User) CheckTelnetPassword(password string) bool {
+	if u.TelnetPasswordHash == "" {
+		return false
+	}
+	return bcrypt.CompareHashAndPassword([]byte(u.TelnetPasswordHash), []byte(password)) == nil
+}
@@ -6,7 +6,9 @@ import (
 	"github.com/talesmud/talesmud/pkg/entities/dialogs"
 	"github.com/talesmud/talesmud/pkg/entities/items"
 	npc "github.com/talesmud/talesmud/pkg/entities/npcs"
+	"github.com/talesmud/talesmud/pkg/entities/quests"
 	"github.com/talesmud/talesmud/pkg/entities/rooms"
+	"github.com/talesmud/talesmud/pkg/entities/skills"
 	"github.com/talesmud/talesmud/pkg/scripts"
 )
 
@@ -22,4 +24,6 @@ type Data struct {
 	Dialogs    []*dialogs.Dialog       `json:"dialogs"`
 	Parties    []*e.Party              `json:"parties"`
 	LootTables []*items.LootTable      `json:"lootTables,omitempty"`
+	Quests     []*quests.Quest         `json:"quests,omitempty"`
+	Skills     []*skills.Skill         `json:"skills,omitempty"`
 }
@@ -1,11 +1,17 @@
 package importer
 
 import (
+	"fmt"
+	"strings"
+	"time"
+
 	"github.com/talesmud/talesmud/pkg/entities"
 	"github.com/talesmud/talesmud/pkg/entities/dialogs"
 	"github.com/talesmud/talesmud/pkg/entities/items"
 	npc "github.com/talesmud/talesmud/pkg/entities/npcs"
+	"github.com/talesmud/talesmud/pkg/entities/quests"
 	"github.com/talesmud/talesmud/pkg/entities/rooms"
+	"github.com/talesmud/talesmud/pkg/entities/skills"
 	"github.com/talesmud/talesmud/pkg/entities/traits"
 	"github.com/talesmud/talesmud/pkg/scripts"
 )
@@ -33,6 +39,7 @@ func (y *YAMLRoom) ToEntity() *rooms.Room {
 				Type:        rooms.RoomExitType(e.Type),
 				Description: e.Description,
 				Hidden:      e.Hidden,
+				Door:        e.Door.ToEntity(),
 			}
 		}
 		room.Exits = &exits
@@ -79,6 +86,23 @@ func (y *YAMLRoom) ToEntity() *rooms.Room {
 	return room
 }
 
+// ToEntity converts YAMLDoor to rooms.Door, nil if the exit has no door
+func (y *YAMLDoor) ToEntity() *rooms.Door {
+	if y == nil {
+		return nil
+	}
+	return &rooms.Door{
+		Name:           y.Name,
+		Closed:         y.Closed || y.Locked,
+		Locked:         y.Locked,
+		KeyID:          y.Key,
+		PickDifficulty: y.PickDifficulty,
+		DefaultClosed:  y.Closed || y.Locked,
+		DefaultLocked:  y.Locked,
+		ResetMinutes:   y.ResetMinutes,
+	}
+}
+
 // ToEntity converts a YAMLItem to an Item entity
 func (y *YAMLItem) ToEntity() *items.Item {
 	item := &items.Item{
@@ -98,6 +122,10 @@ func (y *YAMLItem) ToEntity() *items.Item {
 		Consumable:  y.Consumable,
 		Tags:        y.Tags,
 		OnUseScriptID: y.OnUseScript,
+		Closed:        y.Closed,
+		Locked:        y.Locked,
+		LockedBy:      y.LockedBy,
+		MaxItems:      y.MaxItems,
 	}
 
 	// Set meta if img is provided
@@ -122,8 +150,14 @@ func (y *YAMLNPC) ToEntity() *npc.NPC {
 		MaxHitPoints:     y.MaxHitPoints,
 		CurrentHitPoints: y.MaxHitPoints, // Start at full health
 		DialogID:         y.DialogID,
+		IdleDialogID:     y.IdleDialogID,
+		IdleDialogTimeout: time.Duration(y.IdleDialogTimeout) * time.Second,
 		IsTemplate:       true, // Imported NPCs are templates
 		State:            "idle",
+		WanderRadius:     y.WanderRadius,
+		PatrolPath:       y.PatrolPath,
+		PatrolMode:       npc.PatrolMode(strings.ToLower(y.PatrolMode)),
+		MoveInterval:     time.Duration(y.MoveInterval) * time.Second,
 	}
 
 	// Convert enemy trait if present
@@ -137,19 +171,25 @@ func (y *YAMLNPC) ToEntity() *npc.NPC {
 			AttackSpeed:   y.EnemyTrait.AttackSpeed,
 			AggroRadius:   y.EnemyTrait.AggroRadius,
 			AggroOnSight:  y.EnemyTrait.AggroOnSight,
+			AggroLevelGap: y.EnemyTrait.AggroLevelGap,
 			CallForHelp:   y.EnemyTrait.CallForHelp,
 			FleeThreshold: y.EnemyTrait.FleeThreshold,
 			XPReward:      y.EnemyTrait.XPReward,
 			LootTableID:   y.EnemyTrait.LootTableID,
+			OnAggroScript: y.EnemyTrait.OnAggroScript,
+			OnDeathScript: y.EnemyTrait.OnDeathScript,
+			OnFleeScript:  y.EnemyTrait.OnFleeScript,
 		}
 	}
 
 	// Convert merchant trait if present
 	if y.MerchantTrait != nil {
 		mt := &npc.MerchantTrait{
-			BuyMultiplier:  y.MerchantTrait.BuyRate,
-			SellMultiplier: y.MerchantTrait.SellRate,
-			Inventory:      make([]npc.MerchantItem, 0),
+			BuyMultiplier:   y.MerchantTrait.BuyRate,
+			SellMultiplier:  y.MerchantTrait.SellRate,
+			RestockMinutes:  y.MerchantTrait.RestockMinutes,
+			RestockVariance: y.MerchantTrait.RestockVariance,
+			Inventory:       make([]npc.MerchantItem, 0),
 		}
 		// Convert inventory items
 		for _, item := range y.MerchantTrait.Inventory {
@@ -171,7 +211,7 @@ func (y *YAMLNPC) ToEntity() *npc.NPC {
 
 // ToEntity converts a YAMLScript to a Script entity
 func (y *YAMLScript) ToEntity() *scripts.Script {
-	return &scripts.Script{
+	script := &scripts.Script{
 		Entity:      &entities.Entity{ID: y.ID},
 		Name:        y.Name,
 		Description: y.Description,
@@ -179,6 +219,15 @@ func (y *YAMLScript) ToEntity() *scripts.Script {
 		Language:    scripts.ScriptLanguage(y.Language),
 		Code:        y.Code,
 	}
+	for _, e := range y.Events {
+		script.Events = append(script.Events, scripts.ScriptEventHandler{
+			Event:    e.Event,
+			Priority: e.Priority,
+			Filter:   e.Filter,
+			Async:    e.Async,
+		})
+	}
+	return script
 }
 
 // ToEntity converts a YAMLDialog to a Dialog entity
@@ -297,3 +346,66 @@ func (y *YAMLLootTable) ToEntity() *items.LootTable {
 
 	return lt
 }
+
+// ToEntity converts a YAMLQuest to a Quest entity
+func (y *YAMLQuest) ToEntity() *quests.Quest {
+	q := &quests.Quest{
+		Entity:               &entities.Entity{ID: y.ID},
+		Name:                 y.Name,
+		Description:          y.Description,
+		GiverNPCID:           y.Giver,
+		MinLevel:             y.MinLevel,
+		PrerequisiteQuestIDs: y.Prerequisites,
+		Repeatable:           y.Repeatable,
+		Objectives:           make([]quests.Objective, 0, len(y.Objectives)),
+		Rewards: quests.Rewards{
+			XP:              y.Rewards.XP,
+			Gold:            y.Rewards.Gold,
+			ItemTemplateIDs: y.Rewards.Items,
+		},
+		Tags: y.Tags,
+	}
+
+	for i, o := range y.Objectives {
+		// objectives without an explicit ID are numbered in file order
+		id := o.ID
+		if id == "" {
+			id = fmt.Sprintf("%d", i+1)
+		}
+		q.Objectives = append(q.Objectives, quests.Objective{
+			ID:          id,
+			Type:        quests.ObjectiveType(o.Type),
+			TargetID:    o.Target,
+			Count:       o.Count,
+			Description: o.Description,
+		})
+	}
+
+	return q
+}
+
+// ToEntity converts a YAMLSkill to a Skill entity
+func (y *YAMLSkill) ToEntity() *skills.Skill {
+	return &skills.Skill{
+		Entity:       &entities.Entity{ID: y.ID},
+		Name:         y.Name,
+		Description:  y.Description,
+		ClassIDs:     y.Classes,
+		MinLevel:     y.MinLevel,
+		ResourceType: skills.ResourceType(y.Resource),
+		Cost:         y.Cost,
+		CooldownSec:  y.Cooldown,
+		Target:       skills.TargetType(y.Target),
+		Effect:       skills.EffectType(y.Effect),
+		Formula: skills.Formula{
+			Base:                y.Formula.Base,
+			Dice:                y.Formula.Dice,
+			Attribute:           y.Formula.Attribute,
+			AttributeMultiplier: y.Formula.AttributeMultiplier,
+			LevelMultiplier:     y.Formula.LevelMultiplier,
+		},
+		IgnoreDefense: y.IgnoreDefense,
+		ScriptID:      y.Script,
+		Tags:          y.Tags,
+	}
+}
@@ -0,0 +1,360 @@
+package importer
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/talesmud/talesmud/pkg/entities/dialogs"
+	"github.com/talesmud/talesmud/pkg/entities/items"
+	npc "github.com/talesmud/talesmud/pkg/entities/npcs"
+	"github.com/talesmud/talesmud/pkg/entities/quests"
+	"github.com/talesmud/talesmud/pkg/entities/rooms"
+	"github.com/talesmud/talesmud/pkg/entities/skills"
+	"github.com/talesmud/talesmud/pkg/scripts"
+)
+
+// The FromEntity converters are the inverse of ToEntity: converting an exported
+// entity back with ToEntity gives the same YAML again
+
+// RoomFromEntity converts a Room entity to a YAMLRoom, live data like characters and dropped items is not exported
+func RoomFromEntity(room *rooms.Room) *YAMLRoom {
+	y := &YAMLRoom{
+		ID:          room.ID,
+		Name:        room.Name,
+		Description: room.Description,
+		Detail:      room.Detail,
+		Area:        room.Area,
+		Tags:        room.Tags,
+		CanBind:     room.CanBind,
+		OnEnter:     room.OnEnterScriptID,
+	}
+
+	if room.Exits != nil {
+		for _, e := range *room.Exits {
+			y.Exits = append(y.Exits, YAMLExit{
+				Name:        e.Name,
+				Target:      e.Target,
+				Type:        string(e.Type),
+				Description: e.Description,
+				Hidden:      e.Hidden,
+				Door:        DoorFromEntity(e.Door),
+			})
+		}
+	}
+
+	if room.Actions != nil {
+		for _, a := range *room.Actions {
+			y.Actions = append(y.Actions, YAMLAction{
+				Name:        a.Name,
+				Type:        string(a.Type),
+				Description: a.Description,
+				Response:    a.Response,
+			})
+		}
+	}
+
+	if room.Meta != nil {
+		y.Meta = YAMLRoomMeta{
+			Background: room.Meta.Background,
+			Mood:       room.Meta.Mood,
+		}
+	}
+
+	if room.Coords != nil {
+		y.Coords = &YAMLCoords{
+			X: room.Coords.X,
+			Y: room.Coords.Y,
+			Z: room.Coords.Z,
+		}
+	}
+
+	return y
+}
+
+// DoorFromEntity converts a door to YAMLDoor, the reset state is exported instead of the current one
+func DoorFromEntity(door *rooms.Door) *YAMLDoor {
+	if door == nil {
+		return nil
+	}
+	return &YAMLDoor{
+		Name:           door.Name,
+		Closed:         door.DefaultClosed || door.DefaultLocked,
+		Locked:         door.DefaultLocked,
+		Key:            door.KeyID,
+		PickDifficulty: door.PickDifficulty,
+		ResetMinutes:   door.ResetMinutes,
+	}
+}
+
+// ItemFromEntity converts an item template to a YAMLItem
+func ItemFromEntity(item *items.Item) *YAMLItem {
+	y := &YAMLItem{
+		ID:          item.ID,
+		Name:        item.Name,
+		Description: item.Description,
+		Detail:      item.Detail,
+		Type:        string(item.Type),
+		SubType:     string(item.SubType),
+		Slot:        string(item.Slot),
+		Quality:     string(item.Quality),
+		Level:       item.Level,
+		BasePrice:   item.BasePrice,
+		Stackable:   item.Stackable,
+		MaxStack:    item.MaxStack,
+		Consumable:  item.Consumable,
+		Tags:        item.Tags,
+		OnUseScript: item.OnUseScriptID,
+		Closed:      item.Closed,
+		Locked:      item.Locked,
+		LockedBy:    item.LockedBy,
+		MaxItems:    item.MaxItems,
+	}
+
+	if item.Meta != nil {
+		y.Meta.Img = item.Meta.Img
+	}
+
+	return y
+}
+
+// NPCFromEntity converts an NPC template to a YAMLNPC
+func NPCFromEntity(n *npc.NPC) *YAMLNPC {
+	y := &YAMLNPC{
+		ID:                n.ID,
+		Name:              n.Name,
+		Description:       n.Description,
+		Level:             n.Level,
+		MaxHitPoints:      n.MaxHitPoints,
+		DialogID:          n.DialogID,
+		IdleDialogID:      n.IdleDialogID,
+		IdleDialogTimeout: int(n.IdleDialogTimeout / time.Second),
+		WanderRadius:      n.WanderRadius,
+		PatrolPath:        n.PatrolPath,
+		PatrolMode:        strings.ToLower(string(n.PatrolMode)),
+		MoveInterval:      int(n.MoveInterval / time.Second),
+	}
+
+	if n.EnemyTrait != nil {
+		y.EnemyTrait = &YAMLEnemyTrait{
+			CreatureType:  n.EnemyTrait.CreatureType,
+			CombatStyle:   n.EnemyTrait.CombatStyle,
+			Difficulty:    n.EnemyTrait.Difficulty,
+			AttackPower:   n.EnemyTrait.AttackPower,
+			Defense:       n.EnemyTrait.Defense,
+			AttackSpeed:   n.EnemyTrait.AttackSpeed,
+			AggroRadius:   n.EnemyTrait.AggroRadius,
+			AggroOnSight:  n.EnemyTrait.AggroOnSight,
+			AggroLevelGap: n.EnemyTrait.AggroLevelGap,
+			CallForHelp:   n.EnemyTrait.CallForHelp,
+			FleeThreshold: n.EnemyTrait.FleeThreshold,
+			XPReward:      n.EnemyTrait.XPReward,
+			LootTableID:   n.EnemyTrait.LootTableID,
+			OnAggroScript: n.EnemyTrait.OnAggroScript,
+			OnDeathScript: n.EnemyTrait.OnDeathScript,
+			OnFleeScript:  n.EnemyTrait.OnFleeScript,
+		}
+	}
+
+	if n.MerchantTrait != nil {
+		mt := &YAMLMerchantTrait{
+			BuyRate:         n.MerchantTrait.BuyMultiplier,
+			SellRate:        n.MerchantTrait.SellMultiplier,
+			RestockMinutes:  n.MerchantTrait.RestockMinutes,
+			RestockVariance: n.MerchantTrait.RestockVariance,
+		}
+		for _, item := range n.MerchantTrait.Inventory {
+			// the configured stock, not what is left, unlimited is written as no stock
+			stock := item.MaxQuantity
+			if stock < 0 {
+				stock = 0
+			}
+			mt.Inventory = append(mt.Inventory, YAMLMerchantInventoryItem{
+				ItemTemplateID: item.ItemTemplateID,
+				Stock:          stock,
+			})
+		}
+		y.MerchantTrait = mt
+	}
+
+	return y
+}
+
+// ScriptFromEntity converts a Script entity to a YAMLScript
+func ScriptFromEntity(s *scripts.Script) *YAMLScript {
+	y := &YAMLScript{
+		ID:          s.ID,
+		Name:        s.Name,
+		Description: s.Description,
+		Type:        string(s.Type),
+		Language:    string(s.Language),
+		Code:        s.Code,
+	}
+	for _, e := range s.Events {
+		y.Events = append(y.Events, YAMLScriptEvent{
+			Event:    e.Event,
+			Priority: e.Priority,
+			Filter:   e.Filter,
+			Async:    e.Async,
+		})
+	}
+	return y
+}
+
+// DialogFromEntity flattens a dialog tree to the node map of the YAML format, the root node is "root"
+func DialogFromEntity(dialog *dialogs.Dialog) *YAMLDialog {
+	y := &YAMLDialog{
+		ID:   dialog.ID,
+		Name: dialog.Name,
+		Tree: make(map[string]YAMLDialogNode),
+	}
+
+	// reserve the node first, options leading back to the root must not add it again
+	y.Tree["root"] = YAMLDialogNode{NPCText: dialog.Text}
+	y.Tree["root"] = YAMLDialogNode{
+		NPCText: dialog.Text,
+		Options: flattenDialogOptions(dialog.Options, y.Tree),
+	}
+
+	return y
+}
+
+// flattenDialogOptions adds the answers of the options to the node map, every node is added before
+// its children so references back to a parent node don't expand it again
+func flattenDialogOptions(options []*dialogs.Dialog, tree map[string]YAMLDialogNode) []YAMLDialogOption {
+	if len(options) == 0 {
+		return nil
+	}
+
+	result := make([]YAMLDialogOption, 0, len(options))
+	for _, opt := range options {
+		next := opt.NodeID
+		if next == "" && opt.Answer != nil {
+			next = opt.Answer.NodeID
+		}
+		if next == "" && opt.Answer != nil {
+			// dialogs built in the editor don't name their nodes
+			next = unusedNodeID(tree)
+		}
+
+		if opt.Answer != nil {
+			if _, exists := tree[next]; !exists {
+				tree[next] = YAMLDialogNode{NPCText: opt.Answer.Text}
+				tree[next] = YAMLDialogNode{
+					NPCText: opt.Answer.Text,
+					Options: flattenDialogOptions(opt.Answer.Options, tree),
+				}
+			}
+		}
+
+		result = append(result, YAMLDialogOption{
+			PlayerText: opt.Text,
+			Next:       next,
+		})
+	}
+	return result
+}
+
+func unusedNodeID(tree map[string]YAMLDialogNode) string {
+	for i := len(tree); ; i++ {
+		id := fmt.Sprintf("node%d", i)
+		if _, exists := tree[id]; !exists {
+			return id
+		}
+	}
+}
+
+// LootTableFromEntity converts a LootTable entity to a YAMLLootTable, drop chances become weights (0-100)
+func LootTableFromEntity(lt *items.LootTable) *YAMLLootTable {
+	y := &YAMLLootTable{
+		ID:          lt.ID,
+		Name:        lt.Name,
+		Description: lt.Description,
+	}
+
+	for _, e := range lt.Entries {
+		weight := int(math.Round(e.DropChance * 100))
+		if e.Guaranteed || weight > 100 {
+			weight = 100
+		}
+		// the importer reads missing counts as 1
+		minCount, maxCount := e.MinQuantity, e.MaxQuantity
+		if minCount == 0 {
+			minCount = 1
+		}
+		if maxCount == 0 {
+			maxCount = 1
+		}
+		y.Entries = append(y.Entries, YAMLLootEntry{
+			Item:     e.ItemTemplateID,
+			Weight:   weight,
+			MinCount: minCount,
+			MaxCount: maxCount,
+		})
+	}
+
+	return y
+}
+
+// QuestFromEntity converts a Quest entity to a YAMLQuest
+func QuestFromEntity(q *quests.Quest) *YAMLQuest {
+	y := &YAMLQuest{
+		ID:            q.ID,
+		Name:          q.Name,
+		Description:   q.Description,
+		Giver:         q.GiverNPCID,
+		MinLevel:      q.MinLevel,
+		Prerequisites: q.PrerequisiteQuestIDs,
+		Repeatable:    q.Repeatable,
+		Rewards: YAMLQuestRewards{
+			XP:    q.Rewards.XP,
+			Gold:  q.Rewards.Gold,
+			Items: q.Rewards.ItemTemplateIDs,
+		},
+		Tags: q.Tags,
+	}
+
+	for i, o := range q.Objectives {
+		// same numbering the importer gives objectives without an ID
+		id := o.ID
+		if id == "" {
+			id = fmt.Sprintf("%d", i+1)
+		}
+		y.Objectives = append(y.Objectives, YAMLQuestObjective{
+			ID:          id,
+			Type:        string(o.Type),
+			Target:      o.TargetID,
+			Count:       o.Count,
+			Description: o.Description,
+		})
+	}
+
+	return y
+}
+
+// SkillFromEntity converts a Skill entity to a YAMLSkill
+func SkillFromEntity(s *skills.Skill) *YAMLSkill {
+	return &YAMLSkill{
+		ID:          s.ID,
+		Name:        s.Name,
+		Description: s.Description,
+		Classes:     s.ClassIDs,
+		MinLevel:    s.MinLevel,
+		Resource:    string(s.ResourceType),
+		Cost:        s.Cost,
+		Cooldown:    s.CooldownSec,
+		Target:      string(s.Target),
+		Effect:      string(s.Effect),
+		Formula: YAMLSkillFormula{
+			Base:                s.Formula.Base,
+			Dice:                s.Formula.Dice,
+			Attribute:           s.Formula.Attribute,
+			AttributeMultiplier: s.Formula.AttributeMultiplier,
+			LevelMultiplier:     s.Formula.LevelMultiplier,
+		},
+		IgnoreDefense: s.IgnoreDefense,
+		Script:        s.ScriptID,
+		Tags:          s.Tags,
+	}
+}
@@ -0,0 +1,292 @@
+package importer
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"gopkg.in/yaml.v3"
+
+	"github.com/talesmud/talesmud/pkg/repository"
+)
+
+// WorldExporter writes the world data to the YAML folder layout the WorldImporter reads
+type WorldExporter struct {
+	repos      repository.Factory
+	exportPath string
+	verbose    bool
+	errors     []string
+}
+
+// ExportResult contains the results of an export operation
+type ExportResult struct {
+	RoomsExported      int
+	ItemsExported      int
+	NPCsExported       int
+	ScriptsExported    int
+	DialogsExported    int
+	LootTablesExported int
+	QuestsExported     int
+	SkillsExported     int
+	AssetsExported     int
+	Errors             []string
+	Duration           time.Duration
+}
+
+// unsafeFileChars matches everything that should not end up in a file name
+var unsafeFileChars = regexp.MustCompile(`[^A-Za-z0-9_.-]`)
+
+// NewExporter creates a new WorldExporter
+func NewExporter(repos repository.Factory, exportPath string) *WorldExporter {
+	return &WorldExporter{
+		repos:      repos,
+		exportPath: exportPath,
+		errors:     make([]string, 0),
+	}
+}
+
+// SetVerbose enables verbose output
+func (w *WorldExporter) SetVerbose(v bool) {
+	w.verbose = v
+}
+
+// Export writes one YAML file per entity, YAML files of a previous export are replaced so deleted
+// entities disappear from the folder as well
+func (w *WorldExporter) Export() (*ExportResult, error) {
+	start := time.Now()
+	result := &ExportResult{}
+
+	if err := os.MkdirAll(filepath.Join(w.exportPath, "data"), 0755); err != nil {
+		return nil, fmt.Errorf("failed to create export folder: %w", err)
+	}
+
+	log.Info("Exporting scripts...")
+	allScripts, err := w.repos.Scripts().FindAll()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load scripts: %w", err)
+	}
+	if err := w.clearYAMLFiles("scripts"); err != nil {
+		return nil, err
+	}
+	for _, s := range allScripts {
+		if w.writeYAML("scripts", s.ID, ScriptFromEntity(s)) {
+			result.ScriptsExported++
+		}
+	}
+
+	log.Info("Exporting skills...")
+	allSkills, err := w.repos.Skills().FindAll()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load skills: %w", err)
+	}
+	if err := w.clearYAMLFiles("skills"); err != nil {
+		return nil, err
+	}
+	for _, s := range allSkills {
+		if w.writeYAML("skills", s.ID, SkillFromEntity(s)) {
+			result.SkillsExported++
+		}
+	}
+
+	// item instances belong to players and rooms, only templates are content
+	log.Info("Exporting items...")
+	allItems, err := w.repos.Items().FindAllTemplates(repository.ItemsQuery{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to load items: %w", err)
+	}
+	if err := w.clearYAMLFiles("items"); err != nil {
+		return nil, err
+	}
+	for _, item := range allItems {
+		if w.writeYAML("items", item.ID, ItemFromEntity(item)) {
+			result.ItemsExported++
+		}
+	}
+
+	log.Info("Exporting loot tables...")
+	allLootTables, err := w.repos.LootTables().FindAll()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load loot tables: %w", err)
+	}
+	if err := w.clearYAMLFiles("loot_tables"); err != nil {
+		return nil, err
+	}
+	for _, lt := range allLootTables {
+		if w.writeYAML("loot_tables", lt.ID, LootTableFromEntity(lt)) {
+			result.LootTablesExported++
+		}
+	}
+
+	log.Info("Exporting dialogs...")
+	allDialogs, err := w.repos.Dialogs().FindAll()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load dialogs: %w", err)
+	}
+	if err := w.clearYAMLFiles("dialogs"); err != nil {
+		return nil, err
+	}
+	for _, d := range allDialogs {
+		if w.writeYAML("dialogs", d.ID, DialogFromEntity(d)) {
+			result.DialogsExported++
+		}
+	}
+
+	log.Info("Exporting NPCs...")
+	allNPCs, err := w.repos.NPCs().FindAll()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load NPCs: %w", err)
+	}
+	if err := w.clearYAMLFiles("npcs"); err != nil {
+		return nil, err
+	}
+	for _, n := range allNPCs {
+		if !n.IsTemplate {
+			continue
+		}
+		if w.writeYAML("npcs", n.ID, NPCFromEntity(n)) {
+			result.NPCsExported++
+		}
+	}
+
+	log.Info("Exporting rooms...")
+	allRooms, err := w.repos.Rooms().FindAll()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load rooms: %w", err)
+	}
+	if err := w.clearYAMLFiles("rooms"); err != nil {
+		return nil, err
+	}
+	backgrounds := make([]string, 0)
+	for _, room := range allRooms {
+		if w.writeYAML("rooms", room.ID, RoomFromEntity(room)) {
+			result.RoomsExported++
+		}
+		if room.Meta != nil && room.Meta.Background != "" {
+			backgrounds = append(backgrounds, room.Meta.Background)
+		}
+	}
+
+	log.Info("Exporting quests...")
+	allQuests, err := w.repos.Quests().FindAll()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load quests: %w", err)
+	}
+	if err := w.clearYAMLFiles("quests"); err != nil {
+		return nil, err
+	}
+	for _, q := range allQuests {
+		if w.writeYAML("quests", q.ID, QuestFromEntity(q)) {
+			result.QuestsExported++
+		}
+	}
+
+	log.Info("Exporting assets...")
+	result.AssetsExported = w.exportAssets(backgrounds)
+
+	result.Errors = w.errors
+	result.Duration = time.Since(start)
+
+	return result, nil
+}
+
+func (w *WorldExporter) addError(format string, args ...interface{}) {
+	msg := fmt.Sprintf(format, args...)
+	w.errors = append(w.errors, msg)
+	log.Warn(msg)
+}
+
+// clearYAMLFiles removes the YAML files below data/<subdir>, other files are left alone
+func (w *WorldExporter) clearYAMLFiles(subdir string) error {
+	dir := filepath.Join(w.exportPath, "data", subdir)
+	if _, err := os.Stat(dir); os.IsNotExist(err) {
+		return nil
+	}
+
+	return filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			return nil
+		}
+		ext := strings.ToLower(filepath.Ext(path))
+		if ext != ".yaml" && ext != ".yml" {
+			return nil
+		}
+		if err := os.Remove(path); err != nil {
+			return fmt.Errorf("failed to remove %s: %w", path, err)
+		}
+		return nil
+	})
+}
+
+// writeYAML writes the entity to data/<subdir>/<id>.yaml, returns false if it failed
+func (w *WorldExporter) writeYAML(subdir, id string, value interface{}) bool {
+	dir := filepath.Join(w.exportPath, "data", subdir)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		w.addError("Failed to create %s: %v", dir, err)
+		return false
+	}
+
+	var buf bytes.Buffer
+	enc := yaml.NewEncoder(&buf)
+	enc.SetIndent(2)
+	if err := enc.Encode(value); err != nil {
+		w.addError("Failed to encode %s %s: %v", subdir, id, err)
+		return false
+	}
+	enc.Close()
+
+	path := filepath.Join(dir, unsafeFileChars.ReplaceAllString(id, "_")+".yaml")
+	if err := os.WriteFile(path, buf.Bytes(), 0644); err != nil {
+		w.addError("Failed to write %s: %v", path, err)
+		return false
+	}
+	if w.verbose {
+		log.WithField("file", path).Debug("Exported " + subdir)
+	}
+	return true
+}
+
+// exportAssets copies the room backgrounds into assets/images/rooms, where copyAssets picks them up again
+func (w *WorldExporter) exportAssets(backgrounds []string) int {
+	srcDir := os.Getenv("BACKGROUNDS_PATH")
+	if srcDir == "" {
+		srcDir = "./uploads/backgrounds"
+	}
+	dstDir := filepath.Join(w.exportPath, "assets", "images", "rooms")
+
+	count := 0
+	copied := make(map[string]bool)
+	for _, background := range backgrounds {
+		name := filepath.Base(background)
+		if copied[name] {
+			continue
+		}
+		copied[name] = true
+
+		srcPath := filepath.Join(srcDir, name)
+		if _, err := os.Stat(srcPath); os.IsNotExist(err) {
+			w.addError("Background %s not found in %s", name, srcDir)
+			continue
+		}
+		if err := os.MkdirAll(dstDir, 0755); err != nil {
+			w.addError("Failed to create %s: %v", dstDir, err)
+			return count
+		}
+		if err := copyFile(srcPath, filepath.Join(dstDir, name)); err != nil {
+			w.addError("Failed to copy asset %s: %v", srcPath, err)
+			continue
+		}
+		count++
+		if w.verbose {
+			log.WithField("file", name).Debug("Exported asset")
+		}
+	}
+	return count
+}
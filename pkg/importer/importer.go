@@ -15,9 +15,12 @@ import (
 	"github.com/talesmud/talesmud/pkg/entities/dialogs"
 	"github.com/talesmud/talesmud/pkg/entities/items"
 	npc "github.com/talesmud/talesmud/pkg/entities/npcs"
+	"github.com/talesmud/talesmud/pkg/entities/quests"
 	"github.com/talesmud/talesmud/pkg/entities/rooms"
+	"github.com/talesmud/talesmud/pkg/entities/skills"
 	"github.com/talesmud/talesmud/pkg/repository"
 	"github.com/talesmud/talesmud/pkg/scripts"
+	"github.com/talesmud/talesmud/pkg/validator"
 )
 
 // WorldImporter handles importing world data from YAML files
@@ -26,6 +29,8 @@ type WorldImporter struct {
 	importPath string
 	verbose    bool
 	dryRun     bool
+	merge      bool
+	prune      bool
 	errors     []string
 }
 
@@ -38,8 +43,12 @@ type ImportResult struct {
 	ScriptsImported int
 	DialogsImported int
 	LootTablesImported int
+	QuestsImported int
+	SkillsImported int
 	CharactersRelocated int
 	AssetsImported int
+	Changes       []Change
+	Validation    *validator.Report
 	Errors        []string
 	Duration      time.Duration
 }
@@ -63,6 +72,16 @@ func (w *WorldImporter) SetDryRun(d bool) {
 	w.dryRun = d
 }
 
+// SetMerge enables merge mode: entities are updated by ID and stored entities missing in the import are kept
+func (w *WorldImporter) SetMerge(m bool) {
+	w.merge = m
+}
+
+// SetPrune deletes stored entities missing in the import when merging
+func (w *WorldImporter) SetPrune(p bool) {
+	w.prune = p
+}
+
 // Import performs the full import process
 func (w *WorldImporter) Import() (*ImportResult, error) {
 	start := time.Now()
@@ -99,6 +118,14 @@ func (w *WorldImporter) Import() (*ImportResult, error) {
 	if err != nil {
 		w.addError("Failed to load rooms: %v", err)
 	}
+	yamlQuests, err := w.loadQuests()
+	if err != nil {
+		w.addError("Failed to load quests: %v", err)
+	}
+	yamlSkills, err := w.loadSkills()
+	if err != nil {
+		w.addError("Failed to load skills: %v", err)
+	}
 
 	log.WithFields(log.Fields{
 		"scripts":     len(yamlScripts),
@@ -107,8 +134,41 @@ func (w *WorldImporter) Import() (*ImportResult, error) {
 		"npcs":        len(yamlNPCs),
 		"dialogs":     len(yamlDialogs),
 		"rooms":       len(yamlRooms),
+		"quests":      len(yamlQuests),
+		"skills":      len(yamlSkills),
 	}).Info("Loaded YAML data")
 
+	// Compare with the stored world to report what the import changes
+	var sets []*mergeSet
+	if w.merge || w.dryRun {
+		sets, err = w.buildMergeSets(&worldData{
+			scripts:    yamlScripts,
+			skills:     yamlSkills,
+			items:      yamlItems,
+			lootTables: yamlLootTables,
+			dialogs:    yamlDialogs,
+			npcs:       yamlNPCs,
+			rooms:      yamlRooms,
+			quests:     yamlQuests,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to load stored world data: %w", err)
+		}
+		// a full import drops everything that is not part of it
+		prune := w.prune || !w.merge
+		for _, set := range sets {
+			result.Changes = append(result.Changes, set.diff(prune)...)
+		}
+
+		if w.dryRun {
+			world, err := w.resultingWorld(sets, prune)
+			if err != nil {
+				return nil, err
+			}
+			result.Validation = validator.Validate(world, validator.DefaultStartRoomID)
+		}
+	}
+
 	if w.dryRun {
 		log.Info("Dry-run mode: skipping actual import")
 		result.Errors = w.errors
@@ -126,6 +186,27 @@ func (w *WorldImporter) Import() (*ImportResult, error) {
 		log.WithField("path", backupPath).Info("Backup created")
 	}
 
+	if w.merge {
+		w.applyMerge(sets, result.Changes, result)
+
+		log.Info("Copying assets...")
+		result.AssetsImported, err = w.copyAssets()
+		if err != nil {
+			w.addError("Failed to copy assets: %v", err)
+		}
+
+		// Only characters standing in removed rooms have to move
+		log.Info("Relocating characters of removed rooms...")
+		result.CharactersRelocated, err = w.relocateOrphanedCharacters()
+		if err != nil {
+			w.addError("Failed to relocate characters: %v", err)
+		}
+
+		result.Errors = w.errors
+		result.Duration = time.Since(start)
+		return result, nil
+	}
+
 	// Clear existing world data (preserve users and characters)
 	log.Info("Clearing existing world data...")
 	if err := w.clearWorldData(); err != nil {
@@ -136,6 +217,9 @@ func (w *WorldImporter) Import() (*ImportResult, error) {
 	log.Info("Importing scripts...")
 	result.ScriptsImported = w.importScripts(yamlScripts)
 
+	log.Info("Importing skills...")
+	result.SkillsImported = w.importSkills(yamlSkills)
+
 	log.Info("Importing items...")
 	result.ItemsImported = w.importItems(yamlItems)
 
@@ -151,6 +235,9 @@ func (w *WorldImporter) Import() (*ImportResult, error) {
 	log.Info("Importing rooms...")
 	result.RoomsImported = w.importRooms(yamlRooms)
 
+	log.Info("Importing quests...")
+	result.QuestsImported = w.importQuests(yamlQuests)
+
 	// Copy assets
 	log.Info("Copying assets...")
 	result.AssetsImported, err = w.copyAssets()
@@ -227,6 +314,16 @@ func (w *WorldImporter) createBackup() (string, error) {
 		backup["lootTables"] = lootTables
 	}
 
+	// Backup quests
+	if quests, err := w.repos.Quests().FindAll(); err == nil {
+		backup["quests"] = quests
+	}
+
+	// Backup skills
+	if skills, err := w.repos.Skills().FindAll(); err == nil {
+		backup["skills"] = skills
+	}
+
 	data, err := json.MarshalIndent(backup, "", "  ")
 	if err != nil {
 		return "", err
@@ -241,10 +338,21 @@ func (w *WorldImporter) createBackup() (string, error) {
 
 // clearWorldData clears all world data except users and characters
 func (w *WorldImporter) clearWorldData() error {
+	// Live NPC instances and fights of the old world can't be restored
+	if err := w.repos.WorldSnapshots().Drop(); err != nil {
+		log.WithError(err).Warn("Failed to drop world snapshot")
+	}
+
 	// Drop in reverse dependency order
+	if err := w.repos.Quests().Drop(); err != nil {
+		return fmt.Errorf("failed to drop quests: %w", err)
+	}
 	if err := w.repos.NPCSpawners().Drop(); err != nil {
 		log.WithError(err).Warn("Failed to drop NPC spawners")
 	}
+	if err := w.repos.Skills().Drop(); err != nil {
+		return fmt.Errorf("failed to drop skills: %w", err)
+	}
 	if err := w.repos.Rooms().Drop(); err != nil {
 		return fmt.Errorf("failed to drop rooms: %w", err)
 	}
@@ -390,6 +498,40 @@ func (w *WorldImporter) loadRooms() ([]*YAMLRoom, error) {
 	return result, err
 }
 
+func (w *WorldImporter) loadQuests() ([]*YAMLQuest, error) {
+	var result []*YAMLQuest
+	seen := make(map[string]bool)
+	err := w.loadYAMLFiles("quests", func(data []byte) error {
+		var q YAMLQuest
+		if err := yaml.Unmarshal(data, &q); err != nil {
+			return err
+		}
+		if !seen[q.ID] {
+			seen[q.ID] = true
+			result = append(result, &q)
+		}
+		return nil
+	})
+	return result, err
+}
+
+func (w *WorldImporter) loadSkills() ([]*YAMLSkill, error) {
+	var result []*YAMLSkill
+	seen := make(map[string]bool)
+	err := w.loadYAMLFiles("skills", func(data []byte) error {
+		var s YAMLSkill
+		if err := yaml.Unmarshal(data, &s); err != nil {
+			return err
+		}
+		if !seen[s.ID] {
+			seen[s.ID] = true
+			result = append(result, &s)
+		}
+		return nil
+	})
+	return result, err
+}
+
 // Import functions
 
 func (w *WorldImporter) importScripts(yamlScripts []*YAMLScript) int {
@@ -488,6 +630,38 @@ func (w *WorldImporter) importRooms(yamlRooms []*YAMLRoom) int {
 	return count
 }
 
+func (w *WorldImporter) importQuests(yamlQuests []*YAMLQuest) int {
+	count := 0
+	for _, q := range yamlQuests {
+		entity := q.ToEntity()
+		if _, err := w.repos.Quests().Import(entity); err != nil {
+			w.addError("Failed to import quest %s: %v", q.ID, err)
+		} else {
+			count++
+			if w.verbose {
+				log.WithField("id", q.ID).Debug("Imported quest")
+			}
+		}
+	}
+	return count
+}
+
+func (w *WorldImporter) importSkills(yamlSkills []*YAMLSkill) int {
+	count := 0
+	for _, s := range yamlSkills {
+		entity := s.ToEntity()
+		if _, err := w.repos.Skills().Import(entity); err != nil {
+			w.addError("Failed to import skill %s: %v", s.ID, err)
+		} else {
+			count++
+			if w.verbose {
+				log.WithField("id", s.ID).Debug("Imported skill")
+			}
+		}
+	}
+	return count
+}
+
 // copyAssets copies room images to the backgrounds folder
 func (w *WorldImporter) copyAssets() (int, error) {
 	srcDir := filepath.Join(w.importPath, "assets", "images", "rooms")
@@ -588,4 +762,6 @@ type (
 	NPC       = npc.NPC
 	Dialog    = dialogs.Dialog
 	Room      = rooms.Room
+	Quest     = quests.Quest
+	Skill     = skills.Skill
 )
@@ -0,0 +1,455 @@
+package importer
+
+import (
+	"encoding/json"
+	"fmt"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/talesmud/talesmud/pkg/entities/dialogs"
+	"github.com/talesmud/talesmud/pkg/entities/items"
+	npc "github.com/talesmud/talesmud/pkg/entities/npcs"
+	"github.com/talesmud/talesmud/pkg/entities/quests"
+	"github.com/talesmud/talesmud/pkg/entities/rooms"
+	"github.com/talesmud/talesmud/pkg/entities/skills"
+	"github.com/talesmud/talesmud/pkg/repository"
+	"github.com/talesmud/talesmud/pkg/scripts"
+	"github.com/talesmud/talesmud/pkg/validator"
+)
+
+// ChangeType is the kind of change an import makes to a stored entity
+type ChangeType string
+
+const (
+	ChangeAdded   ChangeType = "added"
+	ChangeChanged ChangeType = "changed"
+	ChangeRemoved ChangeType = "removed"
+	// ChangeKept marks stored entities that are not in the import and stay because deleting was not requested
+	ChangeKept ChangeType = "kept"
+)
+
+// Change is one entry of the import diff
+type Change struct {
+	Kind string
+	ID   string
+	Name string
+	Type ChangeType
+}
+
+// mergeEntity is an imported or stored entity of a merge set
+type mergeEntity struct {
+	id     string
+	name   string
+	entity interface{}
+}
+
+// mergeSet describes how the entities of one type are compared and written
+type mergeSet struct {
+	kind     string
+	incoming []mergeEntity
+	existing map[string]mergeEntity
+	// order keeps the stored entities in the order they were loaded
+	order []string
+
+	// prepare copies live data of the stored entity into the imported one before comparing, optional
+	prepare func(incoming, existing interface{})
+
+	store  func(entity interface{}) error
+	update func(id string, entity interface{}) error
+	remove func(id string) error
+}
+
+func newMergeSet(kind string) *mergeSet {
+	return &mergeSet{
+		kind:     kind,
+		existing: make(map[string]mergeEntity),
+	}
+}
+
+func (s *mergeSet) addIncoming(id, name string, entity interface{}) {
+	s.incoming = append(s.incoming, mergeEntity{id: id, name: name, entity: entity})
+}
+
+func (s *mergeSet) addExisting(id, name string, entity interface{}) {
+	s.existing[id] = mergeEntity{id: id, name: name, entity: entity}
+	s.order = append(s.order, id)
+}
+
+// diff compares the imported entities with the stored ones, stored entities missing in the import
+// are removed if prune is set and kept otherwise
+func (s *mergeSet) diff(prune bool) []Change {
+	changes := make([]Change, 0)
+	imported := make(map[string]bool)
+
+	for _, in := range s.incoming {
+		imported[in.id] = true
+		stored, ok := s.existing[in.id]
+		if !ok {
+			changes = append(changes, Change{Kind: s.kind, ID: in.id, Name: in.name, Type: ChangeAdded})
+			continue
+		}
+		if s.prepare != nil {
+			s.prepare(in.entity, stored.entity)
+		}
+		if !sameJSON(in.entity, stored.entity) {
+			changes = append(changes, Change{Kind: s.kind, ID: in.id, Name: in.name, Type: ChangeChanged})
+		}
+	}
+
+	for _, id := range s.order {
+		if imported[id] {
+			continue
+		}
+		change := Change{Kind: s.kind, ID: id, Name: s.existing[id].name, Type: ChangeKept}
+		if prune {
+			change.Type = ChangeRemoved
+		}
+		changes = append(changes, change)
+	}
+	return changes
+}
+
+// apply writes the changes of the set, returns the number of added and changed entities
+func (w *WorldImporter) apply(s *mergeSet, changes []Change) int {
+	incoming := make(map[string]interface{})
+	for _, in := range s.incoming {
+		incoming[in.id] = in.entity
+	}
+
+	count := 0
+	for _, change := range changes {
+		if change.Kind != s.kind {
+			continue
+		}
+		var err error
+		switch change.Type {
+		case ChangeAdded:
+			err = s.store(incoming[change.ID])
+		case ChangeChanged:
+			err = s.update(change.ID, incoming[change.ID])
+		case ChangeRemoved:
+			err = s.remove(change.ID)
+		default:
+			continue
+		}
+		if err != nil {
+			w.addError("Failed to merge %s %s (%s): %v", s.kind, change.ID, change.Type, err)
+			continue
+		}
+		if change.Type != ChangeRemoved {
+			count++
+		}
+		if w.verbose {
+			log.WithField("id", change.ID).WithField("change", change.Type).Debug("Merged " + s.kind)
+		}
+	}
+	return count
+}
+
+// sameJSON returns true if both entities are stored as the same document
+func sameJSON(a, b interface{}) bool {
+	dataA, errA := json.Marshal(a)
+	dataB, errB := json.Marshal(b)
+	return errA == nil && errB == nil && string(dataA) == string(dataB)
+}
+
+// worldData holds the entities loaded from the YAML files
+type worldData struct {
+	scripts    []*YAMLScript
+	skills     []*YAMLSkill
+	items      []*YAMLItem
+	lootTables []*YAMLLootTable
+	dialogs    []*YAMLDialog
+	npcs       []*YAMLNPC
+	rooms      []*YAMLRoom
+	quests     []*YAMLQuest
+}
+
+// buildMergeSets loads the stored world entities next to the imported ones, in import order
+func (w *WorldImporter) buildMergeSets(data *worldData) ([]*mergeSet, error) {
+	sets := make([]*mergeSet, 0, 8)
+
+	scriptSet := newMergeSet("script")
+	for _, y := range data.scripts {
+		scriptSet.addIncoming(y.ID, y.Name, y.ToEntity())
+	}
+	stored, err := w.repos.Scripts().FindAll()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load scripts: %w", err)
+	}
+	for _, e := range stored {
+		scriptSet.addExisting(e.ID, e.Name, e)
+	}
+	scriptSet.store = func(e interface{}) error { _, err := w.repos.Scripts().Import(e.(*scripts.Script)); return err }
+	scriptSet.update = func(id string, e interface{}) error { return w.repos.Scripts().Update(id, e.(*scripts.Script)) }
+	scriptSet.remove = w.repos.Scripts().Delete
+	sets = append(sets, scriptSet)
+
+	skillSet := newMergeSet("skill")
+	for _, y := range data.skills {
+		skillSet.addIncoming(y.ID, y.Name, y.ToEntity())
+	}
+	storedSkills, err := w.repos.Skills().FindAll()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load skills: %w", err)
+	}
+	for _, e := range storedSkills {
+		skillSet.addExisting(e.ID, e.Name, e)
+	}
+	skillSet.store = func(e interface{}) error { _, err := w.repos.Skills().Import(e.(*skills.Skill)); return err }
+	skillSet.update = func(id string, e interface{}) error { return w.repos.Skills().Update(id, e.(*skills.Skill)) }
+	skillSet.remove = w.repos.Skills().Delete
+	sets = append(sets, skillSet)
+
+	// item instances carried or placed by players are no world content
+	itemSet := newMergeSet("item")
+	for _, y := range data.items {
+		itemSet.addIncoming(y.ID, y.Name, y.ToEntity())
+	}
+	storedItems, err := w.repos.Items().FindAllTemplates(repository.ItemsQuery{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to load items: %w", err)
+	}
+	for _, e := range storedItems {
+		itemSet.addExisting(e.ID, e.Name, e)
+	}
+	itemSet.store = func(e interface{}) error { _, err := w.repos.Items().Import(e.(*items.Item)); return err }
+	itemSet.update = func(id string, e interface{}) error { return w.repos.Items().Update(id, e.(*items.Item)) }
+	itemSet.remove = w.repos.Items().Delete
+	sets = append(sets, itemSet)
+
+	lootTableSet := newMergeSet("loot table")
+	for _, y := range data.lootTables {
+		lootTableSet.addIncoming(y.ID, y.Name, y.ToEntity())
+	}
+	storedLootTables, err := w.repos.LootTables().FindAll()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load loot tables: %w", err)
+	}
+	for _, e := range storedLootTables {
+		lootTableSet.addExisting(e.ID, e.Name, e)
+	}
+	lootTableSet.store = func(e interface{}) error { _, err := w.repos.LootTables().Import(e.(*items.LootTable)); return err }
+	lootTableSet.update = func(id string, e interface{}) error { return w.repos.LootTables().Update(id, e.(*items.LootTable)) }
+	lootTableSet.remove = w.repos.LootTables().Delete
+	sets = append(sets, lootTableSet)
+
+	dialogSet := newMergeSet("dialog")
+	for _, y := range data.dialogs {
+		dialogSet.addIncoming(y.ID, y.Name, y.ToEntity())
+	}
+	storedDialogs, err := w.repos.Dialogs().FindAll()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load dialogs: %w", err)
+	}
+	for _, e := range storedDialogs {
+		dialogSet.addExisting(e.ID, e.Name, e)
+	}
+	dialogSet.store = func(e interface{}) error { _, err := w.repos.Dialogs().Import(e.(*dialogs.Dialog)); return err }
+	dialogSet.update = func(id string, e interface{}) error { return w.repos.Dialogs().Update(id, e.(*dialogs.Dialog)) }
+	dialogSet.remove = w.repos.Dialogs().Delete
+	sets = append(sets, dialogSet)
+
+	// only templates, NPCs created through the editor stay untouched
+	npcSet := newMergeSet("npc")
+	for _, y := range data.npcs {
+		npcSet.addIncoming(y.ID, y.Name, y.ToEntity())
+	}
+	storedNPCs, err := w.repos.NPCs().FindAll()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load NPCs: %w", err)
+	}
+	for _, e := range storedNPCs {
+		if e.IsTemplate {
+			npcSet.addExisting(e.ID, e.Name, e)
+		}
+	}
+	npcSet.store = func(e interface{}) error { _, err := w.repos.NPCs().Import(e.(*npc.NPC)); return err }
+	npcSet.update = func(id string, e interface{}) error { return w.repos.NPCs().Update(id, e.(*npc.NPC)) }
+	npcSet.remove = w.repos.NPCs().Delete
+	sets = append(sets, npcSet)
+
+	roomSet := newMergeSet("room")
+	for _, y := range data.rooms {
+		roomSet.addIncoming(y.ID, y.Name, y.ToEntity())
+	}
+	storedRooms, err := w.repos.Rooms().FindAll()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load rooms: %w", err)
+	}
+	for _, e := range storedRooms {
+		roomSet.addExisting(e.ID, e.Name, e)
+	}
+	roomSet.prepare = func(incoming, existing interface{}) {
+		keepLiveRoomData(incoming.(*rooms.Room), existing.(*rooms.Room))
+	}
+	roomSet.store = func(e interface{}) error { _, err := w.repos.Rooms().Import(e.(*rooms.Room)); return err }
+	roomSet.update = func(id string, e interface{}) error { return w.repos.Rooms().Update(id, e.(*rooms.Room)) }
+	roomSet.remove = w.repos.Rooms().Delete
+	sets = append(sets, roomSet)
+
+	questSet := newMergeSet("quest")
+	for _, y := range data.quests {
+		questSet.addIncoming(y.ID, y.Name, y.ToEntity())
+	}
+	storedQuests, err := w.repos.Quests().FindAll()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load quests: %w", err)
+	}
+	for _, e := range storedQuests {
+		questSet.addExisting(e.ID, e.Name, e)
+	}
+	questSet.store = func(e interface{}) error { _, err := w.repos.Quests().Import(e.(*quests.Quest)); return err }
+	questSet.update = func(id string, e interface{}) error { return w.repos.Quests().Update(id, e.(*quests.Quest)) }
+	questSet.remove = w.repos.Quests().Delete
+	sets = append(sets, questSet)
+
+	return sets, nil
+}
+
+// keepLiveRoomData copies the characters, dropped items and door states of the stored room into the imported one
+func keepLiveRoomData(incoming, existing *rooms.Room) {
+	incoming.Items = existing.Items
+	incoming.Characters = existing.Characters
+	incoming.NPCs = existing.NPCs
+
+	if incoming.Exits == nil || existing.Exits == nil {
+		return
+	}
+	for i := range *incoming.Exits {
+		exit := &(*incoming.Exits)[i]
+		if exit.Door == nil {
+			continue
+		}
+		if stored := existing.FindExit(exit.Name); stored != nil && stored.Door != nil {
+			exit.Door.Closed = stored.Door.Closed
+			exit.Door.Locked = stored.Door.Locked
+			exit.Door.ChangedAt = stored.Door.ChangedAt
+		}
+	}
+}
+
+// applyMerge writes the changes of all sets in import order and fills the import counts
+func (w *WorldImporter) applyMerge(sets []*mergeSet, changes []Change, result *ImportResult) {
+	counts := make(map[string]int)
+	for _, set := range sets {
+		log.Infof("Merging %ss...", set.kind)
+		counts[set.kind] = w.apply(set, changes)
+	}
+
+	result.ScriptsImported = counts["script"]
+	result.SkillsImported = counts["skill"]
+	result.ItemsImported = counts["item"]
+	result.LootTablesImported = counts["loot table"]
+	result.DialogsImported = counts["dialog"]
+	result.NPCsImported = counts["npc"]
+	result.RoomsImported = counts["room"]
+	result.QuestsImported = counts["quest"]
+}
+
+// relocateOrphanedCharacters moves characters whose room or respawn room no longer exists to the starting room
+func (w *WorldImporter) relocateOrphanedCharacters() (int, error) {
+	startRoomID := validator.DefaultStartRoomID
+
+	allRooms, err := w.repos.Rooms().FindAll()
+	if err != nil {
+		return 0, fmt.Errorf("failed to find rooms: %w", err)
+	}
+	exists := make(map[string]bool)
+	for _, room := range allRooms {
+		exists[room.ID] = true
+	}
+
+	chars, err := w.repos.Characters().FindAll()
+	if err != nil {
+		return 0, fmt.Errorf("failed to find characters: %w", err)
+	}
+
+	count := 0
+	for _, char := range chars {
+		moved := false
+		if !exists[char.CurrentRoomID] {
+			char.CurrentRoomID = startRoomID
+			moved = true
+		}
+		if char.BoundRoomID != "" && !exists[char.BoundRoomID] {
+			char.BoundRoomID = startRoomID
+			moved = true
+		}
+		if !moved {
+			continue
+		}
+		if err := w.repos.Characters().Update(char.ID, char); err != nil {
+			w.addError("Failed to relocate character %s: %v", char.Name, err)
+		} else {
+			count++
+			if w.verbose {
+				log.WithField("name", char.Name).Debug("Relocated character")
+			}
+		}
+	}
+	return count, nil
+}
+
+// resultingWorld returns the world as it is after the import, for validating it in a dry run
+func (w *WorldImporter) resultingWorld(sets []*mergeSet, prune bool) (*validator.World, error) {
+	world := &validator.World{}
+
+	for _, set := range sets {
+		entities := make([]interface{}, 0, len(set.incoming)+len(set.order))
+		imported := make(map[string]bool)
+		for _, in := range set.incoming {
+			entities = append(entities, in.entity)
+			imported[in.id] = true
+		}
+		if !prune {
+			for _, id := range set.order {
+				if !imported[id] {
+					entities = append(entities, set.existing[id].entity)
+				}
+			}
+		}
+
+		for _, e := range entities {
+			switch entity := e.(type) {
+			case *scripts.Script:
+				world.Scripts = append(world.Scripts, entity)
+			case *items.Item:
+				world.Items = append(world.Items, entity)
+			case *items.LootTable:
+				world.LootTables = append(world.LootTables, entity)
+			case *dialogs.Dialog:
+				world.Dialogs = append(world.Dialogs, entity)
+			case *npc.NPC:
+				world.NPCs = append(world.NPCs, entity)
+			case *rooms.Room:
+				world.Rooms = append(world.Rooms, entity)
+			case *quests.Quest:
+				world.Quests = append(world.Quests, entity)
+			}
+		}
+	}
+
+	var err error
+	if world.CharacterTemplates, err = w.repos.CharacterTemplates().FindAll(); err != nil {
+		return nil, fmt.Errorf("failed to load character templates: %w", err)
+	}
+
+	// a full import drops spawners and all NPCs, merging keeps them
+	if !w.merge {
+		return world, nil
+	}
+	if world.Spawners, err = w.repos.NPCSpawners().FindAll(); err != nil {
+		return nil, fmt.Errorf("failed to load NPC spawners: %w", err)
+	}
+	storedNPCs, err := w.repos.NPCs().FindAll()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load NPCs: %w", err)
+	}
+	for _, n := range storedNPCs {
+		if !n.IsTemplate {
+			world.NPCs = append(world.NPCs, n)
+		}
+	}
+	return world, nil
+}
@@ -1,5 +1,11 @@
 package importer
 
+import (
+	"strconv"
+
+	"gopkg.in/yaml.v3"
+)
+
 // YAML model definitions for importing world data
 // These match the structure of YAML files in the import folder
 
@@ -7,39 +13,50 @@ package importer
 type YAMLRoom struct {
 	ID          string       `yaml:"id"`
 	Name        string       `yaml:"name"`
-	Description string       `yaml:"description"`
-	Detail      string       `yaml:"detail"`
-	Area        string       `yaml:"area"`
-	Tags        []string     `yaml:"tags"`
-	CanBind     bool         `yaml:"canBind"`
-	Coords      *YAMLCoords  `yaml:"coords"`
-	Exits       []YAMLExit   `yaml:"exits"`
-	Actions     []YAMLAction `yaml:"actions"`
-	Meta        YAMLRoomMeta `yaml:"meta"`
-	OnEnter     string       `yaml:"onEnterScript"`
+	Description string       `yaml:"description,omitempty"`
+	Detail      string       `yaml:"detail,omitempty"`
+	Area        string       `yaml:"area,omitempty"`
+	Tags        []string     `yaml:"tags,omitempty"`
+	CanBind     bool         `yaml:"canBind,omitempty"`
+	Coords      *YAMLCoords  `yaml:"coords,omitempty"`
+	Exits       []YAMLExit   `yaml:"exits,omitempty"`
+	Actions     []YAMLAction `yaml:"actions,omitempty"`
+	Meta        YAMLRoomMeta `yaml:"meta,omitempty"`
+	OnEnter     string       `yaml:"onEnterScript,omitempty"`
 }
 
 // YAMLExit represents a room exit
 type YAMLExit struct {
-	Name        string `yaml:"name"`
-	Target      string `yaml:"target"`
-	Type        string `yaml:"type"`
-	Description string `yaml:"description"`
-	Hidden      bool   `yaml:"hidden"`
+	Name        string    `yaml:"name"`
+	Target      string    `yaml:"target,omitempty"`
+	Type        string    `yaml:"type,omitempty"`
+	Description string    `yaml:"description,omitempty"`
+	Hidden      bool      `yaml:"hidden,omitempty"`
+	Door        *YAMLDoor `yaml:"door,omitempty"`
+}
+
+// YAMLDoor represents the door of an exit, the imported state is also the reset state
+type YAMLDoor struct {
+	Name           string `yaml:"name"`
+	Closed         bool   `yaml:"closed,omitempty"`
+	Locked         bool   `yaml:"locked,omitempty"`
+	Key            string `yaml:"key,omitempty"` // item template ID
+	PickDifficulty int32  `yaml:"pickDifficulty,omitempty"`
+	ResetMinutes   int32  `yaml:"resetMinutes,omitempty"`
 }
 
 // YAMLAction represents a room action
 type YAMLAction struct {
 	Name        string `yaml:"name"`
-	Type        string `yaml:"type"`
-	Description string `yaml:"description"`
-	Response    string `yaml:"response"`
+	Type        string `yaml:"type,omitempty"`
+	Description string `yaml:"description,omitempty"`
+	Response    string `yaml:"response,omitempty"`
 }
 
 // YAMLRoomMeta contains room metadata
 type YAMLRoomMeta struct {
-	Background string `yaml:"background"`
-	Mood       string `yaml:"mood"`
+	Background string `yaml:"background,omitempty"`
+	Mood       string `yaml:"mood,omitempty"`
 }
 
 // YAMLCoords represents room coordinates - supports both list [x,y,z] and object {x,y,z} formats
@@ -78,153 +95,247 @@ func (c *YAMLCoords) UnmarshalYAML(unmarshal func(interface{}) error) error {
 	return nil // Return nil to allow missing coords
 }
 
+// MarshalYAML writes coordinates in the short list format: [x, y, z]
+func (c YAMLCoords) MarshalYAML() (interface{}, error) {
+	node := &yaml.Node{Kind: yaml.SequenceNode, Style: yaml.FlowStyle}
+	for _, v := range []int32{c.X, c.Y, c.Z} {
+		node.Content = append(node.Content, &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!int", Value: strconv.Itoa(int(v))})
+	}
+	return node, nil
+}
+
 // YAMLItem represents an item in YAML format
 type YAMLItem struct {
 	ID          string       `yaml:"id"`
 	Name        string       `yaml:"name"`
-	Description string       `yaml:"description"`
-	Detail      string       `yaml:"detail"`
-	Type        string       `yaml:"type"`
-	SubType     string       `yaml:"subType"`
-	Slot        string       `yaml:"slot"`
-	Quality     string       `yaml:"quality"`
-	Level       int32        `yaml:"level"`
-	BasePrice   int64        `yaml:"basePrice"`
-	Stackable   bool         `yaml:"stackable"`
-	MaxStack    int32        `yaml:"maxStack"`
-	Consumable  bool         `yaml:"consumable"`
-	Tags        []string     `yaml:"tags"`
-	Meta        YAMLItemMeta `yaml:"meta"`
-	OnUseScript string       `yaml:"onUseScript"`
+	Description string       `yaml:"description,omitempty"`
+	Detail      string       `yaml:"detail,omitempty"`
+	Type        string       `yaml:"type,omitempty"`
+	SubType     string       `yaml:"subType,omitempty"`
+	Slot        string       `yaml:"slot,omitempty"`
+	Quality     string       `yaml:"quality,omitempty"`
+	Level       int32        `yaml:"level,omitempty"`
+	BasePrice   int64        `yaml:"basePrice,omitempty"`
+	Stackable   bool         `yaml:"stackable,omitempty"`
+	MaxStack    int32        `yaml:"maxStack,omitempty"`
+	Consumable  bool         `yaml:"consumable,omitempty"`
+	Tags        []string     `yaml:"tags,omitempty"`
+	Meta        YAMLItemMeta `yaml:"meta,omitempty"`
+	OnUseScript string       `yaml:"onUseScript,omitempty"`
+	// Containers: lockedBy is the ID, template ID or name of the key item
+	Closed   bool   `yaml:"closed,omitempty"`
+	Locked   bool   `yaml:"locked,omitempty"`
+	LockedBy string `yaml:"lockedBy,omitempty"`
+	MaxItems int32  `yaml:"maxItems,omitempty"`
 }
 
 // YAMLItemMeta contains item metadata
 type YAMLItemMeta struct {
-	Img string `yaml:"img"`
+	Img string `yaml:"img,omitempty"`
 }
 
 // YAMLNPC represents an NPC in YAML format
 type YAMLNPC struct {
 	ID            string         `yaml:"id"`
 	Name          string         `yaml:"name"`
-	Description   string         `yaml:"description"`
-	Detail        string         `yaml:"detail"`
-	Type          string         `yaml:"type"`
-	Tags          []string       `yaml:"tags"`
-	Level         int32          `yaml:"level"`
-	MaxHitPoints  int32          `yaml:"maxHitPoints"`
-	DialogID      string         `yaml:"dialogID"`
-	EnemyTrait    *YAMLEnemyTrait `yaml:"enemyTrait"`
-	MerchantTrait *YAMLMerchantTrait `yaml:"merchantTrait"`
-	Meta          YAMLNPCMeta    `yaml:"meta"`
+	Description   string         `yaml:"description,omitempty"`
+	Detail        string         `yaml:"detail,omitempty"`
+	Type          string         `yaml:"type,omitempty"`
+	Tags          []string       `yaml:"tags,omitempty"`
+	Level         int32          `yaml:"level,omitempty"`
+	MaxHitPoints  int32          `yaml:"maxHitPoints,omitempty"`
+	DialogID      string         `yaml:"dialogID,omitempty"`
+	// Idle dialog: spoken to the room line by line
+	IdleDialogID      string     `yaml:"idleDialogID,omitempty"`
+	IdleDialogTimeout int        `yaml:"idleDialogTimeout,omitempty"` // seconds between lines
+	// Movement: wanderRadius rooms around the spawn room or an ordered patrol path of room IDs
+	WanderRadius  int            `yaml:"wanderRadius,omitempty"`
+	PatrolPath    []string       `yaml:"patrolPath,omitempty"`
+	PatrolMode    string         `yaml:"patrolMode,omitempty"`   // loop (default) or pingpong
+	MoveInterval  int            `yaml:"moveInterval,omitempty"` // seconds between moves, 0 = game default
+	EnemyTrait    *YAMLEnemyTrait `yaml:"enemyTrait,omitempty"`
+	MerchantTrait *YAMLMerchantTrait `yaml:"merchantTrait,omitempty"`
+	Meta          YAMLNPCMeta    `yaml:"meta,omitempty"`
 }
 
 // YAMLEnemyTrait contains enemy-specific configuration
 type YAMLEnemyTrait struct {
-	CreatureType  string  `yaml:"creatureType"`
-	CombatStyle   string  `yaml:"combatStyle"`
-	Difficulty    string  `yaml:"difficulty"`
-	AttackPower   int32   `yaml:"attackPower"`
-	Defense       int32   `yaml:"defense"`
-	AttackSpeed   float64 `yaml:"attackSpeed"`
-	AggroRadius   int     `yaml:"aggroRadius"`
-	AggroOnSight  bool    `yaml:"aggroOnSight"`
-	CallForHelp   bool    `yaml:"callForHelp"`
-	FleeThreshold float64 `yaml:"fleeThreshold"`
-	XPReward      int64   `yaml:"xpReward"`
-	LootTableID   string  `yaml:"lootTableID"`
+	CreatureType  string  `yaml:"creatureType,omitempty"`
+	CombatStyle   string  `yaml:"combatStyle,omitempty"`
+	Difficulty    string  `yaml:"difficulty,omitempty"`
+	AttackPower   int32   `yaml:"attackPower,omitempty"`
+	Defense       int32   `yaml:"defense,omitempty"`
+	AttackSpeed   float64 `yaml:"attackSpeed,omitempty"`
+	AggroRadius   int     `yaml:"aggroRadius,omitempty"`
+	AggroOnSight  bool    `yaml:"aggroOnSight,omitempty"`
+	AggroLevelGap int32   `yaml:"aggroLevelGap,omitempty"`
+	CallForHelp   bool    `yaml:"callForHelp,omitempty"`
+	FleeThreshold float64 `yaml:"fleeThreshold,omitempty"`
+	XPReward      int64   `yaml:"xpReward,omitempty"`
+	LootTableID   string  `yaml:"lootTableID,omitempty"`
+	OnAggroScript string  `yaml:"onAggroScript,omitempty"`
+	OnDeathScript string  `yaml:"onDeathScript,omitempty"`
+	OnFleeScript  string  `yaml:"onFleeScript,omitempty"`
 }
 
 // YAMLMerchantTrait contains merchant-specific configuration
 type YAMLMerchantTrait struct {
-	ShopName       string                      `yaml:"shopName"`
-	BuyRate        float64                     `yaml:"buyRate"`
-	SellRate       float64                     `yaml:"sellRate"`
-	Inventory      []YAMLMerchantInventoryItem `yaml:"inventory"`
+	ShopName        string                      `yaml:"shopName,omitempty"`
+	BuyRate         float64                     `yaml:"buyRate,omitempty"`
+	SellRate        float64                     `yaml:"sellRate,omitempty"`
+	RestockMinutes  int32                       `yaml:"restockMinutes,omitempty"`
+	RestockVariance int32                       `yaml:"restockVariance,omitempty"`
+	Inventory       []YAMLMerchantInventoryItem `yaml:"inventory,omitempty"`
 }
 
 // YAMLMerchantInventoryItem represents an item in a merchant's inventory
 type YAMLMerchantInventoryItem struct {
-	ItemTemplateID string `yaml:"itemTemplateID"`
-	Stock          int32  `yaml:"stock"`
+	ItemTemplateID string `yaml:"itemTemplateID,omitempty"`
+	Stock          int32  `yaml:"stock,omitempty"`
 }
 
 // YAMLNPCMeta contains NPC metadata
 type YAMLNPCMeta struct {
-	Img string `yaml:"img"`
+	Img string `yaml:"img,omitempty"`
 }
 
 // YAMLScript represents a script in YAML format
 type YAMLScript struct {
-	ID          string `yaml:"id"`
-	Name        string `yaml:"name"`
-	Description string `yaml:"description"`
-	Type        string `yaml:"type"`
-	Language    string `yaml:"language"`
-	Code        string `yaml:"code"`
+	ID          string            `yaml:"id"`
+	Name        string            `yaml:"name"`
+	Description string            `yaml:"description,omitempty"`
+	Type        string            `yaml:"type,omitempty"`
+	Language    string            `yaml:"language,omitempty"`
+	Code        string            `yaml:"code,omitempty"`
+	Events      []YAMLScriptEvent `yaml:"events,omitempty"`
+}
+
+// YAMLScriptEvent binds an event script to a game event
+type YAMLScriptEvent struct {
+	Event    string `yaml:"event"`
+	Priority int    `yaml:"priority,omitempty"`
+	Filter   string `yaml:"filter,omitempty"`
+	Async    bool   `yaml:"async,omitempty"`
 }
 
 // YAMLDialog represents a dialog tree in YAML format
 type YAMLDialog struct {
 	ID          string                   `yaml:"id"`
 	Name        string                   `yaml:"name"`
-	Type        string                   `yaml:"type"`
-	NPCRef      string                   `yaml:"npc_ref"`
-	Description string                   `yaml:"description"`
-	Barks       []YAMLBark               `yaml:"barks"`
-	Tree        map[string]YAMLDialogNode `yaml:"tree"`
-	Tags        []string                 `yaml:"tags"`
+	Type        string                   `yaml:"type,omitempty"`
+	NPCRef      string                   `yaml:"npc_ref,omitempty"`
+	Description string                   `yaml:"description,omitempty"`
+	Barks       []YAMLBark               `yaml:"barks,omitempty"`
+	Tree        map[string]YAMLDialogNode `yaml:"tree,omitempty"`
+	Tags        []string                 `yaml:"tags,omitempty"`
 }
 
 // YAMLBark represents an idle/bark line
 type YAMLBark struct {
-	Text       string   `yaml:"text"`
-	Conditions []string `yaml:"conditions"`
-	Weight     int      `yaml:"weight"`
+	Text       string   `yaml:"text,omitempty"`
+	Conditions []string `yaml:"conditions,omitempty"`
+	Weight     int      `yaml:"weight,omitempty"`
 }
 
 // YAMLDialogNode represents a node in the dialog tree
 type YAMLDialogNode struct {
-	NPCText string             `yaml:"npc_text"`
-	Options []YAMLDialogOption `yaml:"options"`
+	NPCText string             `yaml:"npc_text,omitempty"`
+	Options []YAMLDialogOption `yaml:"options,omitempty"`
 }
 
 // YAMLDialogOption represents a player's dialog choice
 type YAMLDialogOption struct {
-	PlayerText string   `yaml:"player_text"`
-	Next       string   `yaml:"next"`
-	Conditions []string `yaml:"conditions"`
+	PlayerText string   `yaml:"player_text,omitempty"`
+	Next       string   `yaml:"next,omitempty"`
+	Conditions []string `yaml:"conditions,omitempty"`
 }
 
 // YAMLLootTable represents a loot table in YAML format
 type YAMLLootTable struct {
 	ID          string           `yaml:"id"`
 	Name        string           `yaml:"name"`
-	Type        string           `yaml:"type"`
-	Description string           `yaml:"description"`
-	Entries     []YAMLLootEntry  `yaml:"entries"`
-	Guaranteed  []string         `yaml:"guaranteed"`
-	GoldRange   []int32          `yaml:"gold_range"`
-	Tags        []string         `yaml:"tags"`
-	Flags       YAMLLootFlags    `yaml:"flags"`
+	Type        string           `yaml:"type,omitempty"`
+	Description string           `yaml:"description,omitempty"`
+	Entries     []YAMLLootEntry  `yaml:"entries,omitempty"`
+	Guaranteed  []string         `yaml:"guaranteed,omitempty"`
+	GoldRange   []int32          `yaml:"gold_range,omitempty"`
+	Tags        []string         `yaml:"tags,omitempty"`
+	Flags       YAMLLootFlags    `yaml:"flags,omitempty"`
 }
 
 // YAMLLootEntry represents a loot table entry
 type YAMLLootEntry struct {
-	Item       string   `yaml:"item"`
-	Weight     int      `yaml:"weight"`
-	MinCount   int32    `yaml:"min_count"`
-	MaxCount   int32    `yaml:"max_count"`
-	Conditions []string `yaml:"conditions"`
+	Item       string   `yaml:"item,omitempty"`
+	Weight     int      `yaml:"weight,omitempty"`
+	MinCount   int32    `yaml:"min_count,omitempty"`
+	MaxCount   int32    `yaml:"max_count,omitempty"`
+	Conditions []string `yaml:"conditions,omitempty"`
 }
 
 // YAMLLootFlags contains loot table flags
 type YAMLLootFlags struct {
-	ScalesWithLevel bool `yaml:"scales_with_level"`
-	BossLoot        bool `yaml:"boss_loot"`
+	ScalesWithLevel bool `yaml:"scales_with_level,omitempty"`
+	BossLoot        bool `yaml:"boss_loot,omitempty"`
+}
+
+// YAMLQuest represents a quest in YAML format
+type YAMLQuest struct {
+	ID            string               `yaml:"id"`
+	Name          string               `yaml:"name"`
+	Description   string               `yaml:"description,omitempty"`
+	Giver         string               `yaml:"giver,omitempty"`
+	MinLevel      int32                `yaml:"minLevel,omitempty"`
+	Prerequisites []string             `yaml:"prerequisites,omitempty"`
+	Repeatable    bool                 `yaml:"repeatable,omitempty"`
+	Objectives    []YAMLQuestObjective `yaml:"objectives,omitempty"`
+	Rewards       YAMLQuestRewards     `yaml:"rewards,omitempty"`
+	Tags          []string             `yaml:"tags,omitempty"`
+}
+
+// YAMLQuestObjective represents a single quest objective
+type YAMLQuestObjective struct {
+	ID          string `yaml:"id"`
+	Type        string `yaml:"type,omitempty"`
+	Target      string `yaml:"target,omitempty"`
+	Count       int32  `yaml:"count,omitempty"`
+	Description string `yaml:"description,omitempty"`
+}
+
+// YAMLQuestRewards contains the rewards granted on quest completion
+type YAMLQuestRewards struct {
+	XP    int32    `yaml:"xp,omitempty"`
+	Gold  int64    `yaml:"gold,omitempty"`
+	Items []string `yaml:"items,omitempty"`
+}
+
+// YAMLSkill represents a skill in YAML format
+type YAMLSkill struct {
+	ID            string           `yaml:"id"`
+	Name          string           `yaml:"name"`
+	Description   string           `yaml:"description,omitempty"`
+	Classes       []string         `yaml:"classes,omitempty"`
+	MinLevel      int32            `yaml:"minLevel,omitempty"`
+	Resource      string           `yaml:"resource,omitempty"`
+	Cost          int32            `yaml:"cost,omitempty"`
+	Cooldown      int32            `yaml:"cooldown,omitempty"`
+	Target        string           `yaml:"target,omitempty"`
+	Effect        string           `yaml:"effect,omitempty"`
+	Formula       YAMLSkillFormula `yaml:"formula,omitempty"`
+	IgnoreDefense bool             `yaml:"ignoreDefense,omitempty"`
+	Script        string           `yaml:"script,omitempty"`
+	Tags          []string         `yaml:"tags,omitempty"`
+}
+
+// YAMLSkillFormula contains the damage or heal formula of a skill
+type YAMLSkillFormula struct {
+	Base                int32   `yaml:"base,omitempty"`
+	Dice                int32   `yaml:"dice,omitempty"`
+	Attribute           string  `yaml:"attribute,omitempty"`
+	AttributeMultiplier float64 `yaml:"attributeMultiplier,omitempty"`
+	LevelMultiplier     float64 `yaml:"levelMultiplier,omitempty"`
 }
 
 // ImportConfig contains configuration for the import process
 type ImportConfig struct {
-	StartRoomID string `yaml:"startRoomID"`
+	StartRoomID string `yaml:"startRoomID,omitempty"`
 }
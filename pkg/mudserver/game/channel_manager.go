@@ -0,0 +1,150 @@
+package game
+
+import (
+	"strings"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/talesmud/talesmud/pkg/entities/channels"
+	"github.com/talesmud/talesmud/pkg/entities/characters"
+	m "github.com/talesmud/talesmud/pkg/mudserver/game/messages"
+)
+
+// ChannelManager delivers chat channel messages and keeps the recent lines of every channel
+// The history lives in memory, it ends with a restart
+type ChannelManager struct {
+	mu sync.Mutex
+
+	// history maps the lowercase channel name to its recent lines, oldest first
+	history map[string][]channels.Line
+
+	// stored caches the channels of the database, loaded on the first lookup
+	stored []*channels.Channel
+	loaded bool
+
+	game *Game
+}
+
+// NewChannelManager creates a new channel manager
+func NewChannelManager(game *Game) *ChannelManager {
+	return &ChannelManager{
+		history: make(map[string][]channels.Line),
+		game:    game,
+	}
+}
+
+// Channels returns the stored channels, they are loaded once and cached until ReloadChannels
+func (cm *ChannelManager) Channels() []*channels.Channel {
+	cm.mu.Lock()
+	defer cm.mu.Unlock()
+
+	if !cm.loaded {
+		all, err := cm.game.Facade.ChannelsService().FindAll()
+		if err != nil {
+			log.WithError(err).Error("Could not load chat channels")
+			return nil
+		}
+		cm.stored = all
+		cm.loaded = true
+	}
+	result := make([]*channels.Channel, len(cm.stored))
+	copy(result, cm.stored)
+	return result
+}
+
+// FindChannel returns the channel with the case insensitive name, nil if there is none
+func (cm *ChannelManager) FindChannel(name string) *channels.Channel {
+	for _, channel := range cm.Channels() {
+		if strings.EqualFold(channel.Name, name) {
+			return channel
+		}
+	}
+	return nil
+}
+
+// ReloadChannels drops the cached channels, the next lookup loads them from the database
+func (cm *ChannelManager) ReloadChannels() {
+	cm.mu.Lock()
+	defer cm.mu.Unlock()
+
+	cm.stored = nil
+	cm.loaded = false
+}
+
+// record adds a line to the channel history and drops lines beyond the history size
+func (cm *ChannelManager) record(channel *channels.Channel, line channels.Line) {
+	size := channel.History()
+	if size == 0 {
+		return
+	}
+
+	cm.mu.Lock()
+	defer cm.mu.Unlock()
+
+	lines := append(cm.history[channel.Key()], line)
+	if len(lines) > size {
+		lines = lines[len(lines)-size:]
+	}
+	cm.history[channel.Key()] = lines
+}
+
+// lines returns a copy of the channel history
+func (cm *ChannelManager) lines(name string) []channels.Line {
+	cm.mu.Lock()
+	defer cm.mu.Unlock()
+
+	lines := cm.history[strings.ToLower(name)]
+	result := make([]channels.Line, len(lines))
+	copy(result, lines)
+	return result
+}
+
+// Broadcast sends the text to all online members of the channel and adds it to the history
+func (cm *ChannelManager) Broadcast(channel *channels.Channel, sender *characters.Character, text string) {
+	cm.record(channel, channels.Line{
+		Time:   time.Now(),
+		Sender: sender.Name,
+		Text:   text,
+	})
+
+	users, err := cm.game.Facade.UsersService().FindAllOnline()
+	if err != nil {
+		log.WithError(err).Error("Could not find online users for channel message")
+		return
+	}
+
+	formatted := formatChannelLine(channel.Name, sender.Name, text)
+	for _, user := range users {
+		if user.LastCharacter == "" {
+			continue
+		}
+		member, err := cm.game.Facade.CharactersService().FindByID(user.LastCharacter)
+		if err != nil || !member.IsInChannel(channel.Name) {
+			continue
+		}
+		cm.game.SendMessage() <- m.NewChatMessage(user.ID, channel.Key(), sender.Name, formatted)
+	}
+}
+
+// ReplayHistory sends the recent lines of the joined channels to the user
+func (cm *ChannelManager) ReplayHistory(userID string, character *characters.Character) {
+	for _, name := range character.Channels {
+		lines := cm.lines(name)
+		if len(lines) == 0 {
+			continue
+		}
+
+		var sb strings.Builder
+		sb.WriteString("Recent messages on [" + name + "]:")
+		for _, line := range lines {
+			sb.WriteString("\n" + line.Time.Format("15:04") + " " + formatChannelLine(name, line.Sender, line.Text))
+		}
+		cm.game.SendMessage() <- m.NewChatMessage(userID, name, "", sb.String())
+	}
+}
+
+// formatChannelLine renders a channel line as "[ooc] Name: text"
+func formatChannelLine(channel, sender, text string) string {
+	return "[" + channel + "] " + sender + ": " + text
+}
@@ -0,0 +1,123 @@
+package combat
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/talesmud/talesmud/pkg/entities/combat"
+	"github.com/talesmud/talesmud/pkg/entities/effects"
+)
+
+// EffectTickResult contains the result of processing the status effects at the end of a round
+type EffectTickResult struct {
+	Messages []string
+	// Died contains the IDs of all combatants killed by damage over time
+	Died []string
+}
+
+// ApplyEffect applies a status effect to a combatant, returns false if the combatant is unknown or the effect was ignored
+func (e *Engine) ApplyEffect(instance *combat.CombatInstance, combatantID string, effect effects.StatusEffect) bool {
+	combatant := instance.GetCombatantByID(combatantID)
+	if combatant == nil || !combatant.IsAlive {
+		return false
+	}
+	if !combatant.Effects.Apply(effect, time.Now()) {
+		return false
+	}
+	e.UpdateCombatant(instance, combatant)
+
+	instance.AddLogEntry(combat.CombatLogEntry{
+		ActorID:    effect.SourceID,
+		ActorName:  effect.SourceName,
+		Action:     combat.CombatActionEffect,
+		TargetID:   combatant.ID,
+		TargetName: combatant.Name,
+		Result:     "applied",
+		Message:    fmt.Sprintf("%s is affected by %s.", combatant.Name, effect.DisplayName()),
+	})
+	return true
+}
+
+// RemoveEffect removes a status effect by ID or type from a combatant
+func (e *Engine) RemoveEffect(instance *combat.CombatInstance, combatantID string, idOrType string) bool {
+	combatant := instance.GetCombatantByID(combatantID)
+	if combatant == nil || !combatant.Effects.Remove(idOrType) {
+		return false
+	}
+	e.UpdateCombatant(instance, combatant)
+	return true
+}
+
+// IsStunned returns true if the combatant loses its turn
+func (e *Engine) IsStunned(instance *combat.CombatInstance, combatantID string) bool {
+	combatant := instance.GetCombatantByID(combatantID)
+	return combatant != nil && combatant.Effects.Has(effects.EffectTypeStun, time.Now())
+}
+
+// IsHasted returns true if the combatant gets an additional attack
+func (e *Engine) IsHasted(instance *combat.CombatInstance, combatantID string) bool {
+	combatant := instance.GetCombatantByID(combatantID)
+	return combatant != nil && combatant.Effects.Has(effects.EffectTypeHaste, time.Now())
+}
+
+// ProcessStatusEffects applies damage over time and counts down all status effects, called at the end of each round
+func (e *Engine) ProcessStatusEffects(instance *combat.CombatInstance) EffectTickResult {
+	result := EffectTickResult{}
+	now := time.Now()
+
+	process := func(combatant *combat.CombatantRef) {
+		if !combatant.IsAlive || combatant.HasFled || len(combatant.Effects) == 0 {
+			return
+		}
+
+		for _, effect := range combatant.Effects {
+			damage := effect.TickDamage()
+			if damage <= 0 || effect.IsExpired(now) || !combatant.IsAlive {
+				continue
+			}
+
+			combatant.CurrentHP -= damage
+			message := fmt.Sprintf("%s suffers %d damage from %s.", combatant.Name, damage, effect.DisplayName())
+			if combatant.CurrentHP <= 0 {
+				combatant.CurrentHP = 0
+				combatant.IsAlive = false
+				result.Died = append(result.Died, combatant.ID)
+				message += fmt.Sprintf(" %s has been defeated!", combatant.Name)
+			} else {
+				message += fmt.Sprintf(" (%d/%d HP)", combatant.CurrentHP, combatant.MaxHP)
+			}
+			result.Messages = append(result.Messages, message)
+
+			// damage over time is credited to the source of the effect
+			instance.AddLogEntry(combat.CombatLogEntry{
+				ActorID:    effect.SourceID,
+				ActorName:  effect.SourceName,
+				Action:     combat.CombatActionEffect,
+				TargetID:   combatant.ID,
+				TargetName: combatant.Name,
+				Result:     string(effect.Type),
+				Damage:     damage,
+				Message:    message,
+			})
+		}
+
+		expired := combatant.Effects.EndRound()
+		expired = append(expired, combatant.Effects.Prune(now)...)
+		if combatant.IsAlive {
+			for _, effect := range expired {
+				result.Messages = append(result.Messages, fmt.Sprintf("%s is no longer affected by %s.", combatant.Name, effect.DisplayName()))
+			}
+		}
+
+		e.UpdateCombatant(instance, combatant)
+	}
+
+	for i := range instance.Players {
+		process(&instance.Players[i])
+	}
+	for i := range instance.Enemies {
+		process(&instance.Enemies[i])
+	}
+
+	return result
+}
@@ -61,15 +61,25 @@ func NewEngine(manager *Manager, config *CombatConfig) *Engine {
 
 // CreateCombatantFromCharacter creates a CombatantRef from a Character
 func (e *Engine) CreateCombatantFromCharacter(char *characters.Character) combat.CombatantRef {
+	// Apply out of combat regeneration before the resources are snapshotted
+	char.RegenerateResources(time.Now())
+	char.StatusEffects.Prune(time.Now())
+
 	// Calculate defense from equipment
 	defense := char.GetArmorDefense()
 
-	// Calculate attack power from weapon
-	attackPower := char.GetWeaponDamage() + int32(char.GetSTRMod())
+	// Calculate attack power from weapon, buffs are applied by the combatant's status effects
+	attackPower := char.GetWeaponDamage() + int32(char.GetBaseAttributeModifier("STR"))
 	if attackPower < 1 {
 		attackPower = 1
 	}
 
+	// Copy cooldowns so the snapshot does not share the character map
+	cooldowns := make(map[string]time.Time, len(char.SkillCooldowns))
+	for id, readyAt := range char.SkillCooldowns {
+		cooldowns[id] = readyAt
+	}
+
 	return combat.CombatantRef{
 		ID:          char.Entity.ID,
 		Type:        combat.CombatantTypePlayer,
@@ -81,9 +91,19 @@ func (e *Engine) CreateCombatantFromCharacter(char *characters.Character) combat
 		CurrentHP:   char.CurrentHitPoints,
 		AttackPower: attackPower,
 		Defense:     defense,
-		STRMod:      char.GetSTRMod(),
-		DEXMod:      char.GetDEXMod(),
-		CONMod:      char.GetCONMod(),
+		STRMod:      char.GetBaseAttributeModifier("STR"),
+		DEXMod:      char.GetBaseAttributeModifier("DEX"),
+		CONMod:      char.GetBaseAttributeModifier("CON"),
+		INTMod:      char.GetBaseAttributeModifier("INT"),
+		WISMod:      char.GetBaseAttributeModifier("WIS"),
+		Effects:     char.StatusEffects.Copy(),
+
+		Level:          char.Level,
+		CurrentMana:    char.CurrentMana,
+		MaxMana:        char.MaxMana,
+		CurrentStamina: char.CurrentStamina,
+		MaxStamina:     char.MaxStamina,
+		SkillCooldowns: cooldowns,
 	}
 }
 
@@ -115,13 +135,14 @@ func (e *Engine) CreateCombatantFromNPC(n *npc.NPC) combat.CombatantRef {
 		STRMod:      int(n.Level) / 4, // Approximation
 		DEXMod:      dexMod,
 		CONMod:      int(n.Level) / 4, // Approximation
+		Effects:     n.StatusEffects.Copy(),
 	}
 }
 
 // RollInitiative rolls initiative (1d20 + DEX modifier) for a combatant
 func (e *Engine) RollInitiative(c *combat.CombatantRef) int {
 	roll := rand.Intn(20) + 1 // 1d20
-	initiative := roll + c.DEXMod
+	initiative := roll + c.Modifier("dex")
 	c.Initiative = initiative
 	return initiative
 }
@@ -170,6 +191,44 @@ func (e *Engine) InitiateCombat(roomID string, players []*characters.Character,
 	return instance
 }
 
+// AddPlayer adds a character to a running combat instance, the player acts from the next round on
+func (e *Engine) AddPlayer(instance *combat.CombatInstance, char *characters.Character) bool {
+	if instance.GetPlayerByID(char.Entity.ID) != nil {
+		return false
+	}
+
+	combatant := e.CreateCombatantFromCharacter(char)
+	e.RollInitiative(&combatant)
+	instance.Players = append(instance.Players, combatant)
+	e.Manager.RegisterPlayer(char.Entity.ID, instance.ID)
+
+	instance.AddLogEntry(combat.CombatLogEntry{
+		ActorID: char.Entity.ID,
+		Message: fmt.Sprintf("%s joins the fight!", char.Name),
+	})
+
+	return true
+}
+
+// AddEnemy adds an NPC to a running combat instance, the NPC acts from the next round on
+func (e *Engine) AddEnemy(instance *combat.CombatInstance, n *npc.NPC) bool {
+	if instance.GetEnemyByID(n.Entity.ID) != nil {
+		return false
+	}
+
+	combatant := e.CreateCombatantFromNPC(n)
+	e.RollInitiative(&combatant)
+	instance.Enemies = append(instance.Enemies, combatant)
+	e.Manager.RegisterNPC(n.Entity.ID, instance.ID)
+
+	instance.AddLogEntry(combat.CombatLogEntry{
+		ActorID: n.Entity.ID,
+		Message: fmt.Sprintf("%s joins the fight!", n.GetDisplayName()),
+	})
+
+	return true
+}
+
 // BuildTurnOrder creates the turn order from all living combatants sorted by initiative
 func (e *Engine) BuildTurnOrder(instance *combat.CombatInstance) {
 	instance.TurnOrder = make([]combat.CombatantRef, 0)
@@ -228,10 +287,11 @@ func (e *Engine) ProcessAttack(instance *combat.CombatInstance, attackerID, targ
 
 	// Roll to hit: 1d20 + STR modifier
 	roll := rand.Intn(20) + 1
-	toHit := roll + attacker.STRMod
+	strMod := attacker.Modifier("str")
+	toHit := roll + strMod
 
 	// Target AC = 10 + Defense + DefenseBonus
-	targetAC := 10 + int(target.Defense) + int(target.DefenseBonus)
+	targetAC := 10 + int(target.EffectiveDefense()) + int(target.DefenseBonus)
 
 	result := AttackResult{
 		Roll:     roll,
@@ -254,7 +314,7 @@ func (e *Engine) ProcessAttack(instance *combat.CombatInstance, attackerID, targ
 	} else {
 		result.Miss = true
 		result.Message = fmt.Sprintf("%s attacks %s but misses! (Roll: %d + %d = %d vs AC %d)",
-			attacker.Name, target.Name, roll, attacker.STRMod, toHit, targetAC)
+			attacker.Name, target.Name, roll, strMod, toHit, targetAC)
 		return result
 	}
 
@@ -278,7 +338,7 @@ func (e *Engine) ProcessAttack(instance *combat.CombatInstance, attackerID, targ
 			attacker.Name, target.Name, result.Damage)
 	} else {
 		result.Message = fmt.Sprintf("%s hits %s for %d damage. (Roll: %d + %d = %d vs AC %d)",
-			attacker.Name, target.Name, result.Damage, roll, attacker.STRMod, toHit, targetAC)
+			attacker.Name, target.Name, result.Damage, roll, strMod, toHit, targetAC)
 	}
 
 	if result.TargetDied {
@@ -309,10 +369,10 @@ func (e *Engine) ProcessAttack(instance *combat.CombatInstance, attackerID, targ
 // CalculateDamage computes damage from attacker to target
 func (e *Engine) CalculateDamage(attacker, target *combat.CombatantRef, critical bool) int32 {
 	// Base damage = AttackPower (includes weapon damage + STR for players)
-	baseDamage := attacker.AttackPower
+	baseDamage := attacker.EffectiveAttack()
 
 	// Defense reduction = target defense / 2
-	reduction := target.Defense / 2
+	reduction := target.EffectiveDefense() / 2
 
 	// Final damage (minimum 1)
 	damage := baseDamage - reduction
@@ -402,7 +462,7 @@ func (e *Engine) ProcessFlee(instance *combat.CombatInstance, fleeingID string)
 	}
 
 	// Calculate flee chance: base + DEX bonus
-	chance := e.Config.FleeBaseChance + (float64(fleeing.DEXMod) * e.Config.FleeDEXBonus)
+	chance := e.Config.FleeBaseChance + (float64(fleeing.Modifier("dex")) * e.Config.FleeDEXBonus)
 	if chance > 0.95 {
 		chance = 0.95 // Cap at 95%
 	}
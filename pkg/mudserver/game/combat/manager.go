@@ -1,6 +1,7 @@
 package combat
 
 import (
+	"encoding/json"
 	"sync"
 
 	log "github.com/sirupsen/logrus"
@@ -130,6 +131,20 @@ func (m *Manager) RemoveInstance(id string) {
 	log.WithField("instanceID", id).Info("Removed combat instance")
 }
 
+// RestoreInstance registers a previously saved combat instance and its participants
+func (m *Manager) RestoreInstance(instance *combat.CombatInstance) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.instances[instance.ID] = instance
+	for _, player := range instance.Players {
+		m.playerToCombat[player.ID] = instance.ID
+	}
+	for _, enemy := range instance.Enemies {
+		m.npcToCombat[enemy.ID] = instance.ID
+	}
+}
+
 // GetAllInstances returns all active combat instances
 func (m *Manager) GetAllInstances() []*combat.CombatInstance {
 	m.mu.RLock()
@@ -156,6 +171,30 @@ func (m *Manager) GetActiveInstances() []*combat.CombatInstance {
 	return result
 }
 
+// SnapshotActiveInstances returns deep copies of the active combat instances for the world snapshot,
+// they are copied under the manager lock so the live instances can keep changing
+func (m *Manager) SnapshotActiveInstances() ([]*combat.CombatInstance, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	active := make([]*combat.CombatInstance, 0, len(m.instances))
+	for _, inst := range m.instances {
+		if inst.State == combat.CombatStateActive {
+			active = append(active, inst)
+		}
+	}
+
+	data, err := json.Marshal(active)
+	if err != nil {
+		return nil, err
+	}
+	var result []*combat.CombatInstance
+	if err := json.Unmarshal(data, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
 // IsPlayerInCombat checks if a player is currently in a combat instance
 func (m *Manager) IsPlayerInCombat(characterID string) bool {
 	m.mu.RLock()
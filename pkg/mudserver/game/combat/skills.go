@@ -0,0 +1,202 @@
+package combat
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/talesmud/talesmud/pkg/entities/combat"
+	"github.com/talesmud/talesmud/pkg/entities/skills"
+)
+
+// SkillResult contains the result of a skill use in combat
+type SkillResult struct {
+	Success bool
+	// Affected contains the IDs of all combatants hit or healed by the skill
+	Affected []string
+	// Died contains the IDs of all combatants killed by the skill
+	Died    []string
+	Total   int32
+	Message string
+}
+
+// ProcessSkill handles a combatant using a skill on a target
+// An empty targetID selects the default target for the skill's target type
+func (e *Engine) ProcessSkill(instance *combat.CombatInstance, casterID string, skill *skills.Skill, targetID string) SkillResult {
+	caster := instance.GetCombatantByID(casterID)
+	if caster == nil || skill == nil {
+		return SkillResult{Message: "Invalid caster or skill"}
+	}
+	if !caster.IsAlive || caster.HasFled {
+		return SkillResult{Message: fmt.Sprintf("%s can't use skills right now.", caster.Name)}
+	}
+
+	now := time.Now()
+	if readyAt, ok := caster.SkillCooldowns[skill.ID]; ok && readyAt.After(now) {
+		return SkillResult{Message: fmt.Sprintf("%s is not ready yet (%ds).", skill.Name, int(readyAt.Sub(now).Seconds())+1)}
+	}
+
+	targets := e.resolveSkillTargets(instance, caster, skill, targetID)
+	if len(targets) == 0 {
+		return SkillResult{Message: fmt.Sprintf("%s has no valid target.", skill.Name)}
+	}
+
+	if !spendCombatantResource(caster, skill.ResourceType, skill.Cost) {
+		return SkillResult{Message: fmt.Sprintf("%s doesn't have enough %s for %s.", caster.Name, skill.ResourceType, skill.Name)}
+	}
+	if skill.CooldownSec > 0 {
+		if caster.SkillCooldowns == nil {
+			caster.SkillCooldowns = make(map[string]time.Time)
+		}
+		caster.SkillCooldowns[skill.ID] = now.Add(skill.Cooldown())
+	}
+	e.UpdateCombatant(instance, caster)
+
+	result := SkillResult{Success: true}
+	parts := make([]string, 0, len(targets))
+
+	for _, target := range targets {
+		amount := skill.RollAmount(caster.Modifier(skill.Formula.Attribute), caster.Level)
+
+		switch skill.Effect {
+		case skills.EffectTypeHeal:
+			before := target.CurrentHP
+			target.CurrentHP += amount
+			if target.CurrentHP > target.MaxHP {
+				target.CurrentHP = target.MaxHP
+			}
+			amount = target.CurrentHP - before
+			parts = append(parts, fmt.Sprintf("%s is healed for %d (%d/%d HP)", target.Name, amount, target.CurrentHP, target.MaxHP))
+
+		default:
+			if !skill.IgnoreDefense {
+				amount -= target.EffectiveDefense() / 2
+				if amount < 1 {
+					amount = 1
+				}
+			}
+			target.CurrentHP -= amount
+			if target.CurrentHP <= 0 {
+				target.CurrentHP = 0
+				target.IsAlive = false
+				result.Died = append(result.Died, target.ID)
+				parts = append(parts, fmt.Sprintf("%s takes %d damage and has been defeated!", target.Name, amount))
+			} else {
+				parts = append(parts, fmt.Sprintf("%s takes %d damage (%d/%d HP)", target.Name, amount, target.CurrentHP, target.MaxHP))
+			}
+		}
+
+		e.UpdateCombatant(instance, target)
+		result.Affected = append(result.Affected, target.ID)
+		result.Total += amount
+
+		// heals are logged without damage so they don't count as kills
+		var logDamage int32
+		if skill.Effect != skills.EffectTypeHeal {
+			logDamage = amount
+		}
+		instance.AddLogEntry(combat.CombatLogEntry{
+			ActorID:    caster.ID,
+			ActorName:  caster.Name,
+			Action:     combat.CombatActionSkill,
+			TargetID:   target.ID,
+			TargetName: target.Name,
+			Result:     string(skill.Effect),
+			Damage:     logDamage,
+			Message:    fmt.Sprintf("%s uses %s on %s.", caster.Name, skill.Name, target.Name),
+		})
+	}
+
+	result.Message = fmt.Sprintf("%s uses %s! %s", caster.Name, skill.Name, strings.Join(parts, ", "))
+	return result
+}
+
+// resolveSkillTargets returns the combatants affected by a skill
+func (e *Engine) resolveSkillTargets(instance *combat.CombatInstance, caster *combat.CombatantRef, skill *skills.Skill, targetID string) []*combat.CombatantRef {
+	opponents := instance.GetLivingEnemies()
+	allies := instance.GetLivingPlayers()
+	if caster.Type == combat.CombatantTypeNPC {
+		opponents, allies = allies, opponents
+	}
+
+	switch skill.Target {
+	case skills.TargetTypeSelf:
+		return []*combat.CombatantRef{caster}
+
+	case skills.TargetTypeAlly:
+		if targetID == "" {
+			return []*combat.CombatantRef{caster}
+		}
+		for _, ally := range allies {
+			if ally.ID == targetID {
+				return []*combat.CombatantRef{ally}
+			}
+		}
+		return nil
+
+	case skills.TargetTypeAllEnemies:
+		return opponents
+
+	default:
+		if targetID == "" {
+			targetID = caster.AutoAttackTargetID
+		}
+		for _, opponent := range opponents {
+			if opponent.ID == targetID {
+				return []*combat.CombatantRef{opponent}
+			}
+		}
+		if len(opponents) > 0 {
+			return opponents[:1]
+		}
+		return nil
+	}
+}
+
+// spendCombatantResource removes the skill cost from the combatant, returns false if there is not enough left
+func spendCombatantResource(c *combat.CombatantRef, resourceType skills.ResourceType, amount int32) bool {
+	if amount <= 0 {
+		return true
+	}
+	switch resourceType {
+	case skills.ResourceTypeMana:
+		if c.CurrentMana < amount {
+			return false
+		}
+		c.CurrentMana -= amount
+	case skills.ResourceTypeStamina:
+		if c.CurrentStamina < amount {
+			return false
+		}
+		c.CurrentStamina -= amount
+	}
+	return true
+}
+
+// HealCombatant heals a living combatant by a skill used from outside the fight, e.g. by a party member
+// Returns the healed combatant and the amount of HP restored, nil if the combatant is unknown or defeated
+func (e *Engine) HealCombatant(instance *combat.CombatInstance, healerID, healerName string, skill *skills.Skill, targetID string, amount int32) (*combat.CombatantRef, int32) {
+	target := instance.GetCombatantByID(targetID)
+	if target == nil || !target.IsAlive || target.HasFled {
+		return nil, 0
+	}
+
+	before := target.CurrentHP
+	target.CurrentHP += amount
+	if target.CurrentHP > target.MaxHP {
+		target.CurrentHP = target.MaxHP
+	}
+	healed := target.CurrentHP - before
+	e.UpdateCombatant(instance, target)
+
+	instance.AddLogEntry(combat.CombatLogEntry{
+		ActorID:    healerID,
+		ActorName:  healerName,
+		Action:     combat.CombatActionSkill,
+		TargetID:   target.ID,
+		TargetName: target.Name,
+		Result:     string(skill.Effect),
+		Message:    fmt.Sprintf("%s uses %s on %s.", healerName, skill.Name, target.Name),
+	})
+	return target, healed
+}
@@ -0,0 +1,592 @@
+package commands
+
+import (
+	"strconv"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/talesmud/talesmud/pkg/entities"
+	"github.com/talesmud/talesmud/pkg/entities/audit"
+	"github.com/talesmud/talesmud/pkg/entities/characters"
+	"github.com/talesmud/talesmud/pkg/entities/items"
+	npc "github.com/talesmud/talesmud/pkg/entities/npcs"
+	"github.com/talesmud/talesmud/pkg/entities/rooms"
+	"github.com/talesmud/talesmud/pkg/mudserver/game/def"
+	"github.com/talesmud/talesmud/pkg/mudserver/game/messages"
+)
+
+// GotoCommand moves the staff member to a room or to a player
+type GotoCommand struct {
+}
+
+// Key returns the command key matcher
+func (command *GotoCommand) Key() CommandKey { return &StartsWithCommandKey{} }
+
+// Execute handles the goto command: "goto <room id>", "goto Town Square" or "goto bob"
+func (command *GotoCommand) Execute(game def.GameCtrl, message *messages.Message) bool {
+	target, ok := staffArgs(game, message, "Usage: goto <room id|room name|player>")
+	if !ok {
+		return true
+	}
+
+	room := findRoom(game, target)
+	if room == nil {
+		if character := findOnlineCharacter(game, target); character != nil {
+			room, _ = game.GetFacade().RoomsService().FindByID(character.CurrentRoomID)
+		}
+	}
+	if room == nil {
+		game.SendMessage() <- message.Reply("There is no room or player named '" + target + "'.")
+		return true
+	}
+	if room.ID == message.Character.CurrentRoomID {
+		game.SendMessage() <- message.Reply("You are already there.")
+		return true
+	}
+
+	moveCharacterTo(game, message.FromUser, message.Character, room,
+		message.Character.Name+" vanishes in a puff of smoke.",
+		message.Character.Name+" appears in a puff of smoke.")
+	return true
+}
+
+// TransferCommand brings an online player to the room of the staff member
+type TransferCommand struct {
+}
+
+// Key returns the command key matcher
+func (command *TransferCommand) Key() CommandKey { return &StartsWithCommandKey{} }
+
+// Execute handles the transfer command: "transfer bob" or "summon bob"
+func (command *TransferCommand) Execute(game def.GameCtrl, message *messages.Message) bool {
+	name, ok := staffArgs(game, message, "Usage: transfer <player>")
+	if !ok {
+		return true
+	}
+
+	target := findOnlineCharacter(game, name)
+	if target == nil {
+		game.SendMessage() <- message.Reply("There is no player named '" + name + "' online.")
+		return true
+	}
+	if target.CurrentRoomID == message.Character.CurrentRoomID {
+		game.SendMessage() <- message.Reply(target.Name + " is already here.")
+		return true
+	}
+	room, err := game.GetFacade().RoomsService().FindByID(message.Character.CurrentRoomID)
+	if err != nil {
+		game.SendMessage() <- message.Reply("You are nowhere.")
+		return true
+	}
+	user, err := game.GetFacade().UsersService().FindByID(target.BelongsUserID)
+	if err != nil {
+		game.SendMessage() <- message.Reply("Could not find the user of " + target.Name + ".")
+		return true
+	}
+
+	game.SendMessage() <- messages.Reply(user.ID, message.Character.Name+" summons you.")
+	moveCharacterTo(game, user, target, room,
+		target.Name+" is pulled away by an unseen force.",
+		target.Name+" appears, summoned by "+message.Character.Name+".")
+	game.SendMessage() <- message.Reply("You summon " + target.Name + ".")
+	return true
+}
+
+// SpawnCommand spawns an NPC instance from a template in the room of the staff member
+type SpawnCommand struct {
+}
+
+// Key returns the command key matcher
+func (command *SpawnCommand) Key() CommandKey { return &StartsWithCommandKey{} }
+
+// Execute handles the spawn command: "spawn <template id>" or "spawn Forest Wolf"
+func (command *SpawnCommand) Execute(game def.GameCtrl, message *messages.Message) bool {
+	name, ok := staffArgs(game, message, "Usage: spawn <npc template>")
+	if !ok {
+		return true
+	}
+
+	manager := game.GetNPCInstanceManager()
+	if manager == nil {
+		game.SendMessage() <- message.Reply("NPC instances are not loaded.")
+		return true
+	}
+	template := findNPCTemplate(game, name)
+	if template == nil {
+		game.SendMessage() <- message.Reply("There is no NPC template named '" + name + "'.")
+		return true
+	}
+
+	instance, err := manager.SpawnInstanceDirect(template.ID, message.Character.CurrentRoomID)
+	if err != nil {
+		log.WithError(err).WithField("template", template.ID).Error("Error spawning NPC")
+		game.SendMessage() <- message.Reply("Could not spawn " + template.GetDisplayName() + ".")
+		return true
+	}
+
+	game.SendMessage() <- message.Reply("You spawn " + instance.GetDisplayName() + ".")
+	sendRoomNotice(game, message, instance.GetDisplayName()+" appears out of thin air.")
+	return true
+}
+
+// PurgeCommand removes all NPCs and loose items from the room of the staff member
+type PurgeCommand struct {
+}
+
+// Key returns the command key matcher
+func (command *PurgeCommand) Key() CommandKey { return &ExactCommandKey{} }
+
+// Execute handles the purge command: "purge"
+func (command *PurgeCommand) Execute(game def.GameCtrl, message *messages.Message) bool {
+	if message.Character == nil {
+		game.SendMessage() <- message.Reply("You need to select a character first.")
+		return true
+	}
+	room, err := game.GetFacade().RoomsService().FindByID(message.Character.CurrentRoomID)
+	if err != nil {
+		game.SendMessage() <- message.Reply("You are nowhere.")
+		return true
+	}
+
+	// NPCs in combat stay, the fight would lose its enemies
+	npcs := 0
+	if manager := game.GetNPCInstanceManager(); manager != nil {
+		combatEngine := game.GetCombatEngine()
+		for _, instance := range manager.GetInstancesInRoom(room.ID) {
+			if combatEngine != nil && combatEngine.IsNPCInCombat(instance.ID) {
+				continue
+			}
+			manager.RemoveInstance(instance.ID)
+			npcs++
+		}
+	}
+
+	// items that can't be picked up are part of the room and stay
+	removed := 0
+	for _, itemID := range room.GetItemIDs() {
+		item, err := game.GetFacade().ItemsService().FindByID(itemID)
+		if err == nil && item.NoPickup {
+			continue
+		}
+		room.RemoveItem(itemID)
+		if err == nil {
+			if err := game.GetFacade().ItemsService().Delete(itemID); err != nil {
+				log.WithError(err).WithField("item", itemID).Error("Error deleting purged item")
+			}
+		}
+		removed++
+	}
+	if removed > 0 {
+		if err := game.GetFacade().RoomsService().Update(room.ID, room); err != nil {
+			log.WithError(err).Error("Error updating purged room")
+		}
+	}
+
+	game.SendMessage() <- message.Reply("You purge the room: " + itoa(npcs) + " NPCs and " + itoa(removed) + " items removed.")
+	sendRoomNotice(game, message, message.Character.Name+" waves a hand and the room is cleansed.")
+	return true
+}
+
+// RestoreCommand fully heals the staff member or a player
+type RestoreCommand struct {
+}
+
+// Key returns the command key matcher
+func (command *RestoreCommand) Key() CommandKey { return &StartsWithCommandKey{} }
+
+// Execute handles the restore command: "restore" or "restore bob"
+func (command *RestoreCommand) Execute(game def.GameCtrl, message *messages.Message) bool {
+	if message.Character == nil {
+		game.SendMessage() <- message.Reply("You need to select a character first.")
+		return true
+	}
+
+	target := message.Character
+	if parts := strings.Fields(message.Data); len(parts) > 1 {
+		target = findOnlineCharacter(game, parts[1])
+		if target == nil {
+			game.SendMessage() <- message.Reply("There is no player named '" + parts[1] + "' online.")
+			return true
+		}
+	}
+
+	target.CurrentHitPoints = target.MaxHitPoints
+	target.CurrentMana = target.MaxMana
+	target.CurrentStamina = target.MaxStamina
+	if err := game.GetFacade().CharactersService().Update(target.ID, target); err != nil {
+		log.WithError(err).Error("Error updating restored character")
+		game.SendMessage() <- message.Reply("Could not restore " + target.Name + ".")
+		return true
+	}
+
+	if target.ID == message.Character.ID {
+		game.SendMessage() <- message.Reply("You are fully restored.")
+		return true
+	}
+	game.SendMessage() <- message.Reply("You restore " + target.Name + ".")
+	game.SendMessage() <- messages.Reply(target.BelongsUserID, message.Character.Name+" has fully restored you.")
+	return true
+}
+
+// SetLevelCommand sets the level of a character
+type SetLevelCommand struct {
+}
+
+// Key returns the command key matcher
+func (command *SetLevelCommand) Key() CommandKey { return &StartsWithCommandKey{} }
+
+// Execute handles the setlevel command: "setlevel bob 10"
+func (command *SetLevelCommand) Execute(game def.GameCtrl, message *messages.Message) bool {
+	parts := strings.Fields(message.Data)
+	if len(parts) != 3 {
+		game.SendMessage() <- message.Reply("Usage: setlevel <player> <level>")
+		return true
+	}
+	level, err := strconv.Atoi(parts[2])
+	if err != nil || level < 1 {
+		game.SendMessage() <- message.Reply("The level must be a number of at least 1.")
+		return true
+	}
+
+	target := findCharacterByName(game, parts[1])
+	if target == nil {
+		game.SendMessage() <- message.Reply("There is no character named '" + parts[1] + "'.")
+		return true
+	}
+
+	charactersService := game.GetFacade().CharactersService()
+	levelUps := target.SetLevel(int32(level), charactersService.ProgressionCurve(), "admin")
+	if err := charactersService.Update(target.ID, target); err != nil {
+		log.WithError(err).Error("Error updating character level")
+		game.SendMessage() <- message.Reply("Could not change the level of " + target.Name + ".")
+		return true
+	}
+
+	game.SendMessage() <- message.Reply(target.Name + " is now level " + itoa(int(target.Level)) + ".")
+	if isCharacterOnline(game, target) {
+		if len(levelUps) > 0 {
+			NotifyLevelUps(game, target, levelUps)
+		} else {
+			game.SendMessage() <- messages.Reply(target.BelongsUserID, "You are now level "+itoa(int(target.Level))+".")
+		}
+	}
+	return true
+}
+
+// GiveItemCommand creates an item from a template in the inventory of the staff member or a player
+type GiveItemCommand struct {
+}
+
+// Key returns the command key matcher
+func (command *GiveItemCommand) Key() CommandKey { return &StartsWithCommandKey{} }
+
+// Execute handles the give command: "give item <template>" or "give item Iron Sword to bob"
+func (command *GiveItemCommand) Execute(game def.GameCtrl, message *messages.Message) bool {
+	usage := "Usage: give item <template> [to <player>]"
+	if message.Character == nil {
+		game.SendMessage() <- message.Reply("You need to select a character first.")
+		return true
+	}
+	parts := strings.Fields(message.Data)
+	if len(parts) < 3 || strings.ToLower(parts[1]) != "item" {
+		game.SendMessage() <- message.Reply(usage)
+		return true
+	}
+
+	args := parts[2:]
+	target := message.Character
+	for i := len(args) - 2; i > 0; i-- {
+		if strings.ToLower(args[i]) == "to" {
+			target = findOnlineCharacter(game, strings.Join(args[i+1:], " "))
+			if target == nil {
+				game.SendMessage() <- message.Reply("There is no player named '" + strings.Join(args[i+1:], " ") + "' online.")
+				return true
+			}
+			args = args[:i]
+			break
+		}
+	}
+	name := strings.Join(args, " ")
+
+	template := findItemTemplate(game, name)
+	if template == nil {
+		game.SendMessage() <- message.Reply("There is no item template named '" + name + "'.")
+		return true
+	}
+
+	item, err := game.GetFacade().ItemsService().CreateInstanceFromTemplate(template.ID)
+	if err != nil {
+		log.WithError(err).WithField("template", template.ID).Error("Error creating item")
+		game.SendMessage() <- message.Reply("Could not create " + template.Name + ".")
+		return true
+	}
+	if err := target.Inventory.AddItem(item); err != nil {
+		game.GetFacade().ItemsService().Delete(item.ID)
+		game.SendMessage() <- message.Reply(target.Name + " can't carry " + item.Name + ": " + err.Error())
+		return true
+	}
+	if err := game.GetFacade().CharactersService().Update(target.ID, target); err != nil {
+		log.WithError(err).Error("Error updating character inventory")
+		game.GetFacade().ItemsService().Delete(item.ID)
+		game.SendMessage() <- message.Reply("Could not give " + item.Name + ".")
+		return true
+	}
+
+	if target.ID == message.Character.ID {
+		game.SendMessage() <- message.Reply(item.Name + " appears in your inventory.")
+		if inv := messages.NewInventoryUpdateMessage(message); inv != nil {
+			game.SendMessage() <- inv
+		}
+		return true
+	}
+	game.SendMessage() <- message.Reply("You give " + item.Name + " to " + target.Name + ".")
+	game.SendMessage() <- messages.Reply(target.BelongsUserID, item.Name+" appears in your inventory, a gift from "+message.Character.Name+".")
+	return true
+}
+
+// KickCommand disconnects an online player
+type KickCommand struct {
+}
+
+// Key returns the command key matcher
+func (command *KickCommand) Key() CommandKey { return &StartsWithCommandKey{} }
+
+// Execute handles the kick command: "kick bob" or "kick bob spamming the channels"
+func (command *KickCommand) Execute(game def.GameCtrl, message *messages.Message) bool {
+	parts := strings.Fields(message.Data)
+	if len(parts) < 2 {
+		game.SendMessage() <- message.Reply("Usage: kick <player> [reason]")
+		return true
+	}
+
+	user, character := findStaffTarget(game, message, parts[1])
+	if user == nil {
+		return true
+	}
+
+	reason := "You have been disconnected by " + message.FromUser.Nickname + "."
+	if len(parts) > 2 {
+		reason += " Reason: " + strings.Join(parts[2:], " ")
+	}
+	game.GetTradeManager().CancelTrade(character.ID, character.Name+" was disconnected")
+	game.SendMessage() <- messages.DisconnectUser{
+		UserID: user.ID,
+		Reason: reason,
+	}
+	game.SendMessage() <- message.Reply("You kick " + character.Name + ".")
+	return true
+}
+
+// ForceCommand makes a player run a command as if they had typed it, staff commands can't be forced
+// because the audit log would record the forced player instead of the admin
+type ForceCommand struct {
+	processor *CommandProcessor
+}
+
+// Key returns the command key matcher
+func (command *ForceCommand) Key() CommandKey { return &StartsWithCommandKey{} }
+
+// Execute handles the force command: "force bob say hello"
+func (command *ForceCommand) Execute(game def.GameCtrl, message *messages.Message) bool {
+	parts := strings.Fields(message.Data)
+	if len(parts) < 3 {
+		game.SendMessage() <- message.Reply("Usage: force <player> <command>")
+		return true
+	}
+
+	user, character := findStaffTarget(game, message, parts[1])
+	if user == nil {
+		return true
+	}
+
+	if command.processor != nil && command.processor.RequiredRole(parts[2]) != "" {
+		game.SendMessage() <- message.Reply("Staff commands can't be forced.")
+		return true
+	}
+
+	input := strings.Join(parts[2:], " ")
+	game.SendMessage() <- messages.Reply(user.ID, message.Character.Name+" forces you to '"+input+"'.")
+	game.OnMessageReceived() <- messages.NewMessage(user, input)
+	game.SendMessage() <- message.Reply("You force " + character.Name + " to '" + input + "'.")
+	return true
+}
+
+// currentUser reloads the user of the message so role changes apply without a reconnect
+func currentUser(game def.GameCtrl, message *messages.Message) *entities.User {
+	if message.FromUser == nil {
+		return nil
+	}
+	user, err := game.GetFacade().UsersService().FindByID(message.FromUser.ID)
+	if err != nil {
+		return nil
+	}
+	return user
+}
+
+// recordStaffCommand writes an audit entry for a staff command
+func recordStaffCommand(game def.GameCtrl, message *messages.Message, user *entities.User, key string) {
+	entry := &audit.Entry{
+		UserID:   user.ID,
+		UserName: user.Nickname,
+		Role:     user.GetRole(),
+		Command:  key,
+		Input:    message.Data,
+	}
+	if message.Character != nil {
+		entry.CharacterID = message.Character.ID
+		entry.CharacterName = message.Character.Name
+		entry.RoomID = message.Character.CurrentRoomID
+	}
+
+	log.WithField("user", user.Nickname).WithField("input", message.Data).Info("Staff command used")
+	if err := game.GetFacade().AuditService().Record(entry); err != nil {
+		log.WithError(err).Error("Error recording staff command")
+	}
+}
+
+// staffArgs returns the text after the command, replies with the usage if there is none
+func staffArgs(game def.GameCtrl, message *messages.Message, usage string) (string, bool) {
+	if message.Character == nil {
+		game.SendMessage() <- message.Reply("You need to select a character first.")
+		return "", false
+	}
+	parts := strings.Fields(message.Data)
+	if len(parts) < 2 {
+		game.SendMessage() <- message.Reply(usage)
+		return "", false
+	}
+	return strings.Join(parts[1:], " "), true
+}
+
+// findStaffTarget returns the user and character of an online player staff commands may act on,
+// replies and returns nil for unknown players, the staff member themselves and admins
+func findStaffTarget(game def.GameCtrl, message *messages.Message, name string) (*entities.User, *characters.Character) {
+	if message.Character == nil {
+		game.SendMessage() <- message.Reply("You need to select a character first.")
+		return nil, nil
+	}
+	character := findOnlineCharacter(game, name)
+	if character == nil {
+		game.SendMessage() <- message.Reply("There is no player named '" + name + "' online.")
+		return nil, nil
+	}
+	if character.BelongsUserID == message.FromUser.ID {
+		game.SendMessage() <- message.Reply("You can't do that to yourself.")
+		return nil, nil
+	}
+	user, err := game.GetFacade().UsersService().FindByID(character.BelongsUserID)
+	if err != nil {
+		game.SendMessage() <- message.Reply("Could not find the user of " + character.Name + ".")
+		return nil, nil
+	}
+	if user.IsAdmin() {
+		game.SendMessage() <- message.Reply("You can't do that to an admin.")
+		return nil, nil
+	}
+	return user, character
+}
+
+// findRoom finds a room by ID or by name
+func findRoom(game def.GameCtrl, target string) *rooms.Room {
+	if room, err := game.GetFacade().RoomsService().FindByID(target); err == nil && room != nil {
+		return room
+	}
+	if found, err := game.GetFacade().RoomsService().FindByName(target); err == nil && len(found) > 0 {
+		return found[0]
+	}
+	all, err := game.GetFacade().RoomsService().FindAll()
+	if err != nil {
+		return nil
+	}
+	for _, room := range all {
+		if strings.EqualFold(room.Name, target) {
+			return room
+		}
+	}
+	return nil
+}
+
+// findNPCTemplate finds an NPC template by ID or by name
+func findNPCTemplate(game def.GameCtrl, name string) *npc.NPC {
+	templates, err := game.GetFacade().NPCsService().FindAllTemplates()
+	if err != nil {
+		return nil
+	}
+	for _, template := range templates {
+		if template.ID == name {
+			return template
+		}
+	}
+	for _, template := range templates {
+		if strings.EqualFold(template.Name, name) || strings.EqualFold(template.GetDisplayName(), name) {
+			return template
+		}
+	}
+	return nil
+}
+
+// findItemTemplate finds an item template by ID or by name
+func findItemTemplate(game def.GameCtrl, name string) *items.Item {
+	if item, err := game.GetFacade().ItemsService().FindByID(name); err == nil && item != nil && item.IsTemplate {
+		return item
+	}
+	if found, err := game.GetFacade().ItemsService().FindTemplateByName(name); err == nil && len(found) > 0 {
+		return found[0]
+	}
+	return nil
+}
+
+// moveCharacterTo moves a character into the room without taking an exit and shows the room to its user
+func moveCharacterTo(game def.GameCtrl, user *entities.User, character *characters.Character, target *rooms.Room, leaveText, enterText string) {
+	game.GetTradeManager().CancelTrade(character.ID, character.Name+" left the room")
+	if combatEngine := game.GetCombatEngine(); combatEngine != nil && combatEngine.IsPlayerInCombat(character.ID) {
+		combatEngine.EndCombatForPlayer(character.ID)
+	}
+
+	previousRoomID := character.CurrentRoomID
+	if previous, err := game.GetFacade().RoomsService().FindByID(previousRoomID); err == nil {
+		previous.RemoveCharacter(character.ID)
+		game.GetFacade().RoomsService().Update(previous.ID, previous)
+	}
+
+	target.AddCharacter(character.ID)
+	game.GetFacade().RoomsService().Update(target.ID, target)
+
+	character.CurrentRoomID = target.ID
+	if err := game.GetFacade().CharactersService().Update(character.ID, character); err != nil {
+		log.WithError(err).Error("Error updating moved character")
+	}
+
+	game.SendMessage() <- messages.CharacterLeftRoom{
+		MessageResponse: messages.MessageResponse{
+			Audience:   messages.MessageAudienceRoomWithoutOrigin,
+			AudienceID: previousRoomID,
+			OriginID:   character.ID,
+			Message:    leaveText,
+		},
+	}
+
+	enterRoom := messages.NewEnterRoomMessage(target, user, game)
+	enterRoom.AudienceID = user.ID
+	game.SendMessage() <- enterRoom
+
+	game.SendMessage() <- messages.CharacterJoinedRoom{
+		MessageResponse: messages.MessageResponse{
+			Audience:   messages.MessageAudienceRoomWithoutOrigin,
+			AudienceID: target.ID,
+			OriginID:   character.ID,
+			Message:    enterText,
+		},
+	}
+}
+
+// sendRoomNotice tells everyone else in the room of the character what happened
+func sendRoomNotice(game def.GameCtrl, message *messages.Message, text string) {
+	game.SendMessage() <- messages.MessageResponse{
+		Audience:   messages.MessageAudienceRoomWithoutOrigin,
+		AudienceID: message.Character.CurrentRoomID,
+		OriginID:   message.Character.ID,
+		Message:    text,
+	}
+}
@@ -49,6 +49,15 @@ func (command *AttackCommand) Execute(game def.GameCtrl, message *messages.Messa
 		return command.handleInCombatAttack(game, message, combatEngine, targetName)
 	}
 
+	// Not in combat - assist party members already fighting in this room
+	if targetName == "" {
+		for _, member := range GetPartyMembersInRoom(game, message.Character, message.Character.CurrentRoomID) {
+			if instance := combatEngine.GetCombatInstance(member.Entity.ID); instance != nil {
+				return command.handleJoinCombat(game, message, combatEngine, instance, "")
+			}
+		}
+	}
+
 	// Not in combat - need a target name to initiate
 	if targetName == "" {
 		game.SendMessage() <- message.Reply("Attack whom? Usage: attack <target>")
@@ -86,8 +95,14 @@ func (command *AttackCommand) handleInitiateCombat(game def.GameCtrl, message *m
 		return true
 	}
 
-	// Check if NPC is already in combat with someone else
+	// Check if NPC is already in combat, party members join the fight
 	if combatEngine.IsNPCInCombat(target.Entity.ID) {
+		for _, member := range GetPartyMembersInRoom(game, message.Character, message.Character.CurrentRoomID) {
+			if instance := combatEngine.GetCombatInstance(member.Entity.ID); instance != nil && instance.GetEnemyByID(target.Entity.ID) != nil {
+				return command.handleJoinCombat(game, message, combatEngine, instance, target.Entity.ID)
+			}
+		}
+
 		game.SendMessage() <- message.Reply(fmt.Sprintf("%s is already in combat with someone else!", target.Name))
 		return true
 	}
@@ -111,8 +126,13 @@ func (command *AttackCommand) handleInitiateCombat(game def.GameCtrl, message *m
 		}
 	}
 
-	// Gather players (just the attacker for now, others can join)
+	// Gather players, party members in the same room fight along
 	players := []*characters.Character{message.Character}
+	for _, member := range GetPartyMembersInRoom(game, message.Character, message.Character.CurrentRoomID) {
+		if member.CurrentHitPoints > 0 && !combatEngine.IsPlayerInCombat(member.Entity.ID) {
+			players = append(players, member)
+		}
+	}
 
 	// Initiate combat
 	instance := combatEngine.InitiateCombat(message.Character.CurrentRoomID, players, enemies)
@@ -121,10 +141,12 @@ func (command *AttackCommand) handleInitiateCombat(game def.GameCtrl, message *m
 		return true
 	}
 
-	// Update character's combat state in database
-	message.Character.InCombat = true
-	message.Character.CombatInstanceID = instance.ID
-	game.GetFacade().CharactersService().Update(message.Character.ID, message.Character)
+	// Update characters' combat state in database
+	for _, player := range players {
+		player.InCombat = true
+		player.CombatInstanceID = instance.ID
+		game.GetFacade().CharactersService().Update(player.ID, player)
+	}
 
 	// Update NPC combat states
 	for _, enemy := range enemies {
@@ -141,12 +163,48 @@ func (command *AttackCommand) handleInitiateCombat(game def.GameCtrl, message *m
 		enemyNames = append(enemyNames, e.Name)
 	}
 
+	startMsg := CombatStartMessage(combatEngine, instance, message.Character.Entity.ID, fmt.Sprintf("You attack %s!", target.Name), enemyNames)
+	game.SendMessage() <- message.Reply(startMsg)
+
+	// Set auto-attack target to the initial target
+	for _, player := range players {
+		combatEngine.SetAutoAttackTarget(player.Entity.ID, target.Entity.ID)
+	}
+
+	// Party members are pulled into the fight
+	for _, member := range players[1:] {
+		game.SendMessage() <- messages.MessageResponse{
+			Audience:   messages.MessageAudienceUser,
+			AudienceID: member.BelongsUserID,
+			Type:       messages.MessageTypeCombatStart,
+			Message:    fmt.Sprintf("%s attacks %s! You join the fight.\n\n%s", message.Character.Name, target.Name, combatEngine.GetCombatStatus(member.Entity.ID)),
+		}
+	}
+
+	game.SendMessage() <- message.Reply("\nCombat is automatic. Commands: attack <target> (switch target) | defend | flee | status")
+
+	// Notify other players in the room
+	roomMsg := messages.MessageResponse{
+		Audience:   messages.MessageAudienceRoomWithoutOrigin,
+		AudienceID: message.Character.CurrentRoomID,
+		OriginID:   message.FromUser.ID,
+		Type:       messages.MessageTypeCombatStart,
+		Message:    fmt.Sprintf("%s engages %s in combat!", message.Character.Name, strings.Join(enemyNames, ", ")),
+	}
+	game.SendMessage() <- roomMsg
+
+	return true
+}
+
+// CombatStartMessage builds the message a player gets when a fight starts: the intro line, the enemies
+// that joined the first one, the turn order and the combat status
+func CombatStartMessage(combatEngine def.CombatEngineCtrl, instance *combat.CombatInstance, characterID string, intro string, enemyNames []string) string {
 	startMsg := fmt.Sprintf("\n%s\n%s\n\n",
 		"═══════════════════════════════════════════════════",
 		"              COMBAT INITIATED!")
-	startMsg += fmt.Sprintf("You attack %s!\n\n", target.Name)
+	startMsg += intro + "\n\n"
 
-	if len(enemies) > 1 {
+	if len(enemyNames) > 1 {
 		startMsg += fmt.Sprintf("Enemies join the fight: %s\n\n", strings.Join(enemyNames[1:], ", "))
 	}
 
@@ -160,25 +218,33 @@ func (command *AttackCommand) handleInitiateCombat(game def.GameCtrl, message *m
 		startMsg += fmt.Sprintf("%s%d. %s (Initiative: %d)\n", marker, i+1, combatant.Name, combatant.Initiative)
 	}
 
-	startMsg += "\n" + combatEngine.GetCombatStatus(message.Character.Entity.ID)
-	startMsg += "\n═══════════════════════════════════════════════════"
-
-	game.SendMessage() <- message.Reply(startMsg)
+	// the status ends with the command help and its own footer line
+	startMsg += "\n" + combatEngine.GetCombatStatus(characterID)
+	return startMsg
+}
 
-	// Set auto-attack target to the initial target
-	combatEngine.SetAutoAttackTarget(message.Character.Entity.ID, target.Entity.ID)
+// handleJoinCombat adds the attacker to the combat instance of a party member
+func (command *AttackCommand) handleJoinCombat(game def.GameCtrl, message *messages.Message, combatEngine def.CombatEngineCtrl, instance *combat.CombatInstance, targetID string) bool {
+	if !combatEngine.JoinCombat(instance.ID, message.Character) {
+		game.SendMessage() <- message.Reply("You can't join this fight.")
+		return true
+	}
 
-	game.SendMessage() <- message.Reply("\nCombat is automatic. Commands: attack <target> (switch target) | defend | flee | status")
+	message.Character.InCombat = true
+	message.Character.CombatInstanceID = instance.ID
+	game.GetFacade().CharactersService().Update(message.Character.ID, message.Character)
 
-	// Notify other players in the room
-	roomMsg := messages.MessageResponse{
-		Audience:   messages.MessageAudienceRoomWithoutOrigin,
-		AudienceID: message.Character.CurrentRoomID,
-		OriginID:   message.FromUser.ID,
-		Type:       messages.MessageTypeCombatStart,
-		Message:    fmt.Sprintf("%s engages %s in combat!", message.Character.Name, strings.Join(enemyNames, ", ")),
+	if targetID == "" {
+		if living := instance.GetLivingEnemies(); len(living) > 0 {
+			targetID = living[0].ID
+		}
+	}
+	if targetID != "" {
+		combatEngine.SetAutoAttackTarget(message.Character.Entity.ID, targetID)
 	}
-	game.SendMessage() <- roomMsg
+
+	game.SendMessage() <- message.Reply("You join the fight alongside your group!\n\n" + combatEngine.GetCombatStatus(message.Character.Entity.ID))
+	game.SendMessage() <- message.Reply("\nCombat is automatic. Commands: attack <target> (switch target) | defend | flee | status")
 
 	return true
 }
@@ -267,125 +333,6 @@ func (command *AttackCommand) handleInCombatAttack(game def.GameCtrl, message *m
 	return true
 }
 
-// handleCombatEnd handles the end of combat
-func (command *AttackCommand) handleCombatEnd(game def.GameCtrl, message *messages.Message, combatEngine def.CombatEngineCtrl, endState combat.CombatState) {
-	// Get the combat instance before cleanup
-	instance := combatEngine.GetCombatInstance(message.Character.Entity.ID)
-
-	var endMsg string
-	switch endState {
-	case combat.CombatStateVictory:
-		endMsg = "\n═══════════════════════════════════════════════════\n"
-		endMsg += "              VICTORY!\n"
-		endMsg += "═══════════════════════════════════════════════════\n\n"
-
-		if instance != nil {
-			// Calculate and show rewards
-			var totalXP int64 = 0
-			var totalGold int64 = 0
-
-			for _, enemy := range instance.Enemies {
-				// Get NPC data to access rewards
-				npcData := game.GetNPCInstanceManager().GetInstance(enemy.ID)
-				if npcData != nil && npcData.EnemyTrait != nil {
-					totalXP += npcData.EnemyTrait.XPReward
-
-					// Roll gold
-					goldRange := npcData.EnemyTrait.GoldDrop
-					if goldRange.Max > goldRange.Min {
-						// Random between min and max
-						gold := goldRange.Min + (goldRange.Max-goldRange.Min)/2 // Simplified
-						totalGold += int64(gold)
-					} else {
-						totalGold += int64(goldRange.Min)
-					}
-				}
-				endMsg += fmt.Sprintf("Defeated: %s\n", enemy.Name)
-			}
-
-			// Award XP and gold to living players
-			livingPlayers := instance.GetLivingPlayers()
-			if len(livingPlayers) > 0 {
-				xpPerPlayer := totalXP / int64(len(livingPlayers))
-				goldPerPlayer := totalGold / int64(len(livingPlayers))
-
-				endMsg += fmt.Sprintf("\nREWARDS:\n")
-				endMsg += fmt.Sprintf("  Experience: %d XP\n", xpPerPlayer)
-				endMsg += fmt.Sprintf("  Gold: %d gold\n", goldPerPlayer)
-
-				// Update character with rewards
-				message.Character.XP += int32(xpPerPlayer)
-				message.Character.Gold += goldPerPlayer
-			}
-		}
-
-		endMsg += "\n═══════════════════════════════════════════════════"
-
-	case combat.CombatStateDefeat:
-		endMsg = "\n═══════════════════════════════════════════════════\n"
-		endMsg += "              DEFEAT\n"
-		endMsg += "═══════════════════════════════════════════════════\n\n"
-		endMsg += "You have been defeated!\n\n"
-
-		// Calculate gold loss (10%)
-		goldLoss := int64(float64(message.Character.Gold) * 0.10)
-		if goldLoss > 0 {
-			message.Character.Gold -= goldLoss
-			endMsg += fmt.Sprintf("PENALTY: Lost %d gold\n", goldLoss)
-		}
-
-		// Set HP to 50% and respawn
-		message.Character.CurrentHitPoints = message.Character.MaxHitPoints / 2
-		if message.Character.CurrentHitPoints < 1 {
-			message.Character.CurrentHitPoints = 1
-		}
-
-		// Determine respawn location
-		respawnRoom := message.Character.BoundRoomID
-		if respawnRoom == "" {
-			// Use a default starting room (would need to be configured)
-			respawnRoom = message.Character.CurrentRoomID // Stay in same room as fallback
-		}
-
-		endMsg += fmt.Sprintf("\nYou awaken with %d/%d HP.\n", message.Character.CurrentHitPoints, message.Character.MaxHitPoints)
-		endMsg += "═══════════════════════════════════════════════════"
-
-	case combat.CombatStateFled:
-		endMsg = "\n═══════════════════════════════════════════════════\n"
-		endMsg += "              ESCAPED\n"
-		endMsg += "═══════════════════════════════════════════════════\n\n"
-		endMsg += "You have fled from combat!\n"
-		endMsg += "═══════════════════════════════════════════════════"
-
-	default:
-		endMsg = "\nCombat has ended."
-	}
-
-	// Clear combat state
-	message.Character.InCombat = false
-	message.Character.CombatInstanceID = ""
-	game.GetFacade().CharactersService().Update(message.Character.ID, message.Character)
-
-	// Clear NPC combat states
-	if instance != nil {
-		npcManager := game.GetNPCInstanceManager()
-		for _, enemy := range instance.Enemies {
-			npcManager.UpdateInstance(enemy.ID, func(n *npc.NPC) {
-				n.InCombat = false
-				n.CombatInstanceID = ""
-				if n.CurrentHitPoints > 0 {
-					n.State = "idle"
-				}
-			})
-		}
-	}
-
-	// End combat in engine
-	combatEngine.EndCombatForPlayer(message.Character.Entity.ID)
-
-	game.SendMessage() <- message.Reply(endMsg)
-}
-
 // DefendCommand handles the defend action in combat
 type DefendCommand struct{}
 
@@ -0,0 +1,408 @@
+package commands
+
+import (
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/talesmud/talesmud/pkg/entities"
+	"github.com/talesmud/talesmud/pkg/entities/rooms"
+	"github.com/talesmud/talesmud/pkg/mudserver/game/def"
+	"github.com/talesmud/talesmud/pkg/mudserver/game/messages"
+)
+
+// DigCommand creates a new room behind a new exit of the current room
+type DigCommand struct {
+}
+
+// Key returns the command key matcher
+func (command *DigCommand) Key() CommandKey { return &StartsWithCommandKey{} }
+
+// Execute handles the dig command: "dig north Dark Corridor"
+func (command *DigCommand) Execute(game def.GameCtrl, message *messages.Message) bool {
+	room, parts, ok := buildingRoom(game, message, 3, "Usage: dig <direction> <room name>")
+	if !ok {
+		return true
+	}
+
+	direction := strings.ToLower(parts[1])
+	back := rooms.OppositeDirection(direction)
+	if back == "" {
+		game.SendMessage() <- message.Reply("'" + direction + "' is no direction. Use north, south, east, west, northeast, northwest, southeast, southwest, up, down, in or out.")
+		return true
+	}
+	if room.FindExit(direction) != nil {
+		game.SendMessage() <- message.Reply("This room already has an exit " + direction + ".")
+		return true
+	}
+
+	// the new room starts in the area of the current room
+	next := &rooms.Room{
+		Entity:   entities.NewEntity(),
+		Name:     strings.Join(parts[2:], " "),
+		Area:     room.Area,
+		AreaType: room.AreaType,
+		RoomType: room.RoomType,
+		Tags:     []string{},
+	}
+	if x, y, z, ok := rooms.DirectionOffset(direction); ok && room.Coords != nil {
+		next.SetCoords(room.Coords.X+x, room.Coords.Y+y, room.Coords.Z+z)
+	}
+	next.AddExit(rooms.Exit{Name: back, Type: rooms.RoomExitTypeDirection, Target: room.ID})
+
+	stored, err := game.GetFacade().RoomsService().Store(next)
+	if err != nil {
+		log.WithError(err).Error("Error storing dug room")
+		game.SendMessage() <- message.Reply("Could not create the room.")
+		return true
+	}
+
+	room.AddExit(rooms.Exit{Name: direction, Type: rooms.RoomExitTypeDirection, Target: stored.ID})
+	if !updateBuildingRoom(game, message, room) {
+		game.GetFacade().RoomsService().Delete(stored.ID)
+		return true
+	}
+
+	game.SendMessage() <- message.Reply("You dig " + direction + " and create " + stored.Name + " (" + stored.ID + ").")
+	return true
+}
+
+// ReditCommand edits the current room
+type ReditCommand struct {
+}
+
+// Key returns the command key matcher
+func (command *ReditCommand) Key() CommandKey { return &StartsWithCommandKey{} }
+
+// Execute handles the redit command: "redit name Old Mill", "redit tags inn,safe" or "redit mood none"
+func (command *ReditCommand) Execute(game def.GameCtrl, message *messages.Message) bool {
+	usage := "Usage: redit name|desc|area|tags|mood <value> (tags: comma separated, 'none' clears tags and mood)"
+	room, parts, ok := buildingRoom(game, message, 3, usage)
+	if !ok {
+		return true
+	}
+
+	value := strings.Join(parts[2:], " ")
+	field := strings.ToLower(parts[1])
+	switch field {
+	case "name":
+		room.Name = value
+	case "desc", "description":
+		field = "description"
+		room.Description = value
+	case "area":
+		room.Area = value
+	case "tags":
+		room.Tags = []string{}
+		if !strings.EqualFold(value, "none") {
+			for _, tag := range strings.FieldsFunc(value, func(r rune) bool { return r == ',' || r == ' ' }) {
+				room.Tags = append(room.Tags, strings.ToLower(tag))
+			}
+		}
+	case "mood":
+		if strings.EqualFold(value, "none") {
+			value = ""
+		}
+		room.SetMood(value)
+	default:
+		game.SendMessage() <- message.Reply(usage)
+		return true
+	}
+
+	if !updateBuildingRoom(game, message, room) {
+		return true
+	}
+	game.SendMessage() <- message.Reply("Room " + field + " updated.")
+	showBuildingRoom(game, message, room)
+	return true
+}
+
+// ExitCommand adds, removes, hides and shows exits of the current room
+type ExitCommand struct {
+}
+
+// Key returns the command key matcher
+func (command *ExitCommand) Key() CommandKey { return &StartsWithCommandKey{} }
+
+// Execute handles the exit command: "exit add north <room>", "exit remove north", "exit hide trapdoor" or "exit show trapdoor"
+func (command *ExitCommand) Execute(game def.GameCtrl, message *messages.Message) bool {
+	usage := "Usage: exit add <name> <room id|room name> [oneway] | exit remove <name> [oneway] | exit hide <name> | exit show <name>"
+	room, parts, ok := buildingRoom(game, message, 3, usage)
+	if !ok {
+		return true
+	}
+
+	name := strings.ToLower(parts[2])
+	switch strings.ToLower(parts[1]) {
+	case "add":
+		if len(parts) < 4 {
+			game.SendMessage() <- message.Reply(usage)
+			return true
+		}
+		args := parts[3:]
+		oneway := strings.EqualFold(args[len(args)-1], "oneway")
+		if oneway && len(args) > 1 {
+			args = args[:len(args)-1]
+		}
+		command.add(game, message, room, name, strings.Join(args, " "), oneway)
+
+	case "remove", "rm":
+		oneway := len(parts) > 3 && strings.EqualFold(parts[3], "oneway")
+		command.remove(game, message, room, name, oneway)
+
+	case "hide":
+		command.setHidden(game, message, room, name, true)
+
+	case "show", "unhide":
+		command.setHidden(game, message, room, name, false)
+
+	default:
+		game.SendMessage() <- message.Reply(usage)
+	}
+	return true
+}
+
+// setHidden hides or shows an exit, running it twice leaves the exit as it is
+func (command *ExitCommand) setHidden(game def.GameCtrl, message *messages.Message, room *rooms.Room, name string, hidden bool) {
+	exit := room.FindExit(name)
+	if exit == nil {
+		game.SendMessage() <- message.Reply("This room has no exit '" + name + "'.")
+		return
+	}
+	if exit.Hidden == hidden {
+		if hidden {
+			game.SendMessage() <- message.Reply("The exit " + exit.Name + " is already hidden.")
+		} else {
+			game.SendMessage() <- message.Reply("The exit " + exit.Name + " is not hidden.")
+		}
+		return
+	}
+	exit.Hidden = hidden
+	if !updateBuildingRoom(game, message, room) {
+		return
+	}
+	if hidden {
+		game.SendMessage() <- message.Reply("The exit " + exit.Name + " is now hidden.")
+	} else {
+		game.SendMessage() <- message.Reply("The exit " + exit.Name + " is visible again.")
+	}
+}
+
+// add links the room to the target, directions get an exit back unless oneway is set
+func (command *ExitCommand) add(game def.GameCtrl, message *messages.Message, room *rooms.Room, name, target string, oneway bool) {
+	if room.FindExit(name) != nil {
+		game.SendMessage() <- message.Reply("This room already has an exit '" + name + "'.")
+		return
+	}
+	targetRoom := findRoom(game, target)
+	if targetRoom == nil {
+		game.SendMessage() <- message.Reply("There is no room '" + target + "'.")
+		return
+	}
+
+	exitType := rooms.RoomExitType(rooms.RoomExitTypeNormal)
+	back := rooms.OppositeDirection(name)
+	if back != "" {
+		exitType = rooms.RoomExitTypeDirection
+	}
+	room.AddExit(rooms.Exit{Name: name, Type: exitType, Target: targetRoom.ID})
+	if !updateBuildingRoom(game, message, room) {
+		return
+	}
+
+	reply := "Added exit " + name + " to " + targetRoom.Name + "."
+	if back != "" && !oneway && targetRoom.ID != room.ID {
+		if err := targetRoom.AddExit(rooms.Exit{Name: back, Type: exitType, Target: room.ID}); err != nil {
+			reply += " " + targetRoom.Name + " already has an exit " + back + ", no exit back was added."
+		} else if err := game.GetFacade().RoomsService().Update(targetRoom.ID, targetRoom); err != nil {
+			log.WithError(err).Error("Error updating target room")
+		} else {
+			reply += " Added exit " + back + " back."
+		}
+	}
+	game.SendMessage() <- message.Reply(reply)
+}
+
+// remove unlinks the exit, the exit back is removed too unless oneway is set
+func (command *ExitCommand) remove(game def.GameCtrl, message *messages.Message, room *rooms.Room, name string, oneway bool) {
+	exit := room.RemoveExit(name)
+	if exit == nil {
+		game.SendMessage() <- message.Reply("This room has no exit '" + name + "'.")
+		return
+	}
+	if !updateBuildingRoom(game, message, room) {
+		return
+	}
+
+	reply := "Removed exit " + exit.Name + "."
+	if back := rooms.OppositeDirection(exit.Name); back != "" && !oneway && exit.Target != room.ID {
+		if targetRoom, err := game.GetFacade().RoomsService().FindByID(exit.Target); err == nil {
+			if reverse := targetRoom.FindExit(back); reverse != nil && reverse.Target == room.ID {
+				targetRoom.RemoveExit(back)
+				if err := game.GetFacade().RoomsService().Update(targetRoom.ID, targetRoom); err != nil {
+					log.WithError(err).Error("Error updating target room")
+				} else {
+					reply += " Removed exit " + back + " of " + targetRoom.Name + "."
+				}
+			}
+		}
+	}
+	game.SendMessage() <- message.Reply(reply)
+}
+
+// ActionCommand adds and removes the custom actions of the current room
+type ActionCommand struct {
+}
+
+// Key returns the command key matcher
+func (command *ActionCommand) Key() CommandKey { return &StartsWithCommandKey{} }
+
+// Execute handles the action command: `action add "move rocks" You move the rocks.` or "action remove pray"
+func (command *ActionCommand) Execute(game def.GameCtrl, message *messages.Message) bool {
+	usage := "Usage: action list | action add|addroom <name> <response> | action remove <name> (quote names with spaces)"
+	room, parts, ok := buildingRoom(game, message, 2, usage)
+	if !ok {
+		return true
+	}
+
+	switch strings.ToLower(parts[1]) {
+	case "list":
+		if room.Actions == nil || len(*room.Actions) == 0 {
+			game.SendMessage() <- message.Reply("This room has no actions.")
+			return true
+		}
+		var sb strings.Builder
+		sb.WriteString("Room actions:")
+		for _, action := range *room.Actions {
+			sb.WriteString("\n  " + action.Name + " (" + string(action.Type) + "): " + action.Description)
+		}
+		game.SendMessage() <- message.Reply(sb.String())
+
+	case "add", "addroom":
+		name, response := splitActionArgs(strings.Join(parts[2:], " "))
+		if name == "" || response == "" {
+			game.SendMessage() <- message.Reply(usage)
+			return true
+		}
+		actionType := rooms.RoomActionTypeResponse
+		if strings.ToLower(parts[1]) == "addroom" {
+			actionType = rooms.RoomActionTypeRoomResponse
+		}
+		room.SetAction(rooms.Action{
+			Name:        name,
+			Description: response,
+			Type:        actionType,
+		})
+		if !updateBuildingRoom(game, message, room) {
+			return true
+		}
+		game.SendMessage() <- message.Reply("Action '" + name + "' saved.")
+
+	case "remove", "rm":
+		name, _ := splitActionArgs(strings.Join(parts[2:], " "))
+		if !room.RemoveAction(name) {
+			game.SendMessage() <- message.Reply("This room has no action '" + name + "'.")
+			return true
+		}
+		if !updateBuildingRoom(game, message, room) {
+			return true
+		}
+		game.SendMessage() <- message.Reply("Action '" + name + "' removed.")
+
+	default:
+		game.SendMessage() <- message.Reply(usage)
+	}
+	return true
+}
+
+// SetBindCommand sets if players can bind their respawn point in the current room
+type SetBindCommand struct {
+}
+
+// Key returns the command key matcher
+func (command *SetBindCommand) Key() CommandKey { return &StartsWithCommandKey{} }
+
+// Execute handles the setbind command: "setbind" toggles, "setbind on" or "setbind off"
+func (command *SetBindCommand) Execute(game def.GameCtrl, message *messages.Message) bool {
+	room, parts, ok := buildingRoom(game, message, 1, "Usage: setbind [on|off]")
+	if !ok {
+		return true
+	}
+
+	canBind := !room.CanBind
+	if len(parts) > 1 {
+		switch strings.ToLower(parts[1]) {
+		case "on", "yes", "true":
+			canBind = true
+		case "off", "no", "false":
+			canBind = false
+		default:
+			game.SendMessage() <- message.Reply("Usage: setbind [on|off]")
+			return true
+		}
+	}
+
+	room.CanBind = canBind
+	if !updateBuildingRoom(game, message, room) {
+		return true
+	}
+	if canBind {
+		game.SendMessage() <- message.Reply("Players can now bind their respawn point here.")
+	} else {
+		game.SendMessage() <- message.Reply("Players can no longer bind their respawn point here.")
+	}
+	return true
+}
+
+// buildingRoom loads the current room of the builder, replies with the usage if there are less than minParts words
+func buildingRoom(game def.GameCtrl, message *messages.Message, minParts int, usage string) (*rooms.Room, []string, bool) {
+	if message.Character == nil {
+		game.SendMessage() <- message.Reply("You need to select a character first.")
+		return nil, nil, false
+	}
+	parts := strings.Fields(message.Data)
+	if len(parts) < minParts {
+		game.SendMessage() <- message.Reply(usage)
+		return nil, nil, false
+	}
+	room, err := game.GetFacade().RoomsService().FindByID(message.Character.CurrentRoomID)
+	if err != nil {
+		game.SendMessage() <- message.Reply("You are nowhere.")
+		return nil, nil, false
+	}
+	return room, parts, true
+}
+
+// updateBuildingRoom stores the edited room, replies and returns false on errors
+func updateBuildingRoom(game def.GameCtrl, message *messages.Message, room *rooms.Room) bool {
+	if err := game.GetFacade().RoomsService().Update(room.ID, room); err != nil {
+		log.WithError(err).WithField("room", room.ID).Error("Error updating room")
+		game.SendMessage() <- message.Reply("Could not save the room.")
+		return false
+	}
+	return true
+}
+
+// showBuildingRoom shows the edited room to the builder
+func showBuildingRoom(game def.GameCtrl, message *messages.Message, room *rooms.Room) {
+	enterRoom := messages.NewEnterRoomMessage(room, message.FromUser, game)
+	enterRoom.AudienceID = message.FromUser.ID
+	game.SendMessage() <- enterRoom
+}
+
+// splitActionArgs splits `"move rocks" You move the rocks.` or `pray You pray.` into name and response
+func splitActionArgs(args string) (string, string) {
+	args = strings.TrimSpace(args)
+	if strings.HasPrefix(args, "\"") {
+		end := strings.Index(args[1:], "\"")
+		if end < 0 {
+			return "", ""
+		}
+		return strings.TrimSpace(args[1 : end+1]), strings.TrimSpace(args[end+2:])
+	}
+	parts := strings.SplitN(args, " ", 2)
+	if len(parts) < 2 {
+		return parts[0], ""
+	}
+	return parts[0], strings.TrimSpace(parts[1])
+}
@@ -2,6 +2,7 @@ package commands
 
 import (
 	"strings"
+	"time"
 
 	"github.com/talesmud/talesmud/pkg/entities/items"
 	"github.com/talesmud/talesmud/pkg/mudserver/game/def"
@@ -41,6 +42,12 @@ func (command *CharacterCommand) Execute(game def.GameCtrl, message *m.Message)
 	sb.WriteString(itoa(int(char.Level)))
 	sb.WriteString(" | XP: ")
 	sb.WriteString(itoa(int(char.XP)))
+	if curve := game.GetFacade().CharactersService().ProgressionCurve(); !curve.IsMaxLevel(char.Level) {
+		sb.WriteString("/")
+		sb.WriteString(itoa(int(curve.XPForLevel(char.Level + 1))))
+	} else {
+		sb.WriteString(" (max level)")
+	}
 	sb.WriteString("\n\n")
 
 	// Vitals
@@ -50,6 +57,15 @@ func (command *CharacterCommand) Execute(game def.GameCtrl, message *m.Message)
 	sb.WriteString("/")
 	sb.WriteString(itoa(int(char.MaxHitPoints)))
 	sb.WriteString("\n")
+	sb.WriteString("  Mana: ")
+	sb.WriteString(itoa(int(char.CurrentMana)))
+	sb.WriteString("/")
+	sb.WriteString(itoa(int(char.MaxMana)))
+	sb.WriteString(" | Stamina: ")
+	sb.WriteString(itoa(int(char.CurrentStamina)))
+	sb.WriteString("/")
+	sb.WriteString(itoa(int(char.MaxStamina)))
+	sb.WriteString("\n")
 
 	// Gold
 	sb.WriteString("  Gold: ")
@@ -93,6 +109,11 @@ func (command *CharacterCommand) Execute(game def.GameCtrl, message *m.Message)
 	if char.InCombat {
 		sb.WriteString("  Status: IN COMBAT\n")
 	}
+	if summary := char.StatusEffects.Summary(time.Now()); summary != "" {
+		sb.WriteString("  Effects: ")
+		sb.WriteString(summary)
+		sb.WriteString("\n")
+	}
 
 	// Equipment
 	sb.WriteString("\n[Equipment]\n")
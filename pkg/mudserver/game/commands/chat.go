@@ -0,0 +1,311 @@
+package commands
+
+import (
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/talesmud/talesmud/pkg/entities/characters"
+	"github.com/talesmud/talesmud/pkg/mudserver/game/def"
+	"github.com/talesmud/talesmud/pkg/mudserver/game/messages"
+)
+
+// SayCommand says something to everyone in the room
+type SayCommand struct {
+}
+
+// Key returns the command key matcher
+func (command *SayCommand) Key() CommandKey { return &StartsWithCommandKey{} }
+
+// Execute handles the say command: "say hello"
+func (command *SayCommand) Execute(game def.GameCtrl, message *messages.Message) bool {
+	text, ok := chatText(game, message, "Say what? Usage: say <text>")
+	if !ok {
+		return true
+	}
+
+	game.SendMessage() <- messages.NewChatMessage(message.FromUser.ID, "say", message.Character.Name, "You say: "+text)
+	sendToRoom(game, message, "say", message.Character.Name+" says: "+text)
+	return true
+}
+
+// EmoteCommand shows an action of the character to everyone in the room
+type EmoteCommand struct {
+}
+
+// Key returns the command key matcher
+func (command *EmoteCommand) Key() CommandKey { return &StartsWithCommandKey{} }
+
+// Execute handles the emote command: "emote waves"
+func (command *EmoteCommand) Execute(game def.GameCtrl, message *messages.Message) bool {
+	text, ok := chatText(game, message, "Emote what? Usage: emote <action>")
+	if !ok {
+		return true
+	}
+
+	line := message.Character.Name + " " + text
+	game.SendMessage() <- messages.NewChatMessage(message.FromUser.ID, "emote", message.Character.Name, line)
+	sendToRoom(game, message, "emote", line)
+	return true
+}
+
+// WhisperCommand says something only a player in the same room hears
+type WhisperCommand struct {
+}
+
+// Key returns the command key matcher
+func (command *WhisperCommand) Key() CommandKey { return &StartsWithCommandKey{} }
+
+// Execute handles the whisper command: "whisper bob meet me outside"
+func (command *WhisperCommand) Execute(game def.GameCtrl, message *messages.Message) bool {
+	name, text, ok := chatTargetText(game, message, "Whisper what to whom? Usage: whisper <player> <text>")
+	if !ok {
+		return true
+	}
+
+	target := findOnlineCharacter(game, name)
+	if target == nil || target.CurrentRoomID != message.Character.CurrentRoomID {
+		game.SendMessage() <- message.Reply("There is no player named '" + name + "' here.")
+		return true
+	}
+	if target.ID == message.Character.ID {
+		game.SendMessage() <- message.Reply("You mumble to yourself.")
+		return true
+	}
+
+	game.SendMessage() <- messages.NewChatMessage(message.FromUser.ID, "whisper", message.Character.Name, "You whisper to "+target.Name+": "+text)
+	game.SendMessage() <- messages.NewChatMessage(target.BelongsUserID, "whisper", message.Character.Name, message.Character.Name+" whispers to you: "+text)
+
+	// the others only notice the whispering
+	notice := message.Character.Name + " whispers something to " + target.Name + "."
+	for _, character := range onlineCharactersInRoom(game, message.Character.CurrentRoomID) {
+		if character.ID != message.Character.ID && character.ID != target.ID {
+			game.SendMessage() <- messages.NewChatMessage(character.BelongsUserID, "whisper", message.Character.Name, notice)
+		}
+	}
+	return true
+}
+
+// TellCommand sends a private message to an online player anywhere in the world
+type TellCommand struct {
+}
+
+// Key returns the command key matcher
+func (command *TellCommand) Key() CommandKey { return &StartsWithCommandKey{} }
+
+// Execute handles the tell command: "tell bob where are you?"
+func (command *TellCommand) Execute(game def.GameCtrl, message *messages.Message) bool {
+	name, text, ok := chatTargetText(game, message, "Tell what to whom? Usage: tell <player> <text>")
+	if !ok {
+		return true
+	}
+
+	target := findOnlineCharacter(game, name)
+	if target == nil {
+		game.SendMessage() <- message.Reply("There is no player named '" + name + "' online.")
+		return true
+	}
+	if target.ID == message.Character.ID {
+		game.SendMessage() <- message.Reply("You tell yourself: " + text)
+		return true
+	}
+
+	game.SendMessage() <- messages.NewChatMessage(message.FromUser.ID, "tell", message.Character.Name, "You tell "+target.Name+": "+text)
+	game.SendMessage() <- messages.NewChatMessage(target.BelongsUserID, "tell", message.Character.Name, message.Character.Name+" tells you: "+text)
+	return true
+}
+
+// ChannelPrefix starts a line talking on a chat channel: ".trade selling a sword"
+const ChannelPrefix = "."
+
+// ChannelCommand lists, joins and leaves chat channels
+type ChannelCommand struct {
+}
+
+// Key returns the command key matcher
+func (command *ChannelCommand) Key() CommandKey { return &StartsWithCommandKey{} }
+
+// Execute handles the channel command: "channels", "channel join ooc", "channel leave trade"
+func (command *ChannelCommand) Execute(game def.GameCtrl, message *messages.Message) bool {
+	if message.Character == nil {
+		game.SendMessage() <- message.Reply("You need to select a character first.")
+		return true
+	}
+
+	parts := strings.Fields(message.Data)
+	if len(parts) < 2 || strings.ToLower(parts[1]) == "list" {
+		command.list(game, message)
+		return true
+	}
+	if len(parts) < 3 {
+		game.SendMessage() <- message.Reply("Usage: channel list | channel join <channel> | channel leave <channel>")
+		return true
+	}
+
+	channel := game.GetChannelManager().FindChannel(parts[2])
+	if channel == nil {
+		game.SendMessage() <- message.Reply("There is no channel named '" + parts[2] + "'.")
+		return true
+	}
+
+	character := message.Character
+	switch strings.ToLower(parts[1]) {
+	case "join":
+		if !channel.CanJoin(character.Level) {
+			game.SendMessage() <- message.Reply("You can't join [" + channel.Key() + "].")
+			return true
+		}
+		if !character.JoinChannel(channel.Name) {
+			game.SendMessage() <- message.Reply("You are already in [" + channel.Key() + "].")
+			return true
+		}
+		if err := game.GetFacade().CharactersService().Update(character.ID, character); err != nil {
+			log.WithError(err).Error("Error updating character channels")
+		}
+		game.SendMessage() <- message.Reply("You joined [" + channel.Key() + "]. Talk with: " + ChannelPrefix + channel.Key() + " <text>")
+
+	case "leave":
+		if !character.LeaveChannel(channel.Name) {
+			game.SendMessage() <- message.Reply("You are not in [" + channel.Key() + "].")
+			return true
+		}
+		if err := game.GetFacade().CharactersService().Update(character.ID, character); err != nil {
+			log.WithError(err).Error("Error updating character channels")
+		}
+		game.SendMessage() <- message.Reply("You left [" + channel.Key() + "].")
+
+	default:
+		game.SendMessage() <- message.Reply("Usage: channel list | channel join <channel> | channel leave <channel>")
+	}
+	return true
+}
+
+func (command *ChannelCommand) list(game def.GameCtrl, message *messages.Message) {
+	all := game.GetChannelManager().Channels()
+	if len(all) == 0 {
+		game.SendMessage() <- message.Reply("There are no chat channels.")
+		return
+	}
+
+	var sb strings.Builder
+	sb.WriteString("Chat channels:")
+	for _, channel := range all {
+		if !channel.CanJoin(message.Character.Level) && !message.Character.IsInChannel(channel.Name) {
+			continue
+		}
+		marker := "  "
+		if message.Character.IsInChannel(channel.Name) {
+			marker = "* "
+		}
+		sb.WriteString("\n" + marker + "[" + channel.Key() + "] " + channel.Description)
+	}
+	sb.WriteString("\n(* = joined) Use 'channel join <channel>' or 'channel leave <channel>', talk with '" + ChannelPrefix + "<channel> <text>'.")
+	game.SendMessage() <- message.Reply(sb.String())
+}
+
+// ProcessChannelMessage sends the message to a chat channel if it starts with the prefixed channel name: ".trade hello"
+// Channels that share no name with a command also work without the prefix: "ooc hello"
+// Returns false if the first word is no channel
+func ProcessChannelMessage(game def.GameCtrl, message *messages.Message) bool {
+	if message.Character == nil {
+		return false
+	}
+	parts := strings.Fields(message.Data)
+	if len(parts) == 0 {
+		return false
+	}
+
+	name := strings.TrimPrefix(parts[0], ChannelPrefix)
+	prefixed := name != parts[0]
+	if name == "" {
+		return false
+	}
+
+	channel := game.GetChannelManager().FindChannel(name)
+	if channel == nil {
+		if prefixed {
+			game.SendMessage() <- message.Reply("There is no channel named '" + name + "'.")
+			return true
+		}
+		return false
+	}
+	if !message.Character.IsInChannel(channel.Name) {
+		game.SendMessage() <- message.Reply("You are not in [" + channel.Key() + "]. Use 'channel join " + channel.Key() + "' first.")
+		return true
+	}
+	if len(parts) < 2 {
+		game.SendMessage() <- message.Reply("Usage: " + ChannelPrefix + channel.Key() + " <text>")
+		return true
+	}
+
+	game.GetChannelManager().Broadcast(channel, message.Character, strings.Join(parts[1:], " "))
+	return true
+}
+
+// joinChannelsOnLogin puts characters that never joined a channel into the auto join channels
+// and replays the recent lines of their channels
+func joinChannelsOnLogin(game def.GameCtrl, userID string, character *characters.Character) {
+	if character.Channels == nil {
+		character.Channels = []string{}
+		for _, channel := range game.GetChannelManager().Channels() {
+			if channel.AutoJoin && channel.CanJoin(character.Level) {
+				character.JoinChannel(channel.Name)
+			}
+		}
+		if err := game.GetFacade().CharactersService().Update(character.ID, character); err != nil {
+			log.WithError(err).Error("Error updating character channels")
+		}
+	}
+	game.GetChannelManager().ReplayHistory(userID, character)
+}
+
+// chatText returns the text after the command, replies with the usage if there is none
+func chatText(game def.GameCtrl, message *messages.Message, usage string) (string, bool) {
+	if message.Character == nil {
+		game.SendMessage() <- message.Reply("You need to select a character first.")
+		return "", false
+	}
+	parts := strings.Fields(message.Data)
+	if len(parts) < 2 {
+		game.SendMessage() <- message.Reply(usage)
+		return "", false
+	}
+	return strings.Join(parts[1:], " "), true
+}
+
+// chatTargetText returns the player name and the text after the command, replies with the usage if one is missing
+func chatTargetText(game def.GameCtrl, message *messages.Message, usage string) (string, string, bool) {
+	if message.Character == nil {
+		game.SendMessage() <- message.Reply("You need to select a character first.")
+		return "", "", false
+	}
+	parts := strings.Fields(message.Data)
+	if len(parts) < 3 {
+		game.SendMessage() <- message.Reply(usage)
+		return "", "", false
+	}
+	return parts[1], strings.Join(parts[2:], " "), true
+}
+
+// sendToRoom sends a chat line to everyone in the room of the character except the character
+func sendToRoom(game def.GameCtrl, message *messages.Message, channel, text string) {
+	chat := messages.NewChatMessage(message.Character.CurrentRoomID, channel, message.Character.Name, text)
+	chat.Audience = messages.MessageAudienceRoomWithoutOrigin
+	chat.OriginID = message.Character.ID
+	game.SendMessage() <- chat
+}
+
+// onlineCharactersInRoom returns the active characters of online players in the room
+func onlineCharactersInRoom(game def.GameCtrl, roomID string) []*characters.Character {
+	result := make([]*characters.Character, 0)
+	room, err := game.GetFacade().RoomsService().FindByID(roomID)
+	if err != nil || room.Characters == nil {
+		return result
+	}
+	for _, id := range *room.Characters {
+		if character, err := game.GetFacade().CharactersService().FindByID(id); err == nil && isCharacterOnline(game, character) {
+			result = append(result, character)
+		}
+	}
+	return result
+}
@@ -4,6 +4,7 @@ import (
 	"log"
 	"strings"
 
+	"github.com/talesmud/talesmud/pkg/entities"
 	"github.com/talesmud/talesmud/pkg/mudserver/game/def"
 	"github.com/talesmud/talesmud/pkg/mudserver/game/messages"
 )
@@ -12,6 +13,9 @@ import (
 type CommandProcessor struct {
 	commands map[string]Command
 	Help     map[string]string
+
+	// roles maps the keys and help entries of staff commands to the role required to use them
+	roles map[string]string
 }
 
 // NewCommandProcessor .. creates a new command processor
@@ -19,6 +23,7 @@ func NewCommandProcessor() *CommandProcessor {
 	var commandProcessor = &CommandProcessor{
 		commands: make(map[string]Command),
 		Help:     make(map[string]string),
+		roles:    make(map[string]string),
 	}
 	// only once?
 	commandProcessor.registerCommands()
@@ -27,6 +32,15 @@ func NewCommandProcessor() *CommandProcessor {
 
 // RegisterCommand ... register
 func (commandProcessor *CommandProcessor) RegisterCommand(command Command, desc string, keys ...string) {
+	commandProcessor.register(command, "", desc, keys...)
+}
+
+// RegisterStaffCommand registers a command only users with the role (or a higher one) can use, every use is audited
+func (commandProcessor *CommandProcessor) RegisterStaffCommand(command Command, role string, desc string, keys ...string) {
+	commandProcessor.register(command, role, desc, keys...)
+}
+
+func (commandProcessor *CommandProcessor) register(command Command, role string, desc string, keys ...string) {
 
 	cmds := "["
 	for i, key := range keys {
@@ -35,11 +49,22 @@ func (commandProcessor *CommandProcessor) RegisterCommand(command Command, desc
 		}
 		cmds += key
 		commandProcessor.commands[key] = command
+		if role != "" {
+			commandProcessor.roles[key] = role
+		}
 	}
 
 	cmds += "]"
 
 	commandProcessor.Help[cmds] = desc
+	if role != "" {
+		commandProcessor.roles[cmds] = role
+	}
+}
+
+// RequiredRole returns the role needed for a command key or help entry, empty for commands everyone can use
+func (commandProcessor *CommandProcessor) RequiredRole(key string) string {
+	return commandProcessor.roles[key]
 }
 
 // Process ...asd
@@ -56,6 +81,15 @@ func (commandProcessor *CommandProcessor) Process(game def.GameCtrl, message *me
 				return false
 			}
 
+			// staff commands are unknown to everyone without the role
+			if role := commandProcessor.RequiredRole(key); role != "" {
+				user := currentUser(game, message)
+				if user == nil || !user.HasRole(role) {
+					return false
+				}
+				recordStaffCommand(game, message, user, key)
+			}
+
 			log.Println("Found command " + key + " executing...")
 			return val.Execute(game, message)
 		}
@@ -77,18 +111,37 @@ func (commandProcessor *CommandProcessor) registerCommands() {
 	commandProcessor.RegisterCommand(&CharacterCommand{}, "Display character stats", "character", "char", "stats")
 	commandProcessor.RegisterCommand(&NewCharacterCommand{}, "Create a new character", "newcharacter", "nc")
 	commandProcessor.RegisterCommand(&TalkCommand{}, "Talk to an NPC: talk [npc-name]", "talk")
+	commandProcessor.RegisterCommand(&TelnetPasswordCommand{}, "Set the password for telnet logins: telnetpassword [password]", "telnetpassword")
+
+	// Chat commands
+	commandProcessor.RegisterCommand(&SayCommand{}, "Say something to the room: say [text]", "say")
+	commandProcessor.RegisterCommand(&EmoteCommand{}, "Show an action to the room: emote [action]", "emote", "me")
+	commandProcessor.RegisterCommand(&WhisperCommand{}, "Whisper to a player in the room: whisper [player] [text]", "whisper")
+	commandProcessor.RegisterCommand(&TellCommand{}, "Send a private message to an online player: tell [player] [text]", "tell")
+	commandProcessor.RegisterCommand(&ChannelCommand{}, "List, join or leave chat channels: channel [list|join|leave] [channel], talk with [channel] [text]", "channel", "channels")
+
+	commandProcessor.RegisterCommand(&MailCommand{}, "Send and read mail: mail [list|read|send|collect|delete]", "mail")
 
 	// Item commands
-	commandProcessor.RegisterCommand(&PickupCommand{}, "Pick up an item: pickup [item]", "pickup", "get", "take")
+	commandProcessor.RegisterCommand(&PickupCommand{}, "Pick up an item: pickup [item] or get [item] from [container]", "pickup", "get", "take")
 	commandProcessor.RegisterCommand(&DropCommand{}, "Drop an item: drop [item] [quantity]", "drop")
 	commandProcessor.RegisterCommand(&ExamineCommand{}, "Examine an item: examine [item]", "examine", "inspect")
 	commandProcessor.RegisterCommand(&UseCommand{}, "Use a consumable item: use [item]", "use", "eat", "drink", "consume")
 
+	// Door and container commands
+	commandProcessor.RegisterCommand(&OpenCommand{}, "Open a door or container: open [door|container]", "open")
+	commandProcessor.RegisterCommand(&CloseCommand{}, "Close a door or container: close [door|container]", "close")
+	commandProcessor.RegisterCommand(&LockCommand{}, "Lock a door or container with its key: lock [door|container]", "lock")
+	commandProcessor.RegisterCommand(&UnlockCommand{}, "Unlock a door or container with its key: unlock [door|container]", "unlock")
+	commandProcessor.RegisterCommand(&PickCommand{}, "Pick the lock of a door: pick [door]", "pick", "picklock")
+	commandProcessor.RegisterCommand(&PutCommand{}, "Put an item into a container: put [item] in [container]", "put")
+
 	// Trade commands
 	commandProcessor.RegisterCommand(&ListCommand{}, "List merchant inventory: list", "list", "shop")
 	commandProcessor.RegisterCommand(&BuyCommand{}, "Buy from merchant: buy [item] [quantity]", "buy")
 	commandProcessor.RegisterCommand(&SellCommand{}, "Sell to merchant: sell [item] [quantity]", "sell")
 	commandProcessor.RegisterCommand(&ValueCommand{}, "Check item sell price: value [item]", "value", "price")
+	commandProcessor.RegisterCommand(&TradeCommand{}, "Trade with a player: trade [player|offer|gold|remove|confirm|cancel]", "trade")
 
 	// Equipment commands
 	commandProcessor.RegisterCommand(&EquipCommand{}, "Equip an item: equip [item]", "equip", "wear")
@@ -101,7 +154,35 @@ func (commandProcessor *CommandProcessor) registerCommands() {
 	commandProcessor.RegisterCommand(&FleeCommand{}, "Attempt to flee from combat", "flee", "run", "escape")
 	commandProcessor.RegisterCommand(&CombatStatusCommand{}, "Show combat status", "status", "cs", "combat")
 
+	// Skill commands
+	commandProcessor.RegisterCommand(&SkillsCommand{}, "List your skills", "skills")
+	commandProcessor.RegisterCommand(&CastCommand{}, "Use a skill: cast [skill] [target]", "cast")
+
 	// Respawn commands
 	commandProcessor.RegisterCommand(&BindCommand{}, "Bind respawn point: bind", "bind")
 
+	// Group commands
+	commandProcessor.RegisterCommand(&GroupCommand{}, "Manage your group: group [list|invite|accept|decline|leave|kick] [player]", "group", "party")
+	commandProcessor.RegisterCommand(&GroupTellCommand{}, "Talk to your group: gtell [message]", "gtell", "gt")
+
+	// Quest commands
+	commandProcessor.RegisterCommand(&QuestCommand{}, "Quests offered here: quest [list|info|accept|abandon] [quest]", "quest")
+	commandProcessor.RegisterCommand(&JournalCommand{}, "Show your active quests", "journal", "quests", "ql")
+
+	// Staff commands
+	commandProcessor.RegisterStaffCommand(&GotoCommand{}, entities.RoleCreator, "Go to a room or player: goto [room id|room name|player]", "goto")
+	commandProcessor.RegisterStaffCommand(&SpawnCommand{}, entities.RoleCreator, "Spawn an NPC from a template in this room: spawn [npc template]", "spawn")
+	commandProcessor.RegisterStaffCommand(&PurgeCommand{}, entities.RoleCreator, "Remove all NPCs and loose items from this room", "purge")
+	commandProcessor.RegisterStaffCommand(&RestoreCommand{}, entities.RoleCreator, "Restore health, mana and stamina: restore [player]", "restore")
+	commandProcessor.RegisterStaffCommand(&GiveItemCommand{}, entities.RoleCreator, "Create an item from a template: give item [template] [to player]", "give")
+	commandProcessor.RegisterStaffCommand(&DigCommand{}, entities.RoleCreator, "Create a room behind a new exit: dig [direction] [room name]", "dig")
+	commandProcessor.RegisterStaffCommand(&ReditCommand{}, entities.RoleCreator, "Edit this room: redit [name|desc|area|tags|mood] [value]", "redit")
+	commandProcessor.RegisterStaffCommand(&ExitCommand{}, entities.RoleCreator, "Edit exits: exit [add|remove|hide|show] [name] [room] [oneway]", "exit")
+	commandProcessor.RegisterStaffCommand(&ActionCommand{}, entities.RoleCreator, "Edit room actions: action [list|add|addroom|remove] [name] [response]", "action")
+	commandProcessor.RegisterStaffCommand(&SetBindCommand{}, entities.RoleCreator, "Allow binding the respawn point here: setbind [on|off]", "setbind")
+	commandProcessor.RegisterStaffCommand(&TransferCommand{}, entities.RoleAdmin, "Bring a player to this room: transfer [player]", "transfer", "summon")
+	commandProcessor.RegisterStaffCommand(&SetLevelCommand{}, entities.RoleAdmin, "Set the level of a character: setlevel [player] [level]", "setlevel")
+	commandProcessor.RegisterStaffCommand(&KickCommand{}, entities.RoleAdmin, "Disconnect a player: kick [player] [reason]", "kick")
+	commandProcessor.RegisterStaffCommand(&ForceCommand{processor: commandProcessor}, entities.RoleAdmin, "Make a player run a command (no staff commands): force [player] [command]", "force")
+
 }
@@ -0,0 +1,404 @@
+package commands
+
+import (
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/talesmud/talesmud/pkg/entities/items"
+	"github.com/talesmud/talesmud/pkg/entities/quests"
+	"github.com/talesmud/talesmud/pkg/mudserver/game/def"
+	"github.com/talesmud/talesmud/pkg/mudserver/game/messages"
+)
+
+// OpenCommand opens a closed door or container
+type OpenCommand struct {
+}
+
+// Key returns the command key matcher
+func (command *OpenCommand) Key() CommandKey { return &StartsWithCommandKey{} }
+
+// Execute handles the open command
+func (command *OpenCommand) Execute(game def.GameCtrl, message *messages.Message) bool {
+	if handleDoorCommand(game, message, doorActionOpen) {
+		return true
+	}
+
+	container, inRoom := containerFromCommand(game, message, "Open what? Usage: open <door|container>")
+	if container == nil {
+		return true
+	}
+
+	if !container.Closed {
+		game.SendMessage() <- message.Reply(container.Name + " is already open.")
+		return true
+	}
+	if container.Locked {
+		game.SendMessage() <- message.Reply(container.Name + " is locked.")
+		return true
+	}
+
+	container.Closed = false
+	if saveContainer(game, message, container, inRoom) {
+		game.SendMessage() <- message.Reply("You open " + container.Name + ".")
+	}
+	return true
+}
+
+// CloseCommand closes an open door or container
+type CloseCommand struct {
+}
+
+// Key returns the command key matcher
+func (command *CloseCommand) Key() CommandKey { return &StartsWithCommandKey{} }
+
+// Execute handles the close command
+func (command *CloseCommand) Execute(game def.GameCtrl, message *messages.Message) bool {
+	if handleDoorCommand(game, message, doorActionClose) {
+		return true
+	}
+
+	container, inRoom := containerFromCommand(game, message, "Close what? Usage: close <door|container>")
+	if container == nil {
+		return true
+	}
+
+	if container.Closed {
+		game.SendMessage() <- message.Reply(container.Name + " is already closed.")
+		return true
+	}
+
+	container.Closed = true
+	if saveContainer(game, message, container, inRoom) {
+		game.SendMessage() <- message.Reply("You close " + container.Name + ".")
+	}
+	return true
+}
+
+// LockCommand locks a closed door or container with a matching key
+type LockCommand struct {
+}
+
+// Key returns the command key matcher
+func (command *LockCommand) Key() CommandKey { return &StartsWithCommandKey{} }
+
+// Execute handles the lock command
+func (command *LockCommand) Execute(game def.GameCtrl, message *messages.Message) bool {
+	if handleDoorCommand(game, message, doorActionLock) {
+		return true
+	}
+
+	container, inRoom := containerFromCommand(game, message, "Lock what? Usage: lock <door|container>")
+	if container == nil {
+		return true
+	}
+
+	if !container.HasLock() {
+		game.SendMessage() <- message.Reply(container.Name + " has no lock.")
+		return true
+	}
+	if container.Locked {
+		game.SendMessage() <- message.Reply(container.Name + " is already locked.")
+		return true
+	}
+	if !container.Closed {
+		game.SendMessage() <- message.Reply("You need to close " + container.Name + " first.")
+		return true
+	}
+
+	key := message.Character.Inventory.FindKeyFor(container)
+	if key == nil {
+		game.SendMessage() <- message.Reply("You don't have the key for " + container.Name + ".")
+		return true
+	}
+
+	container.Locked = true
+	if saveContainer(game, message, container, inRoom) {
+		game.SendMessage() <- message.Reply("You lock " + container.Name + " with " + key.Name + ".")
+	}
+	return true
+}
+
+// UnlockCommand unlocks a locked door or container with a matching key
+type UnlockCommand struct {
+}
+
+// Key returns the command key matcher
+func (command *UnlockCommand) Key() CommandKey { return &StartsWithCommandKey{} }
+
+// Execute handles the unlock command
+func (command *UnlockCommand) Execute(game def.GameCtrl, message *messages.Message) bool {
+	if handleDoorCommand(game, message, doorActionUnlock) {
+		return true
+	}
+
+	container, inRoom := containerFromCommand(game, message, "Unlock what? Usage: unlock <door|container>")
+	if container == nil {
+		return true
+	}
+
+	if !container.Locked {
+		game.SendMessage() <- message.Reply(container.Name + " is not locked.")
+		return true
+	}
+
+	key := message.Character.Inventory.FindKeyFor(container)
+	if key == nil {
+		game.SendMessage() <- message.Reply("You don't have the key for " + container.Name + ".")
+		return true
+	}
+
+	container.Locked = false
+	if saveContainer(game, message, container, inRoom) {
+		game.SendMessage() <- message.Reply("You unlock " + container.Name + " with " + key.Name + ".")
+	}
+	return true
+}
+
+// PutCommand puts an inventory item into a container
+type PutCommand struct {
+}
+
+// Key returns the command key matcher
+func (command *PutCommand) Key() CommandKey { return &StartsWithCommandKey{} }
+
+// Execute handles the put command: "put gem in chest"
+func (command *PutCommand) Execute(game def.GameCtrl, message *messages.Message) bool {
+	if message.Character == nil {
+		game.SendMessage() <- message.Reply("You need to select a character first.")
+		return true
+	}
+
+	parts := strings.Fields(message.Data)
+	itemName, containerName, ok := splitContainerArgs(strings.Join(parts[1:], " "), "into", "in")
+	if !ok {
+		game.SendMessage() <- message.Reply("Put what where? Usage: put <item> in <container>")
+		return true
+	}
+
+	item := message.Character.Inventory.FindItemByName(itemName)
+	if item == nil {
+		item = message.Character.Inventory.FindItemByTargetName(itemName)
+	}
+	if item == nil {
+		game.SendMessage() <- message.Reply("You don't have a '" + itemName + "' in your inventory.")
+		return true
+	}
+
+	container, inRoom := findContainer(game, message, containerName)
+	if container == nil {
+		game.SendMessage() <- message.Reply("You don't see a '" + containerName + "' here.")
+		return true
+	}
+	if !container.IsContainer() {
+		game.SendMessage() <- message.Reply("You can't put anything into " + container.Name + ".")
+		return true
+	}
+	if item.ID == container.ID || item.IsContainer() {
+		game.SendMessage() <- message.Reply("You can't put " + item.Name + " into another container.")
+		return true
+	}
+	if container.Closed {
+		game.SendMessage() <- message.Reply(container.Name + " is closed.")
+		return true
+	}
+
+	if _, err := message.Character.Inventory.RemoveItem(item.ID); err != nil {
+		game.SendMessage() <- message.Reply("You don't have a '" + itemName + "' in your inventory.")
+		return true
+	}
+	if err := container.PutItem(item); err != nil {
+		// put the item back, the container is full
+		message.Character.Inventory.Items = append(message.Character.Inventory.Items, item)
+		game.SendMessage() <- message.Reply(container.Name + " is full.")
+		return true
+	}
+
+	if inRoom {
+		if err := game.GetFacade().ItemsService().Update(container.ID, container); err != nil {
+			log.WithError(err).Error("Error updating container")
+			game.SendMessage() <- message.Reply("Error putting item into " + container.Name + ".")
+			return true
+		}
+	}
+	if err := game.GetFacade().CharactersService().Update(message.Character.ID, message.Character); err != nil {
+		log.WithError(err).Error("Error updating character")
+	}
+
+	game.SendMessage() <- message.Reply("You put " + item.Name + " into " + container.Name + ".")
+	if inv := messages.NewInventoryUpdateMessage(message); inv != nil {
+		game.SendMessage() <- inv
+	}
+	return true
+}
+
+// getFromContainer takes an item out of a container, used by "get <item> from <container>"
+func getFromContainer(game def.GameCtrl, message *messages.Message, itemName, containerName string) bool {
+	container, inRoom := findContainer(game, message, containerName)
+	if container == nil {
+		game.SendMessage() <- message.Reply("You don't see a '" + containerName + "' here.")
+		return true
+	}
+	if !container.IsContainer() {
+		game.SendMessage() <- message.Reply(container.Name + " is not a container.")
+		return true
+	}
+	if container.Closed {
+		game.SendMessage() <- message.Reply(container.Name + " is closed.")
+		return true
+	}
+
+	item := container.FindContainedItem(itemName)
+	if item == nil {
+		game.SendMessage() <- message.Reply("There is no '" + itemName + "' in " + container.Name + ".")
+		return true
+	}
+
+	// Remember the taken amount, stacking may change the item quantity
+	quantity := int32(1)
+	if item.Stackable && item.Quantity > 1 {
+		quantity = item.Quantity
+	}
+
+	if _, err := container.TakeItem(item.ID); err != nil {
+		game.SendMessage() <- message.Reply("There is no '" + itemName + "' in " + container.Name + ".")
+		return true
+	}
+	if err := message.Character.Inventory.AddItem(item); err != nil {
+		// leave the item in the container
+		container.Items = append(container.Items, item)
+		game.SendMessage() <- message.Reply("Your inventory is full.")
+		return true
+	}
+
+	if inRoom {
+		if err := game.GetFacade().ItemsService().Update(container.ID, container); err != nil {
+			log.WithError(err).Error("Error updating container")
+			game.SendMessage() <- message.Reply("Error taking item from " + container.Name + ".")
+			return true
+		}
+	}
+	if err := game.GetFacade().CharactersService().Update(message.Character.ID, message.Character); err != nil {
+		log.WithError(err).Error("Error updating character")
+	}
+
+	game.SendMessage() <- message.Reply("You take " + item.Name + " from " + container.Name + ".")
+	if inv := messages.NewInventoryUpdateMessage(message); inv != nil {
+		game.SendMessage() <- inv
+	}
+
+	UpdateQuestProgress(game, message.Character, quests.ObjectiveTypeCollect, quantity, item.TemplateID, item.ID)
+	return true
+}
+
+// lookInContainer lists the contents of a container, used by "look in <container>"
+func lookInContainer(game def.GameCtrl, message *messages.Message, containerName string) bool {
+	container, _ := findContainer(game, message, containerName)
+	if container == nil {
+		game.SendMessage() <- message.Reply("You don't see a '" + containerName + "' here.")
+		return true
+	}
+	if !container.IsContainer() {
+		game.SendMessage() <- message.Reply("You can't look inside " + container.Name + ".")
+		return true
+	}
+	if container.Closed {
+		game.SendMessage() <- message.Reply(container.Name + " is closed.")
+		return true
+	}
+
+	if len(container.Items) == 0 {
+		game.SendMessage() <- message.Reply(container.Name + " is empty.")
+		return true
+	}
+
+	var sb strings.Builder
+	sb.WriteString(container.Name + " contains:")
+	for _, item := range container.Items {
+		sb.WriteString("\n - ")
+		sb.WriteString(item.Name)
+		if item.Stackable && item.Quantity > 1 {
+			sb.WriteString(" (x" + itoa(int(item.Quantity)) + ")")
+		}
+	}
+	if container.MaxItems > 0 {
+		sb.WriteString("\n(" + itoa(len(container.Items)) + "/" + itoa(int(container.MaxItems)) + " slots used)")
+	}
+
+	game.SendMessage() <- message.Reply(sb.String())
+	return true
+}
+
+// containerFromCommand finds the container named by the command arguments and replies if there is none
+func containerFromCommand(game def.GameCtrl, message *messages.Message, usage string) (*items.Item, bool) {
+	if message.Character == nil {
+		game.SendMessage() <- message.Reply("You need to select a character first.")
+		return nil, false
+	}
+
+	parts := strings.Fields(message.Data)
+	if len(parts) < 2 {
+		game.SendMessage() <- message.Reply(usage)
+		return nil, false
+	}
+	name := strings.Join(parts[1:], " ")
+
+	container, inRoom := findContainer(game, message, name)
+	if container == nil {
+		game.SendMessage() <- message.Reply("You don't see a '" + name + "' here.")
+		return nil, false
+	}
+	if !container.IsContainer() {
+		game.SendMessage() <- message.Reply(container.Name + " is not a container.")
+		return nil, false
+	}
+	return container, inRoom
+}
+
+// findContainer finds an item by name in the room of the character or in its inventory
+// The second return value is true if the item lies in the room
+func findContainer(game def.GameCtrl, message *messages.Message, name string) (*items.Item, bool) {
+	if message.Character.CurrentRoomID != "" {
+		if room, err := game.GetFacade().RoomsService().FindByID(message.Character.CurrentRoomID); err == nil && room != nil {
+			if item := findItemInRoom(room, game, name); item != nil {
+				return item, true
+			}
+		}
+	}
+
+	item := message.Character.Inventory.FindItemByName(name)
+	if item == nil {
+		item = message.Character.Inventory.FindItemByTargetName(name)
+	}
+	return item, false
+}
+
+// saveContainer stores a changed container, room containers are stored as items and
+// inventory containers with the character
+func saveContainer(game def.GameCtrl, message *messages.Message, container *items.Item, inRoom bool) bool {
+	var err error
+	if inRoom {
+		err = game.GetFacade().ItemsService().Update(container.ID, container)
+	} else {
+		err = game.GetFacade().CharactersService().Update(message.Character.ID, message.Character)
+	}
+	if err != nil {
+		log.WithError(err).Error("Error updating container")
+		game.SendMessage() <- message.Reply("Error updating " + container.Name + ".")
+		return false
+	}
+	return true
+}
+
+// splitContainerArgs splits "gem in chest" at the first separator word into item and container name
+func splitContainerArgs(args string, separators ...string) (string, string, bool) {
+	words := strings.Fields(args)
+	for i, word := range words {
+		for _, separator := range separators {
+			if i > 0 && i < len(words)-1 && strings.EqualFold(word, separator) {
+				return strings.Join(words[:i], " "), strings.Join(words[i+1:], " "), true
+			}
+		}
+	}
+	return "", "", false
+}
@@ -0,0 +1,218 @@
+package commands
+
+import (
+	"math/rand"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/talesmud/talesmud/pkg/entities/items"
+	"github.com/talesmud/talesmud/pkg/entities/rooms"
+	"github.com/talesmud/talesmud/pkg/mudserver/game/def"
+	"github.com/talesmud/talesmud/pkg/mudserver/game/messages"
+	"github.com/talesmud/talesmud/pkg/mudserver/game/util"
+)
+
+// doorAction type
+type doorAction string
+
+const (
+	doorActionOpen   doorAction = "open"
+	doorActionClose  doorAction = "close"
+	doorActionLock   doorAction = "lock"
+	doorActionUnlock doorAction = "unlock"
+	doorActionPick   doorAction = "pick"
+)
+
+// PickCommand picks the lock of a locked door
+type PickCommand struct {
+}
+
+// Key returns the command key matcher
+func (command *PickCommand) Key() CommandKey { return &StartsWithCommandKey{} }
+
+// Execute handles the pick command: "pick north" or "pick gate"
+func (command *PickCommand) Execute(game def.GameCtrl, message *messages.Message) bool {
+	if handleDoorCommand(game, message, doorActionPick) {
+		return true
+	}
+	if message.Character != nil {
+		game.SendMessage() <- message.Reply("Pick which lock? Usage: pick <door>")
+	} else {
+		game.SendMessage() <- message.Reply("You need to select a character first.")
+	}
+	return true
+}
+
+// handleDoorCommand runs the action on a door in the current room,
+// returns false if the command arguments don't name a door so containers can be tried
+func handleDoorCommand(game def.GameCtrl, message *messages.Message, action doorAction) bool {
+	if message.Character == nil {
+		return false
+	}
+	parts := strings.Fields(message.Data)
+	if len(parts) < 2 {
+		return false
+	}
+	name := strings.Join(parts[1:], " ")
+
+	room, err := game.GetFacade().RoomsService().FindByID(message.Character.CurrentRoomID)
+	if err != nil {
+		return false
+	}
+	exit := room.FindDoor(name)
+	if exit == nil {
+		return false
+	}
+
+	combatEngine := game.GetCombatEngine()
+	if action == doorActionPick && combatEngine != nil && combatEngine.IsPlayerInCombat(message.Character.ID) {
+		game.SendMessage() <- message.Reply("You can't pick a lock while fighting!")
+		return true
+	}
+
+	// checks run inside the update so they see the stored door state
+	reply := ""
+	change, err := util.UpdateDoor(game, room.ID, exit.Name, func(door *rooms.Door) bool {
+		var ok bool
+		reply, ok = applyDoorAction(message, door, exit.Name, action)
+		return ok
+	})
+	if err != nil {
+		log.WithError(err).Error("Error updating door")
+		game.SendMessage() <- message.Reply("Something is wrong with that door.")
+		return true
+	}
+	game.SendMessage() <- message.Reply(reply)
+
+	if change != nil {
+		announceDoorChange(game, message, change, action)
+	}
+	return true
+}
+
+// applyDoorAction changes the door for the action, returns the reply and false if nothing changed
+func applyDoorAction(message *messages.Message, door *rooms.Door, exitName string, action doorAction) (string, bool) {
+	name := "the " + door.DisplayName() + " (" + exitName + ")"
+	now := time.Now()
+
+	switch action {
+	case doorActionOpen:
+		if !door.Closed {
+			return capitalize(name) + " is already open.", false
+		}
+		if door.Locked {
+			return capitalize(name) + " is locked.", false
+		}
+		door.SetState(false, false, now)
+		return "You open " + name + ".", true
+
+	case doorActionClose:
+		if door.Closed {
+			return capitalize(name) + " is already closed.", false
+		}
+		door.SetState(true, false, now)
+		return "You close " + name + ".", true
+
+	case doorActionLock:
+		if door.KeyID == "" {
+			return capitalize(name) + " has no lock.", false
+		}
+		if door.Locked {
+			return capitalize(name) + " is already locked.", false
+		}
+		if !door.Closed {
+			return "You need to close " + name + " first.", false
+		}
+		key := findDoorKey(message.Character.Inventory.Items, door)
+		if key == nil {
+			return "You don't have the key for " + name + ".", false
+		}
+		door.SetState(true, true, now)
+		return "You lock " + name + " with " + key.Name + ".", true
+
+	case doorActionUnlock:
+		if !door.Locked {
+			return capitalize(name) + " is not locked.", false
+		}
+		key := findDoorKey(message.Character.Inventory.Items, door)
+		if key == nil {
+			return "You don't have the key for " + name + ".", false
+		}
+		door.SetState(true, false, now)
+		return "You unlock " + name + " with " + key.Name + ".", true
+
+	case doorActionPick:
+		if !door.Locked {
+			return capitalize(name) + " is not locked.", false
+		}
+		if door.PickDifficulty <= 0 {
+			return "The lock of " + name + " can't be picked.", false
+		}
+		// 1d20 + DEX modifier against the difficulty of the lock
+		roll := rand.Intn(20) + 1 + message.Character.GetDEXMod()
+		if int32(roll) < door.PickDifficulty {
+			return "You fail to pick the lock of " + name + ".", false
+		}
+		door.SetState(true, false, now)
+		return "You pick the lock of " + name + ".", true
+	}
+	return "You can't do that.", false
+}
+
+// announceDoorChange tells the others in the room and the players on the other side about the door
+func announceDoorChange(game def.GameCtrl, message *messages.Message, change *util.DoorChange, action doorAction) {
+	door := change.Exit.Door
+	verbs := map[doorAction]string{
+		doorActionOpen:   "opens",
+		doorActionClose:  "closes",
+		doorActionLock:   "locks",
+		doorActionUnlock: "unlocks",
+		doorActionPick:   "picks the lock of",
+	}
+
+	game.SendMessage() <- messages.MessageResponse{
+		Audience:   messages.MessageAudienceRoomWithoutOrigin,
+		AudienceID: change.Room.ID,
+		OriginID:   message.Character.ID,
+		Message:    message.Character.Name + " " + verbs[action] + " the " + door.DisplayName() + " (" + change.Exit.Name + ").",
+	}
+
+	if change.LinkedExit == nil {
+		return
+	}
+	other := "The " + change.LinkedExit.Door.DisplayName() + " (" + change.LinkedExit.Name + ")"
+	text := ""
+	switch action {
+	case doorActionOpen:
+		text = other + " opens from the other side."
+	case doorActionClose:
+		text = other + " closes from the other side."
+	default:
+		text = "You hear a click from " + strings.ToLower(other[:1]) + other[1:] + "."
+	}
+	game.SendMessage() <- messages.MessageResponse{
+		Audience:   messages.MessageAudienceRoom,
+		AudienceID: change.LinkedRoomID,
+		Message:    text,
+	}
+}
+
+// findDoorKey returns the first item matching the key template of the door
+func findDoorKey(inventory []*items.Item, door *rooms.Door) *items.Item {
+	for _, item := range inventory {
+		if item.TemplateID == door.KeyID || item.ID == door.KeyID {
+			return item
+		}
+	}
+	return nil
+}
+
+// capitalize uppercases the first letter of the text
+func capitalize(text string) string {
+	if text == "" {
+		return text
+	}
+	return strings.ToUpper(text[:1]) + text[1:]
+}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/talesmud/talesmud/pkg/mudserver/game/def"
 	"github.com/talesmud/talesmud/pkg/mudserver/game/messages"
+	"github.com/talesmud/talesmud/pkg/scripts/events"
 )
 
 // DropCommand handles dropping items to the room
@@ -73,6 +74,16 @@ func (command *DropCommand) Execute(game def.GameCtrl, message *messages.Message
 		return true
 	}
 
+	// Event scripts may prevent the drop
+	if game.DispatchEvent(events.NewEventContext(events.EventItemDrop).
+		WithCharacter(message.Character).
+		WithRoom(room).
+		WithItem(item).
+		Set("quantity", quantity)) {
+		game.SendMessage() <- message.Reply("You can't drop " + item.Name + " right now.")
+		return true
+	}
+
 	// Handle quantity for stackable items
 	if item.Stackable && quantity > 0 && quantity < item.Quantity {
 		// Split the stack: create a new item with the dropped quantity
@@ -0,0 +1,32 @@
+package commands
+
+import (
+	"time"
+
+	"github.com/talesmud/talesmud/pkg/entities/characters"
+	"github.com/talesmud/talesmud/pkg/entities/effects"
+	"github.com/talesmud/talesmud/pkg/mudserver/game/def"
+)
+
+// ApplyCharacterEffect applies a status effect to a character, while fighting it is applied to the combatant
+// Returns false if the effect was ignored by its stack rule, the caller persists the character
+func ApplyCharacterEffect(game def.GameCtrl, character *characters.Character, effect effects.StatusEffect) bool {
+	if combatEngine := game.GetCombatEngine(); combatEngine != nil {
+		if inCombat, applied := combatEngine.ApplyStatusEffect(character.Entity.ID, effect); inCombat {
+			return applied
+		}
+	}
+	character.StatusEffects.Prune(time.Now())
+	return character.StatusEffects.Apply(effect, time.Now())
+}
+
+// RemoveCharacterEffect removes a status effect by ID or type from a character or its combatant
+// Returns true if an effect was removed, the caller persists the character
+func RemoveCharacterEffect(game def.GameCtrl, character *characters.Character, idOrType string) bool {
+	if combatEngine := game.GetCombatEngine(); combatEngine != nil {
+		if inCombat, removed := combatEngine.RemoveStatusEffect(character.Entity.ID, idOrType); inCombat {
+			return removed
+		}
+	}
+	return character.StatusEffects.Remove(idOrType)
+}
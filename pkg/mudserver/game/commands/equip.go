@@ -7,6 +7,7 @@ import (
 	"github.com/talesmud/talesmud/pkg/entities/items"
 	"github.com/talesmud/talesmud/pkg/mudserver/game/def"
 	"github.com/talesmud/talesmud/pkg/mudserver/game/messages"
+	"github.com/talesmud/talesmud/pkg/scripts/events"
 )
 
 // EquipCommand handles equipping items from inventory
@@ -48,6 +49,14 @@ func (command *EquipCommand) Execute(game def.GameCtrl, message *messages.Messag
 		return true
 	}
 
+	// Event scripts may prevent equipping the item
+	if game.DispatchEvent(events.NewEventContext(events.EventItemEquip).
+		WithCharacter(message.Character).
+		WithItem(item)) {
+		game.SendMessage() <- message.Reply("You can't equip " + item.Name + " right now.")
+		return true
+	}
+
 	// Ensure EquippedItems map exists
 	if message.Character.EquippedItems == nil {
 		message.Character.EquippedItems = make(map[items.ItemSlot]*items.Item)
@@ -0,0 +1,404 @@
+package commands
+
+import (
+	"fmt"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/talesmud/talesmud/pkg/entities"
+	"github.com/talesmud/talesmud/pkg/entities/characters"
+	"github.com/talesmud/talesmud/pkg/mudserver/game/def"
+	"github.com/talesmud/talesmud/pkg/mudserver/game/messages"
+	"github.com/talesmud/talesmud/pkg/service"
+)
+
+const groupUsage = "Usage: group [list] | group invite <player> | group accept [player] | group decline [player] | group leave | group kick <player>"
+
+// GroupCommand manages the character's party
+type GroupCommand struct {
+}
+
+// Key returns the command key matcher
+func (command *GroupCommand) Key() CommandKey { return &StartsWithCommandKey{} }
+
+// Execute handles the group command
+func (command *GroupCommand) Execute(game def.GameCtrl, message *messages.Message) bool {
+	if message.Character == nil {
+		game.SendMessage() <- message.Reply("You need to select a character first.")
+		return true
+	}
+
+	parts := strings.Fields(message.Data)
+	if len(parts) < 2 {
+		command.list(game, message)
+		return true
+	}
+
+	name := strings.Join(parts[2:], " ")
+
+	switch strings.ToLower(parts[1]) {
+	case "list", "info":
+		command.list(game, message)
+	case "invite":
+		command.invite(game, message, name)
+	case "accept", "join":
+		command.accept(game, message, name)
+	case "decline":
+		command.decline(game, message, name)
+	case "leave", "quit":
+		command.leave(game, message)
+	case "kick":
+		command.kick(game, message, name)
+	default:
+		game.SendMessage() <- message.Reply(groupUsage)
+	}
+	return true
+}
+
+// list shows the party members and pending invites
+func (command *GroupCommand) list(game def.GameCtrl, message *messages.Message) {
+	parties := game.GetFacade().PartiesService()
+
+	party, err := parties.FindByCharacterID(message.Character.ID)
+	if err != nil {
+		log.WithError(err).Error("Error loading party")
+	}
+
+	if party == nil {
+		result := "You are not in a group."
+		if invites, err := parties.FindInvitesFor(message.Character.ID); err == nil && len(invites) > 0 {
+			result += "\nYou have been invited by: " + strings.Join(partyLeaderNames(game, invites), ", ")
+			result += "\nUse 'group accept [player]' to join."
+		}
+		game.SendMessage() <- message.Reply(result)
+		return
+	}
+
+	var sb strings.Builder
+	sb.WriteString("=== " + party.Name + " ===\n")
+	for _, characterID := range party.Characters {
+		member, err := game.GetFacade().CharactersService().FindByID(characterID)
+		if err != nil {
+			continue
+		}
+		marker := "  "
+		if party.IsLeader(member.ID) {
+			marker = "* "
+		}
+		status := ""
+		if !isCharacterOnline(game, member) {
+			status = " (offline)"
+		} else if member.CurrentRoomID == message.Character.CurrentRoomID {
+			status = " (here)"
+		}
+		sb.WriteString(fmt.Sprintf("%s%s - Level %d, HP %d/%d%s\n", marker, member.Name, member.Level, member.CurrentHitPoints, member.MaxHitPoints, status))
+	}
+	if len(party.Invites) > 0 {
+		invited := make([]string, 0)
+		for _, characterID := range party.Invites {
+			if invitee, err := game.GetFacade().CharactersService().FindByID(characterID); err == nil {
+				invited = append(invited, invitee.Name)
+			}
+		}
+		sb.WriteString("Invited: " + strings.Join(invited, ", "))
+	}
+
+	game.SendMessage() <- message.Reply(strings.TrimRight(sb.String(), "\n"))
+}
+
+// invite invites another online player, creating the party if necessary
+func (command *GroupCommand) invite(game def.GameCtrl, message *messages.Message, name string) {
+	if name == "" {
+		game.SendMessage() <- message.Reply("Invite whom? Usage: group invite <player>")
+		return
+	}
+
+	target := findOnlineCharacter(game, name)
+	if target == nil {
+		game.SendMessage() <- message.Reply("There is no player named '" + name + "' online.")
+		return
+	}
+	if target.ID == message.Character.ID {
+		game.SendMessage() <- message.Reply("You can't invite yourself.")
+		return
+	}
+
+	parties := game.GetFacade().PartiesService()
+	if existing, _ := parties.FindByCharacterID(target.ID); existing != nil && len(existing.Characters) > 1 {
+		game.SendMessage() <- message.Reply(target.Name + " is already in a group.")
+		return
+	}
+
+	party, err := parties.FindByCharacterID(message.Character.ID)
+	if err != nil {
+		game.SendMessage() <- message.Reply("Error loading your group.")
+		return
+	}
+
+	if party == nil {
+		party, err = parties.CreateParty(&service.CreatePartyDTO{
+			Name:       message.Character.Name + "'s group",
+			Characters: []string{message.Character.ID},
+		})
+		if err != nil {
+			log.WithError(err).Error("Error creating party")
+			game.SendMessage() <- message.Reply("Error creating a group.")
+			return
+		}
+	} else if !party.IsLeader(message.Character.ID) {
+		game.SendMessage() <- message.Reply("Only the group leader can invite players.")
+		return
+	}
+
+	party.AddInvite(target.ID)
+	if err := parties.UpdateParty(party.ID, party); err != nil {
+		log.WithError(err).Error("Error updating party")
+		game.SendMessage() <- message.Reply("Error inviting " + target.Name + ".")
+		return
+	}
+
+	game.SendMessage() <- message.Reply("You invite " + target.Name + " to your group.")
+	game.SendMessage() <- messages.Reply(target.BelongsUserID,
+		message.Character.Name+" invites you to join a group. Use 'group accept "+message.Character.Name+"' to join.")
+}
+
+// accept joins a party the character has been invited to
+func (command *GroupCommand) accept(game def.GameCtrl, message *messages.Message, name string) {
+	parties := game.GetFacade().PartiesService()
+
+	existing, _ := parties.FindByCharacterID(message.Character.ID)
+	if existing != nil && len(existing.Characters) > 1 {
+		game.SendMessage() <- message.Reply("You are already in a group. Leave it first.")
+		return
+	}
+
+	party := findInvite(game, message.Character, name)
+	if party == nil {
+		game.SendMessage() <- message.Reply("You have no pending group invite.")
+		return
+	}
+
+	// a group without other members is dropped when joining another one
+	if existing != nil {
+		if _, err := parties.RemoveCharacterFromParty(existing, message.Character.ID); err != nil {
+			log.WithError(err).Error("Error removing character from party")
+		}
+	}
+
+	if err := parties.AddCharacterToParty(party, message.Character); err != nil {
+		game.SendMessage() <- message.Reply("You can't join the group: " + err.Error() + ".")
+		return
+	}
+
+	game.SendMessage() <- messages.NewPartyMessage(party.ID, "", message.Character.Name+" has joined the group.")
+}
+
+// decline rejects a pending party invite
+func (command *GroupCommand) decline(game def.GameCtrl, message *messages.Message, name string) {
+	party := findInvite(game, message.Character, name)
+	if party == nil {
+		game.SendMessage() <- message.Reply("You have no pending group invite.")
+		return
+	}
+
+	parties := game.GetFacade().PartiesService()
+	party.RemoveInvite(message.Character.ID)
+	game.SendMessage() <- message.Reply("You decline the invite.")
+
+	// a group that only existed for its invites is disbanded when the last one is declined
+	if len(party.Characters) < 2 && len(party.Invites) == 0 {
+		if err := parties.DeletePartyByID(party.ID); err != nil {
+			log.WithError(err).Error("Error deleting party")
+		}
+		for _, characterID := range party.Characters {
+			if member, err := game.GetFacade().CharactersService().FindByID(characterID); err == nil {
+				game.SendMessage() <- messages.Reply(member.BelongsUserID, message.Character.Name+" declined the group invite. The group has been disbanded.")
+			}
+		}
+		return
+	}
+
+	if err := parties.UpdateParty(party.ID, party); err != nil {
+		log.WithError(err).Error("Error updating party")
+	}
+	game.SendMessage() <- messages.NewPartyMessage(party.ID, "", message.Character.Name+" declined the group invite.")
+}
+
+// leave removes the character from the party
+func (command *GroupCommand) leave(game def.GameCtrl, message *messages.Message) {
+	party, _ := game.GetFacade().PartiesService().FindByCharacterID(message.Character.ID)
+	if party == nil {
+		game.SendMessage() <- message.Reply("You are not in a group.")
+		return
+	}
+
+	game.SendMessage() <- message.Reply("You leave the group.")
+	removeFromParty(game, party, message.Character, message.Character.Name+" has left the group.")
+}
+
+// kick removes another member from the party
+func (command *GroupCommand) kick(game def.GameCtrl, message *messages.Message, name string) {
+	party, _ := game.GetFacade().PartiesService().FindByCharacterID(message.Character.ID)
+	if party == nil {
+		game.SendMessage() <- message.Reply("You are not in a group.")
+		return
+	}
+	if !party.IsLeader(message.Character.ID) {
+		game.SendMessage() <- message.Reply("Only the group leader can kick members.")
+		return
+	}
+
+	var target *characters.Character
+	for _, characterID := range party.Characters {
+		if member, err := game.GetFacade().CharactersService().FindByID(characterID); err == nil && strings.EqualFold(member.Name, name) {
+			target = member
+			break
+		}
+	}
+	if target == nil || target.ID == message.Character.ID {
+		game.SendMessage() <- message.Reply("There is no other member named '" + name + "' in your group.")
+		return
+	}
+
+	game.SendMessage() <- messages.Reply(target.BelongsUserID, "You have been removed from the group.")
+	removeFromParty(game, party, target, target.Name+" has been removed from the group.")
+}
+
+// GroupTellCommand sends a message to all party members
+type GroupTellCommand struct {
+}
+
+// Key returns the command key matcher
+func (command *GroupTellCommand) Key() CommandKey { return &StartsWithCommandKey{} }
+
+// Execute handles the gtell command
+func (command *GroupTellCommand) Execute(game def.GameCtrl, message *messages.Message) bool {
+	if message.Character == nil {
+		game.SendMessage() <- message.Reply("You need to select a character first.")
+		return true
+	}
+
+	parts := strings.Fields(message.Data)
+	if len(parts) < 2 {
+		game.SendMessage() <- message.Reply("Tell your group what? Usage: gtell <message>")
+		return true
+	}
+
+	party, _ := game.GetFacade().PartiesService().FindByCharacterID(message.Character.ID)
+	if party == nil {
+		game.SendMessage() <- message.Reply("You are not in a group.")
+		return true
+	}
+
+	text := strings.Join(parts[1:], " ")
+	game.SendMessage() <- messages.NewPartyMessage(party.ID, message.Character.Name, "[Group] "+message.Character.Name+": "+text)
+	return true
+}
+
+// GetPartyMembersInRoom returns the online party members of the character in the given room, excluding the character
+func GetPartyMembersInRoom(game def.GameCtrl, character *characters.Character, roomID string) []*characters.Character {
+	result := make([]*characters.Character, 0)
+
+	party, err := game.GetFacade().PartiesService().FindByCharacterID(character.ID)
+	if err != nil || party == nil {
+		return result
+	}
+
+	for _, characterID := range party.Characters {
+		if characterID == character.ID {
+			continue
+		}
+		member, err := game.GetFacade().CharactersService().FindByID(characterID)
+		if err != nil || member.CurrentRoomID != roomID || !isCharacterOnline(game, member) {
+			continue
+		}
+		result = append(result, member)
+	}
+	return result
+}
+
+// NotifyParty sends a message to the party of the character, if any
+func NotifyParty(game def.GameCtrl, character *characters.Character, text string) {
+	if party, err := game.GetFacade().PartiesService().FindByCharacterID(character.ID); err == nil && party != nil {
+		game.SendMessage() <- messages.NewPartyMessage(party.ID, "", text)
+	}
+}
+
+// removeFromParty removes a member and notifies the remaining members
+func removeFromParty(game def.GameCtrl, party *entities.Party, character *characters.Character, text string) {
+	wasLeader := party.IsLeader(character.ID)
+
+	disbanded, err := game.GetFacade().PartiesService().RemoveCharacterFromParty(party, character.ID)
+	if err != nil {
+		log.WithError(err).Error("Error removing character from party")
+		return
+	}
+
+	if disbanded {
+		text += " The group has been disbanded."
+	} else if wasLeader {
+		if leader, err := game.GetFacade().CharactersService().FindByID(party.LeaderID); err == nil {
+			text += " " + leader.Name + " now leads the group."
+		}
+	}
+
+	for _, characterID := range party.Characters {
+		if member, err := game.GetFacade().CharactersService().FindByID(characterID); err == nil {
+			game.SendMessage() <- messages.Reply(member.BelongsUserID, text)
+		}
+	}
+}
+
+// findInvite returns the party that invited the character, optionally filtered by the name of a member
+func findInvite(game def.GameCtrl, character *characters.Character, name string) *entities.Party {
+	invites, err := game.GetFacade().PartiesService().FindInvitesFor(character.ID)
+	if err != nil || len(invites) == 0 {
+		return nil
+	}
+	if name == "" {
+		return invites[0]
+	}
+
+	for _, party := range invites {
+		for _, characterID := range party.Characters {
+			if member, err := game.GetFacade().CharactersService().FindByID(characterID); err == nil && strings.EqualFold(member.Name, name) {
+				return party
+			}
+		}
+	}
+	return nil
+}
+
+// partyLeaderNames returns the names of the leaders of the given parties
+func partyLeaderNames(game def.GameCtrl, parties []*entities.Party) []string {
+	names := make([]string, 0)
+	for _, party := range parties {
+		if leader, err := game.GetFacade().CharactersService().FindByID(party.LeaderID); err == nil {
+			names = append(names, leader.Name)
+		}
+	}
+	return names
+}
+
+// findOnlineCharacter finds the active character of an online user by name
+func findOnlineCharacter(game def.GameCtrl, name string) *characters.Character {
+	users, err := game.GetFacade().UsersService().FindAllOnline()
+	if err != nil {
+		return nil
+	}
+	for _, user := range users {
+		if user.LastCharacter == "" {
+			continue
+		}
+		if character, err := game.GetFacade().CharactersService().FindByID(user.LastCharacter); err == nil && strings.EqualFold(character.Name, name) {
+			return character
+		}
+	}
+	return nil
+}
+
+// isCharacterOnline returns true if the owning user is online and plays the character
+func isCharacterOnline(game def.GameCtrl, character *characters.Character) bool {
+	user, err := game.GetFacade().UsersService().FindByID(character.BelongsUserID)
+	return err == nil && user != nil && user.IsOnline && user.LastCharacter == character.ID
+}
@@ -17,9 +17,20 @@ func (command *HelpCommand) Key() CommandKey { return &ExactCommandKey{} }
 func (command *HelpCommand) Execute(game def.GameCtrl, message *m.Message) bool {
 
 	result := "List of all global commands:\n"
+	staff := ""
 
+	// staff commands are only listed for users that can use them
+	user := currentUser(game, message)
 	for key, element := range command.processor.Help {
-		result += key + " " + element + "\n"
+		role := command.processor.RequiredRole(key)
+		if role == "" {
+			result += key + " " + element + "\n"
+		} else if user != nil && user.HasRole(role) {
+			staff += key + " " + element + "\n"
+		}
+	}
+	if staff != "" {
+		result += "\nStaff commands:\n" + staff
 	}
 
 	game.SendMessage() <- m.Reply(message.FromUser.ID, result)
@@ -19,6 +19,11 @@ func Look(room *rooms.Room, game def.GameCtrl, message *messages.Message) bool {
 		return lookAtRoom(room, game, message)
 	}
 
+	// Handle looking into a container: "look in chest"
+	if len(parts) > 2 && strings.EqualFold(parts[1], "in") && message.Character != nil {
+		return lookInContainer(game, message, strings.Join(parts[2:], " "))
+	}
+
 	// Handle looking at a specific target
 	target := strings.Join(parts[1:], " ")
 	return lookAtTarget(room, game, message, target)
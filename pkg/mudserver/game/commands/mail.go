@@ -0,0 +1,378 @@
+package commands
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/talesmud/talesmud/pkg/entities/characters"
+	"github.com/talesmud/talesmud/pkg/entities/items"
+	"github.com/talesmud/talesmud/pkg/entities/mail"
+	"github.com/talesmud/talesmud/pkg/entities/rooms"
+	"github.com/talesmud/talesmud/pkg/mudserver/game/def"
+	"github.com/talesmud/talesmud/pkg/mudserver/game/messages"
+)
+
+// MailCommand sends and reads mail between characters
+type MailCommand struct {
+}
+
+// Key returns the command key matcher
+func (command *MailCommand) Key() CommandKey { return &StartsWithCommandKey{} }
+
+// Execute handles the mail command and its subcommands
+func (command *MailCommand) Execute(game def.GameCtrl, message *messages.Message) bool {
+	if message.Character == nil {
+		game.SendMessage() <- message.Reply("You need to select a character first.")
+		return true
+	}
+
+	parts := strings.Fields(message.Data)
+	if len(parts) < 2 {
+		command.list(game, message)
+		return true
+	}
+
+	args := parts[2:]
+	switch strings.ToLower(parts[1]) {
+	case "list", "inbox":
+		command.list(game, message)
+	case "read":
+		command.read(game, message, args)
+	case "send":
+		command.send(game, message, args)
+	case "collect", "take":
+		command.collect(game, message, args)
+	case "delete", "del":
+		command.delete(game, message, args)
+	default:
+		game.SendMessage() <- message.Reply(mailUsage)
+	}
+	return true
+}
+
+const mailUsage = "Usage: mail list | mail read [#] | mail send <player> <text> [+gold <amount>] [+item <item>] | mail collect <#> | mail delete <#>"
+
+func (command *MailCommand) list(game def.GameCtrl, message *messages.Message) {
+	inbox, err := game.GetFacade().MailService().FindInbox(message.Character.ID)
+	if err != nil {
+		log.WithError(err).Error("Error loading mail")
+		game.SendMessage() <- message.Reply("Your mailbox can't be opened right now.")
+		return
+	}
+	if len(inbox) == 0 {
+		game.SendMessage() <- message.Reply("You have no mail.")
+		return
+	}
+
+	var sb strings.Builder
+	sb.WriteString("Your mail:")
+	for i, m := range inbox {
+		marker := " "
+		if !m.Read {
+			marker = "*"
+		}
+		sb.WriteString("\n" + marker + " " + strconv.Itoa(i+1) + ". " + m.Sent.Format("2006-01-02 15:04") + " from " + m.FromName + ": " + m.Preview())
+		if m.HasAttachments() {
+			sb.WriteString(" [attachments]")
+		}
+	}
+	sb.WriteString("\n(* = unread) Use 'mail read <#>'.")
+	game.SendMessage() <- message.Reply(sb.String())
+}
+
+func (command *MailCommand) read(game def.GameCtrl, message *messages.Message, args []string) {
+	var m *mail.Mail
+	if len(args) == 0 {
+		m = firstUnreadMail(game, message)
+	} else {
+		m = findMail(game, message, args)
+	}
+	if m == nil {
+		return
+	}
+
+	var sb strings.Builder
+	sb.WriteString("From: " + m.FromName + "\n")
+	sb.WriteString("Sent: " + m.Sent.Format("2006-01-02 15:04") + "\n\n")
+	sb.WriteString(m.Body)
+	if m.HasAttachments() {
+		sb.WriteString("\n\nAttachments:")
+		if m.Gold > 0 {
+			sb.WriteString("\n - " + strconv.FormatInt(m.Gold, 10) + " gold")
+		}
+		for _, item := range m.Items {
+			sb.WriteString("\n - " + item.Name)
+			if item.Stackable && item.Quantity > 1 {
+				sb.WriteString(" (x" + strconv.Itoa(int(item.Quantity)) + ")")
+			}
+		}
+		sb.WriteString("\nCollect them at a post office with 'mail collect <#>'.")
+	}
+	game.SendMessage() <- message.Reply(sb.String())
+
+	if !m.Read {
+		m.Read = true
+		if err := game.GetFacade().MailService().Update(m.ID, m); err != nil {
+			log.WithError(err).Error("Error marking mail as read")
+		}
+	}
+}
+
+func (command *MailCommand) send(game def.GameCtrl, message *messages.Message, args []string) {
+	if len(args) < 2 {
+		game.SendMessage() <- message.Reply(mailUsage)
+		return
+	}
+
+	recipient := findCharacterByName(game, args[0])
+	if recipient == nil {
+		game.SendMessage() <- message.Reply("There is no character named '" + args[0] + "'.")
+		return
+	}
+	if recipient.ID == message.Character.ID {
+		game.SendMessage() <- message.Reply("You can't send mail to yourself.")
+		return
+	}
+
+	body, gold, itemNames, usage := parseMailArgs(args[1:])
+	if usage != "" {
+		game.SendMessage() <- message.Reply(usage)
+		return
+	}
+	if body == "" {
+		game.SendMessage() <- message.Reply("Your mail needs some text.")
+		return
+	}
+	if len(itemNames) > mail.MaxAttachments {
+		game.SendMessage() <- message.Reply("You can attach at most " + strconv.Itoa(mail.MaxAttachments) + " items.")
+		return
+	}
+
+	// escrow the attachments from a copy of the sender, stored only once the mail exists
+	sender := *message.Character
+	sender.Inventory = copyInventory(message.Character.Inventory)
+	if gold > sender.Gold {
+		game.SendMessage() <- message.Reply("You don't have " + strconv.FormatInt(gold, 10) + " gold.")
+		return
+	}
+	sender.Gold -= gold
+
+	attached := make(items.Items, 0, len(itemNames))
+	for _, name := range itemNames {
+		item := sender.Inventory.FindItemByName(name)
+		if item == nil {
+			item = sender.Inventory.FindItemByTargetName(name)
+		}
+		if item == nil {
+			game.SendMessage() <- message.Reply("You don't have a '" + name + "' in your inventory.")
+			return
+		}
+		if !isTradeable(item) {
+			game.SendMessage() <- message.Reply(item.Name + " can't be sent.")
+			return
+		}
+		if _, err := sender.Inventory.RemoveItem(item.ID); err != nil {
+			game.SendMessage() <- message.Reply("You don't have a '" + name + "' in your inventory.")
+			return
+		}
+		attached = append(attached, item)
+	}
+
+	m, storeErr := game.GetFacade().MailService().Store(&mail.Mail{
+		FromCharacterID: sender.ID,
+		FromName:        sender.Name,
+		ToCharacterID:   recipient.ID,
+		ToName:          recipient.Name,
+		Body:            body,
+		Sent:            time.Now(),
+		Gold:            gold,
+		Items:           attached,
+	})
+	if storeErr != nil {
+		log.WithError(storeErr).Error("Error storing mail")
+		game.SendMessage() <- message.Reply("Your mail could not be sent.")
+		return
+	}
+
+	if gold > 0 || len(attached) > 0 {
+		if err := game.GetFacade().CharactersService().Update(sender.ID, &sender); err != nil {
+			log.WithError(err).Error("Error updating mail sender, removing the mail")
+			game.GetFacade().MailService().Delete(m.ID)
+			game.SendMessage() <- message.Reply("Your mail could not be sent.")
+			return
+		}
+		*message.Character = sender
+		if inv := messages.NewInventoryUpdateMessage(message); inv != nil {
+			game.SendMessage() <- inv
+		}
+	}
+
+	game.SendMessage() <- message.Reply("You send a mail to " + recipient.Name + ".")
+	if isCharacterOnline(game, recipient) {
+		game.SendMessage() <- messages.Reply(recipient.BelongsUserID, "You have new mail from "+sender.Name+". Use 'mail read' to read it.")
+	}
+}
+
+func (command *MailCommand) collect(game def.GameCtrl, message *messages.Message, args []string) {
+	room, err := game.GetFacade().RoomsService().FindByID(message.Character.CurrentRoomID)
+	if err != nil || !room.HasTag(rooms.TagPostOffice) {
+		game.SendMessage() <- message.Reply("You can only collect attachments at a post office.")
+		return
+	}
+
+	m := findMail(game, message, args)
+	if m == nil {
+		return
+	}
+	if !m.HasAttachments() {
+		game.SendMessage() <- message.Reply("That mail has no attachments.")
+		return
+	}
+
+	character := *message.Character
+	character.Inventory = copyInventory(message.Character.Inventory)
+
+	original := *m
+	gold, attached := m.TakeAttachments()
+	for _, item := range attached {
+		if err := character.Inventory.AddItem(item); err != nil {
+			game.SendMessage() <- message.Reply("You don't have enough room in your inventory.")
+			return
+		}
+	}
+	character.Gold += gold
+
+	// take the attachments out of the mail first so they can't be collected twice
+	if err := game.GetFacade().MailService().Update(m.ID, m); err != nil {
+		log.WithError(err).Error("Error updating mail")
+		game.SendMessage() <- message.Reply("The attachments could not be collected.")
+		return
+	}
+	if err := game.GetFacade().CharactersService().Update(character.ID, &character); err != nil {
+		log.WithError(err).Error("Error updating character, restoring mail attachments")
+		game.GetFacade().MailService().Update(original.ID, &original)
+		game.SendMessage() <- message.Reply("The attachments could not be collected.")
+		return
+	}
+	*message.Character = character
+
+	var collected []string
+	if gold > 0 {
+		collected = append(collected, strconv.FormatInt(gold, 10)+" gold")
+	}
+	for _, item := range attached {
+		collected = append(collected, item.Name)
+	}
+	game.SendMessage() <- message.Reply("You collect " + strings.Join(collected, ", ") + " from the mail of " + m.FromName + ".")
+	if inv := messages.NewInventoryUpdateMessage(message); inv != nil {
+		game.SendMessage() <- inv
+	}
+}
+
+func (command *MailCommand) delete(game def.GameCtrl, message *messages.Message, args []string) {
+	m := findMail(game, message, args)
+	if m == nil {
+		return
+	}
+	if m.HasAttachments() {
+		game.SendMessage() <- message.Reply("Collect the attachments at a post office before deleting this mail.")
+		return
+	}
+	if err := game.GetFacade().MailService().Delete(m.ID); err != nil {
+		log.WithError(err).Error("Error deleting mail")
+		game.SendMessage() <- message.Reply("The mail could not be deleted.")
+		return
+	}
+	game.SendMessage() <- message.Reply("Mail from " + m.FromName + " deleted.")
+}
+
+// findMail returns the mail with the number shown by "mail list", replies if there is none
+func findMail(game def.GameCtrl, message *messages.Message, args []string) *mail.Mail {
+	if len(args) < 1 {
+		game.SendMessage() <- message.Reply(mailUsage)
+		return nil
+	}
+	number, err := strconv.Atoi(strings.TrimPrefix(args[0], "#"))
+	if err != nil {
+		game.SendMessage() <- message.Reply("Use the mail number shown by 'mail list'.")
+		return nil
+	}
+
+	inbox, err := game.GetFacade().MailService().FindInbox(message.Character.ID)
+	if err != nil || number < 1 || number > len(inbox) {
+		game.SendMessage() <- message.Reply("There is no mail #" + strconv.Itoa(number) + ".")
+		return nil
+	}
+	return inbox[number-1]
+}
+
+// firstUnreadMail returns the oldest unread mail, replies if there is none
+func firstUnreadMail(game def.GameCtrl, message *messages.Message) *mail.Mail {
+	inbox, err := game.GetFacade().MailService().FindInbox(message.Character.ID)
+	if err == nil {
+		for _, m := range inbox {
+			if !m.Read {
+				return m
+			}
+		}
+	}
+	game.SendMessage() <- message.Reply("You have no unread mail.")
+	return nil
+}
+
+// findCharacterByName finds any character by name, online or not
+func findCharacterByName(game def.GameCtrl, name string) *characters.Character {
+	if found, err := game.GetFacade().CharactersService().FindByName(name); err == nil && len(found) > 0 {
+		return found[0]
+	}
+	all, err := game.GetFacade().CharactersService().FindAll()
+	if err != nil {
+		return nil
+	}
+	for _, character := range all {
+		if strings.EqualFold(character.Name, name) {
+			return character
+		}
+	}
+	return nil
+}
+
+// parseMailArgs splits "text +gold 10 +item rusty dagger" into the text, the gold and the item names,
+// the last value is an error reply for invalid attachments
+func parseMailArgs(args []string) (string, int64, []string, string) {
+	var body []string
+	var gold int64
+	var itemNames []string
+
+	for i := 0; i < len(args); i++ {
+		switch strings.ToLower(args[i]) {
+		case "+gold":
+			if i+1 >= len(args) {
+				return "", 0, nil, "Usage: +gold <amount>"
+			}
+			amount, err := strconv.ParseInt(args[i+1], 10, 64)
+			if err != nil || amount <= 0 {
+				return "", 0, nil, "Invalid gold amount: " + args[i+1]
+			}
+			gold += amount
+			i++
+		case "+item":
+			// the item name runs until the next attachment
+			name := []string{}
+			for i+1 < len(args) && !strings.HasPrefix(args[i+1], "+") {
+				name = append(name, args[i+1])
+				i++
+			}
+			if len(name) == 0 {
+				return "", 0, nil, "Usage: +item <item>"
+			}
+			itemNames = append(itemNames, strings.Join(name, " "))
+		default:
+			body = append(body, args[i])
+		}
+	}
+	return strings.Join(body, " "), gold, itemNames, ""
+}
@@ -5,8 +5,10 @@ import (
 
 	log "github.com/sirupsen/logrus"
 
+	"github.com/talesmud/talesmud/pkg/entities/quests"
 	"github.com/talesmud/talesmud/pkg/mudserver/game/def"
 	"github.com/talesmud/talesmud/pkg/mudserver/game/messages"
+	"github.com/talesmud/talesmud/pkg/scripts/events"
 )
 
 // PickupCommand handles picking up items from the room
@@ -37,6 +39,11 @@ func (command *PickupCommand) Execute(game def.GameCtrl, message *messages.Messa
 
 	itemName := strings.Join(parts[1:], " ")
 
+	// "get gem from chest" takes the item out of a container
+	if name, containerName, ok := splitContainerArgs(itemName, "from"); ok {
+		return getFromContainer(game, message, name, containerName)
+	}
+
 	// Get current room
 	room, err := game.GetFacade().RoomsService().FindByID(message.Character.CurrentRoomID)
 	if err != nil {
@@ -129,6 +136,21 @@ func (command *PickupCommand) Execute(game def.GameCtrl, message *messages.Messa
 		return true
 	}
 
+	// Event scripts may prevent the pickup
+	if game.DispatchEvent(events.NewEventContext(events.EventItemPickup).
+		WithCharacter(message.Character).
+		WithRoom(room).
+		WithItem(item)) {
+		game.SendMessage() <- message.Reply("You can't pick up " + item.Name + " right now.")
+		return true
+	}
+
+	// Remember the picked up amount, stacking may change the item quantity
+	quantity := int32(1)
+	if item.Stackable && item.Quantity > 1 {
+		quantity = int32(item.Quantity)
+	}
+
 	// Add item to character inventory
 	err = message.Character.Inventory.AddItem(item)
 	if err != nil {
@@ -168,5 +190,7 @@ func (command *PickupCommand) Execute(game def.GameCtrl, message *messages.Messa
 		game.SendMessage() <- inv
 	}
 
+	UpdateQuestProgress(game, message.Character, quests.ObjectiveTypeCollect, quantity, item.TemplateID, item.ID)
+
 	return true
 }
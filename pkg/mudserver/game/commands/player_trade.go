@@ -0,0 +1,360 @@
+package commands
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/talesmud/talesmud/pkg/entities/characters"
+	"github.com/talesmud/talesmud/pkg/entities/items"
+	"github.com/talesmud/talesmud/pkg/entities/trades"
+	"github.com/talesmud/talesmud/pkg/mudserver/game/def"
+	"github.com/talesmud/talesmud/pkg/mudserver/game/messages"
+)
+
+const tradeUsage = "Usage: trade <player> | trade offer <item> | trade gold <amount> | trade remove <item> | trade confirm | trade cancel"
+
+// untradeableTags mark items that can't be given to other players
+var untradeableTags = []string{"soulbound", "quest"}
+
+// TradeCommand handles trades between players
+type TradeCommand struct {
+}
+
+// Key returns the command key matcher
+func (command *TradeCommand) Key() CommandKey { return &StartsWithCommandKey{} }
+
+// Execute handles the trade command
+func (command *TradeCommand) Execute(game def.GameCtrl, message *messages.Message) bool {
+	if message.Character == nil {
+		game.SendMessage() <- message.Reply("You need to select a character first.")
+		return true
+	}
+
+	parts := strings.Fields(message.Data)
+	if len(parts) < 2 {
+		command.show(game, message)
+		return true
+	}
+
+	args := strings.Join(parts[2:], " ")
+
+	switch strings.ToLower(parts[1]) {
+	case "offer", "add":
+		command.offer(game, message, args)
+	case "remove":
+		command.remove(game, message, args)
+	case "gold":
+		command.gold(game, message, args)
+	case "confirm", "accept":
+		command.confirm(game, message)
+	case "cancel", "decline":
+		if !game.GetTradeManager().CancelTrade(message.Character.ID, message.Character.Name+" canceled it") {
+			game.SendMessage() <- message.Reply("You are not trading with anyone.")
+		}
+	default:
+		command.request(game, message, strings.Join(parts[1:], " "))
+	}
+	return true
+}
+
+// show displays the open trade window or the pending request
+func (command *TradeCommand) show(game def.GameCtrl, message *messages.Message) {
+	trade := game.GetTradeManager().FindTrade(message.Character.ID)
+	if trade == nil {
+		game.SendMessage() <- message.Reply("You are not trading with anyone.\n" + tradeUsage)
+		return
+	}
+	if !trade.Open {
+		if trade.Initiator.CharacterID == message.Character.ID {
+			game.SendMessage() <- message.Reply("You are waiting for " + trade.Partner.Name + " to accept your trade request.")
+		} else {
+			game.SendMessage() <- message.Reply(trade.Initiator.Name + " wants to trade with you. Use 'trade " + trade.Initiator.Name + "' to accept or 'trade cancel' to decline.")
+		}
+		return
+	}
+	if window := messages.NewTradeWindowMessage(trade, message.Character.ID); window != nil {
+		game.SendMessage() <- window
+	}
+}
+
+// request asks another player in the room to trade or accepts their pending request
+func (command *TradeCommand) request(game def.GameCtrl, message *messages.Message, name string) {
+	tradeManager := game.GetTradeManager()
+
+	if pending := tradeManager.FindTrade(message.Character.ID); pending != nil {
+		if !pending.Open && pending.Partner.CharacterID == message.Character.ID && strings.EqualFold(pending.Initiator.Name, name) {
+			trade := tradeManager.UpdateTrade(message.Character.ID, func(t *trades.Trade) {
+				t.Open = true
+			})
+			if trade != nil {
+				sendTradeWindows(game, trade)
+			}
+			return
+		}
+		game.SendMessage() <- message.Reply("You are already trading with " + pending.OtherSide(message.Character.ID).Name + ". Use 'trade cancel' first.")
+		return
+	}
+
+	target := findOnlineCharacter(game, name)
+	if target == nil || target.CurrentRoomID != message.Character.CurrentRoomID {
+		game.SendMessage() <- message.Reply("There is no player named '" + name + "' here.")
+		return
+	}
+	if target.ID == message.Character.ID {
+		game.SendMessage() <- message.Reply("You can't trade with yourself.")
+		return
+	}
+
+	combatEngine := game.GetCombatEngine()
+	if message.Character.InCombat || (combatEngine != nil && combatEngine.IsPlayerInCombat(message.Character.ID)) {
+		game.SendMessage() <- message.Reply("You can't trade while in combat.")
+		return
+	}
+	if target.InCombat || (combatEngine != nil && combatEngine.IsPlayerInCombat(target.ID)) {
+		game.SendMessage() <- message.Reply(target.Name + " is in combat.")
+		return
+	}
+
+	trade := trades.NewTrade(message.Character.CurrentRoomID,
+		trades.TradeSide{CharacterID: message.Character.ID, UserID: message.Character.BelongsUserID, Name: message.Character.Name},
+		trades.TradeSide{CharacterID: target.ID, UserID: target.BelongsUserID, Name: target.Name})
+	if err := tradeManager.StartTrade(trade); err != nil {
+		game.SendMessage() <- message.Reply("You can't trade now: " + err.Error() + ".")
+		return
+	}
+
+	game.SendMessage() <- message.Reply("You ask " + target.Name + " to trade.")
+	game.SendMessage() <- messages.Reply(target.BelongsUserID,
+		message.Character.Name+" wants to trade with you. Use 'trade "+message.Character.Name+"' to accept or 'trade cancel' to decline.")
+}
+
+// offer adds an inventory item to the offer of the character
+func (command *TradeCommand) offer(game def.GameCtrl, message *messages.Message, name string) {
+	if name == "" {
+		game.SendMessage() <- message.Reply("Offer what? Usage: trade offer <item>")
+		return
+	}
+
+	item := message.Character.Inventory.FindItemByName(name)
+	if item == nil {
+		item = message.Character.Inventory.FindItemByTargetName(name)
+	}
+	if item == nil {
+		game.SendMessage() <- message.Reply("You don't have a '" + name + "' in your inventory.")
+		return
+	}
+	if !isTradeable(item) {
+		game.SendMessage() <- message.Reply("You can't trade " + item.Name + ".")
+		return
+	}
+
+	var offerErr error
+	command.updateOpenTrade(game, message, func(t *trades.Trade) {
+		offerErr = t.AddItem(message.Character.ID, trades.TradeItem{ItemID: item.ID, Name: item.Name, Quantity: item.Quantity})
+	})
+	if offerErr != nil {
+		game.SendMessage() <- message.Reply("You can't offer " + item.Name + ": " + offerErr.Error() + ".")
+	}
+}
+
+// remove takes an item back from the offer of the character
+func (command *TradeCommand) remove(game def.GameCtrl, message *messages.Message, name string) {
+	if name == "" {
+		game.SendMessage() <- message.Reply("Remove what? Usage: trade remove <item>")
+		return
+	}
+
+	removed := false
+	trade := command.updateOpenTrade(game, message, func(t *trades.Trade) {
+		removed = t.RemoveItem(message.Character.ID, name) != nil
+	})
+	if trade != nil && !removed {
+		game.SendMessage() <- message.Reply("You don't offer a '" + name + "'.")
+	}
+}
+
+// gold sets the gold offered by the character
+func (command *TradeCommand) gold(game def.GameCtrl, message *messages.Message, amount string) {
+	gold, err := strconv.ParseInt(strings.TrimSuffix(strings.TrimSpace(amount), " gold"), 10, 64)
+	if err != nil || gold < 0 {
+		game.SendMessage() <- message.Reply("Usage: trade gold <amount>")
+		return
+	}
+	if gold > message.Character.Gold {
+		game.SendMessage() <- message.Reply("You only have " + itoa64(message.Character.Gold) + " gold.")
+		return
+	}
+
+	command.updateOpenTrade(game, message, func(t *trades.Trade) {
+		t.SetGold(message.Character.ID, gold)
+	})
+}
+
+// confirm agrees to the current offers, the exchange happens once both sides confirmed
+func (command *TradeCommand) confirm(game def.GameCtrl, message *messages.Message) {
+	bothConfirmed := false
+	trade := command.updateOpenTrade(game, message, func(t *trades.Trade) {
+		bothConfirmed = t.Confirm(message.Character.ID)
+	})
+	if trade == nil || !bothConfirmed {
+		return
+	}
+
+	// closing the trade first makes sure it is exchanged only once
+	if !game.GetTradeManager().CloseTrade(trade.ID) {
+		return
+	}
+
+	initiator, partner, err := completeTrade(game, trade)
+	if err != nil {
+		log.WithError(err).WithField("trade", trade.ID).Info("Trade failed")
+		text := "The trade between " + trade.Initiator.Name + " and " + trade.Partner.Name + " failed: " + err.Error() + "."
+		game.SendMessage() <- messages.NewTradeEndMessage(trade.Initiator.UserID, text)
+		game.SendMessage() <- messages.NewTradeEndMessage(trade.Partner.UserID, text)
+		return
+	}
+
+	game.SendMessage() <- messages.NewTradeEndMessage(trade.Initiator.UserID, "You complete the trade with "+trade.Partner.Name+".")
+	game.SendMessage() <- messages.NewTradeEndMessage(trade.Partner.UserID, "You complete the trade with "+trade.Initiator.Name+".")
+	for _, character := range []*characters.Character{initiator, partner} {
+		game.SendMessage() <- &messages.InventoryUpdateMessage{
+			MessageResponse: messages.MessageResponse{
+				Audience:   messages.MessageAudienceUser,
+				AudienceID: character.BelongsUserID,
+				Type:       messages.MessageTypeInventoryUpdate,
+			},
+			Inventory:     character.Inventory,
+			EquippedItems: character.EquippedItems,
+			Gold:          character.Gold,
+		}
+	}
+}
+
+// updateOpenTrade changes the open trade of the character and shows the new state to both sides
+func (command *TradeCommand) updateOpenTrade(game def.GameCtrl, message *messages.Message, updater func(*trades.Trade)) *trades.Trade {
+	trade := game.GetTradeManager().FindTrade(message.Character.ID)
+	if trade == nil || !trade.Open {
+		game.SendMessage() <- message.Reply("You are not trading with anyone.")
+		return nil
+	}
+
+	trade = game.GetTradeManager().UpdateTrade(message.Character.ID, func(t *trades.Trade) {
+		if t.Open {
+			updater(t)
+		}
+	})
+	if trade != nil {
+		sendTradeWindows(game, trade)
+	}
+	return trade
+}
+
+// sendTradeWindows sends the trade window to both players
+func sendTradeWindows(game def.GameCtrl, trade *trades.Trade) {
+	for _, characterID := range []string{trade.Initiator.CharacterID, trade.Partner.CharacterID} {
+		if window := messages.NewTradeWindowMessage(trade, characterID); window != nil {
+			game.SendMessage() <- window
+		}
+	}
+}
+
+// completeTrade exchanges the offered items and gold, both characters are reloaded and checked first
+// so either the whole exchange is stored or nothing changes
+func completeTrade(game def.GameCtrl, trade *trades.Trade) (*characters.Character, *characters.Character, error) {
+	charactersService := game.GetFacade().CharactersService()
+
+	initiator, err := charactersService.FindByID(trade.Initiator.CharacterID)
+	if err != nil || initiator == nil {
+		return nil, nil, errors.New(trade.Initiator.Name + " is gone")
+	}
+	partner, err := charactersService.FindByID(trade.Partner.CharacterID)
+	if err != nil || partner == nil {
+		return nil, nil, errors.New(trade.Partner.Name + " is gone")
+	}
+	for _, character := range []*characters.Character{initiator, partner} {
+		if character.CurrentRoomID != trade.RoomID || !isCharacterOnline(game, character) {
+			return nil, nil, errors.New(character.Name + " is not here anymore")
+		}
+	}
+
+	// exchange on copies of the inventories, the characters are only changed if everything fits
+	initiatorInventory := copyInventory(initiator.Inventory)
+	partnerInventory := copyInventory(partner.Inventory)
+	if err := moveTradeOffer(&trade.Initiator, initiator, &initiatorInventory, &partnerInventory); err != nil {
+		return nil, nil, err
+	}
+	if err := moveTradeOffer(&trade.Partner, partner, &partnerInventory, &initiatorInventory); err != nil {
+		return nil, nil, err
+	}
+
+	original := *initiator
+	initiator.Inventory = initiatorInventory
+	initiator.Gold += trade.Partner.Gold - trade.Initiator.Gold
+	partner.Inventory = partnerInventory
+	partner.Gold += trade.Initiator.Gold - trade.Partner.Gold
+
+	if err := charactersService.Update(initiator.ID, initiator); err != nil {
+		return nil, nil, errors.New("the exchange could not be saved")
+	}
+	if err := charactersService.Update(partner.ID, partner); err != nil {
+		// revert the first half so no items or gold are duplicated
+		if revertErr := charactersService.Update(original.ID, &original); revertErr != nil {
+			log.WithError(revertErr).WithField("trade", trade.ID).Error("Could not revert failed trade")
+		}
+		return nil, nil, errors.New("the exchange could not be saved")
+	}
+
+	log.WithFields(log.Fields{
+		"trade":     trade.ID,
+		"initiator": initiator.Name,
+		"partner":   partner.Name,
+	}).Info("Trade completed")
+	return initiator, partner, nil
+}
+
+// moveTradeOffer moves the offered items from one inventory to the other and checks the offered gold
+func moveTradeOffer(side *trades.TradeSide, owner *characters.Character, from *items.Inventory, to *items.Inventory) error {
+	if side.Gold > owner.Gold {
+		return errors.New(owner.Name + " doesn't have " + itoa64(side.Gold) + " gold")
+	}
+	for _, offered := range side.Items {
+		item, err := from.RemoveItem(offered.ItemID)
+		if err != nil {
+			return errors.New(owner.Name + " doesn't have " + offered.Name + " anymore")
+		}
+		if err := to.AddItem(item); err != nil {
+			return errors.New("there is no room for " + offered.Name)
+		}
+	}
+	return nil
+}
+
+// copyInventory copies the inventory and its items so they can be changed without touching the original
+func copyInventory(inventory items.Inventory) items.Inventory {
+	copied := items.Inventory{
+		Size:  inventory.Size,
+		Items: make([]*items.Item, 0, len(inventory.Items)),
+	}
+	for _, item := range inventory.Items {
+		itemCopy := *item
+		copied.Items = append(copied.Items, &itemCopy)
+	}
+	return copied
+}
+
+// isTradeable returns false for quest items and items bound to their owner
+func isTradeable(item *items.Item) bool {
+	if item.Type == items.ItemTypeQuest {
+		return false
+	}
+	for _, tag := range item.Tags {
+		for _, untradeable := range untradeableTags {
+			if strings.EqualFold(tag, untradeable) {
+				return false
+			}
+		}
+	}
+	return true
+}
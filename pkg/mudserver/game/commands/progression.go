@@ -0,0 +1,31 @@
+package commands
+
+import (
+	"github.com/talesmud/talesmud/pkg/entities/characters"
+	"github.com/talesmud/talesmud/pkg/mudserver/game/def"
+	"github.com/talesmud/talesmud/pkg/mudserver/game/messages"
+	"github.com/talesmud/talesmud/pkg/scripts/events"
+)
+
+// NotifyLevelUps sends a level-up message to the player and dispatches the level-up event for each new level
+// Call after the character has been persisted
+func NotifyLevelUps(game def.GameCtrl, character *characters.Character, levelUps []characters.LevelUpRecord) {
+	if len(levelUps) == 0 {
+		return
+	}
+
+	curve := game.GetFacade().CharactersService().ProgressionCurve()
+	for _, levelUp := range levelUps {
+		nextLevelXP := int32(0)
+		if !curve.IsMaxLevel(levelUp.Level) {
+			nextLevelXP = curve.XPForLevel(levelUp.Level + 1)
+		}
+		game.SendMessage() <- messages.NewLevelUpMessage(character, levelUp, nextLevelXP)
+
+		game.DispatchEvent(events.NewEventContext(events.EventPlayerLevelUp).
+			WithCharacter(character).
+			Set("level", int(levelUp.Level)).
+			Set("previousLevel", int(levelUp.Level-1)).
+			Set("source", levelUp.Source))
+	}
+}
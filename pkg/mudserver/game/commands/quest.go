@@ -0,0 +1,371 @@
+package commands
+
+import (
+	"fmt"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/talesmud/talesmud/pkg/entities/characters"
+	"github.com/talesmud/talesmud/pkg/entities/items"
+	"github.com/talesmud/talesmud/pkg/entities/quests"
+	"github.com/talesmud/talesmud/pkg/mudserver/game/def"
+	"github.com/talesmud/talesmud/pkg/mudserver/game/messages"
+	"github.com/talesmud/talesmud/pkg/scripts/events"
+)
+
+// QuestCommand lists, accepts and abandons quests
+type QuestCommand struct {
+}
+
+// Key returns the command key matcher
+func (command *QuestCommand) Key() CommandKey { return &StartsWithCommandKey{} }
+
+// Execute handles the quest command
+// Usage: quest [list] | quest info <name> | quest accept <name> | quest abandon <name>
+func (command *QuestCommand) Execute(game def.GameCtrl, message *messages.Message) bool {
+	if message.Character == nil {
+		game.SendMessage() <- message.Reply("You need to select a character first.")
+		return true
+	}
+
+	parts := strings.Fields(message.Data)
+	if len(parts) < 2 || strings.EqualFold(parts[1], "list") {
+		command.list(game, message)
+		return true
+	}
+
+	name := strings.Join(parts[2:], " ")
+	if name == "" {
+		game.SendMessage() <- message.Reply("Usage: quest [list] | quest info <name> | quest accept <name> | quest abandon <name>")
+		return true
+	}
+
+	switch strings.ToLower(parts[1]) {
+	case "info", "show":
+		command.info(game, message, name)
+	case "accept", "take", "start":
+		command.accept(game, message, name)
+	case "abandon", "drop", "cancel":
+		command.abandon(game, message, name)
+	default:
+		game.SendMessage() <- message.Reply("Usage: quest [list] | quest info <name> | quest accept <name> | quest abandon <name>")
+	}
+	return true
+}
+
+// list shows all quests offered by NPCs in the current room
+func (command *QuestCommand) list(game def.GameCtrl, message *messages.Message) {
+	offered := findOfferedQuests(game, message.Character)
+	if len(offered) == 0 {
+		game.SendMessage() <- message.Reply("Nobody here has a quest for you.")
+		return
+	}
+
+	var sb strings.Builder
+	sb.WriteString("Quests offered here:\n")
+	for _, quest := range offered {
+		status := "available"
+		if err := game.GetFacade().QuestsService().CanAccept(message.Character, quest); err != nil {
+			status = questStatus(message.Character, quest)
+		}
+		sb.WriteString(fmt.Sprintf("  %s [%s]\n", quest.Name, status))
+	}
+	sb.WriteString("Use 'quest info <name>' for details and 'quest accept <name>' to accept a quest.")
+
+	game.SendMessage() <- message.Reply(sb.String())
+}
+
+// info shows the details of an offered or accepted quest
+func (command *QuestCommand) info(game def.GameCtrl, message *messages.Message, name string) {
+	quest := findQuestByName(findOfferedQuests(game, message.Character), name)
+	if quest == nil {
+		quest = findQuestByName(findQuestLogQuests(game, message.Character), name)
+	}
+	if quest == nil {
+		game.SendMessage() <- message.Reply("You don't know a quest named '" + name + "'.")
+		return
+	}
+
+	game.SendMessage() <- message.Reply(describeQuest(message.Character, quest))
+}
+
+// accept adds an offered quest to the quest log
+func (command *QuestCommand) accept(game def.GameCtrl, message *messages.Message, name string) {
+	quest := findQuestByName(findOfferedQuests(game, message.Character), name)
+	if quest == nil {
+		game.SendMessage() <- message.Reply("Nobody here offers a quest named '" + name + "'.")
+		return
+	}
+
+	if err := game.GetFacade().QuestsService().Accept(message.Character, quest); err != nil {
+		game.SendMessage() <- message.Reply("You can't accept " + quest.Name + ": " + err.Error() + ".")
+		return
+	}
+
+	if err := game.GetFacade().CharactersService().Update(message.Character.ID, message.Character); err != nil {
+		log.WithError(err).Error("Error updating character")
+	}
+
+	game.SendMessage() <- message.Reply("Quest accepted: " + quest.Name + "\n" + describeQuest(message.Character, quest))
+
+	game.DispatchEvent(events.NewEventContext(events.EventQuestStart).
+		WithCharacter(message.Character).
+		WithQuest(quest.ID, "", 0))
+}
+
+// abandon removes an active quest from the quest log
+func (command *QuestCommand) abandon(game def.GameCtrl, message *messages.Message, name string) {
+	quest := findQuestByName(findQuestLogQuests(game, message.Character), name)
+	if quest == nil {
+		game.SendMessage() <- message.Reply("You are not on a quest named '" + name + "'.")
+		return
+	}
+
+	if err := game.GetFacade().QuestsService().Abandon(message.Character, quest.ID); err != nil {
+		game.SendMessage() <- message.Reply("You can't abandon " + quest.Name + ": " + err.Error() + ".")
+		return
+	}
+
+	if err := game.GetFacade().CharactersService().Update(message.Character.ID, message.Character); err != nil {
+		log.WithError(err).Error("Error updating character")
+	}
+
+	game.SendMessage() <- message.Reply("You abandon the quest " + quest.Name + ".")
+
+	game.DispatchEvent(events.NewEventContext(events.EventQuestFail).
+		WithCharacter(message.Character).
+		WithQuest(quest.ID, "", 0).
+		Set("reason", "abandoned"))
+}
+
+// JournalCommand shows the quest log of the character
+type JournalCommand struct {
+}
+
+// Key returns the command key matcher
+func (command *JournalCommand) Key() CommandKey { return &ExactCommandKey{} }
+
+// Execute handles the journal command
+func (command *JournalCommand) Execute(game def.GameCtrl, message *messages.Message) bool {
+	if message.Character == nil {
+		game.SendMessage() <- message.Reply("You need to select a character first.")
+		return true
+	}
+
+	active := message.Character.QuestLog.Active()
+	completed := message.Character.QuestLog.Completed()
+
+	if len(active) == 0 && len(completed) == 0 {
+		game.SendMessage() <- message.Reply("Your journal is empty.")
+		return true
+	}
+
+	var sb strings.Builder
+	sb.WriteString("=== Journal ===\n")
+
+	if len(active) == 0 {
+		sb.WriteString("You have no active quests.\n")
+	}
+	for _, progress := range active {
+		quest, err := game.GetFacade().QuestsService().FindByID(progress.QuestID)
+		if err != nil || quest == nil {
+			continue
+		}
+		sb.WriteString("\n[" + quest.Name + "]\n")
+		writeObjectives(&sb, progress, quest)
+	}
+
+	if len(completed) > 0 {
+		sb.WriteString(fmt.Sprintf("\nCompleted quests: %d", len(completed)))
+	}
+
+	game.SendMessage() <- message.Reply(sb.String())
+	return true
+}
+
+// UpdateQuestProgress advances matching quest objectives of the character, persists the character
+// and notifies the player about progress and completed quests
+func UpdateQuestProgress(game def.GameCtrl, character *characters.Character, objectiveType quests.ObjectiveType, amount int32, targetIDs ...string) {
+	if character == nil || len(character.QuestLog.Active()) == 0 {
+		return
+	}
+
+	updates := game.GetFacade().QuestsService().Progress(character, objectiveType, amount, targetIDs...)
+	if len(updates) == 0 {
+		return
+	}
+
+	if err := game.GetFacade().CharactersService().Update(character.ID, character); err != nil {
+		log.WithError(err).Error("Error updating character")
+	}
+
+	for _, update := range updates {
+		objective := update.Objective.Description
+		if objective == "" {
+			objective = string(update.Objective.Type) + " " + update.Objective.TargetID
+		}
+		game.SendMessage() <- messages.Reply(character.BelongsUserID,
+			fmt.Sprintf("[%s] %s (%d/%d)", update.Quest.Name, objective, update.Count, update.Objective.RequiredCount()))
+
+		game.DispatchEvent(events.NewEventContext(events.EventQuestProgress).
+			WithCharacter(character).
+			WithQuest(update.Quest.ID, update.Objective.ID, int(update.Count)))
+
+		if !update.Completed {
+			continue
+		}
+
+		var sb strings.Builder
+		sb.WriteString("Quest completed: " + update.Quest.Name)
+		if update.Quest.Rewards.XP > 0 {
+			sb.WriteString(fmt.Sprintf("\n  +%d XP", update.Quest.Rewards.XP))
+		}
+		if update.Quest.Rewards.Gold > 0 {
+			sb.WriteString(fmt.Sprintf("\n  +%d gold", update.Quest.Rewards.Gold))
+		}
+		for _, item := range update.RewardItems {
+			sb.WriteString("\n  " + item.Name)
+		}
+		for _, item := range dropRewardItems(game, character, update.DroppedItems) {
+			sb.WriteString("\n  " + item.Name + " (your inventory is full, it falls to the ground)")
+		}
+		game.SendMessage() <- messages.Reply(character.BelongsUserID, sb.String())
+
+		game.DispatchEvent(events.NewEventContext(events.EventQuestComplete).
+			WithCharacter(character).
+			WithQuest(update.Quest.ID, "", 0))
+
+		NotifyLevelUps(game, character, update.LevelUps)
+	}
+}
+
+// dropRewardItems puts reward items that didn't fit into the inventory into the character's room
+func dropRewardItems(game def.GameCtrl, character *characters.Character, dropped []*items.Item) []*items.Item {
+	if len(dropped) == 0 {
+		return nil
+	}
+	room, err := game.GetFacade().RoomsService().FindByID(character.CurrentRoomID)
+	if err != nil || room == nil {
+		log.WithError(err).WithField("room", character.CurrentRoomID).Error("Could not drop quest reward items")
+		return nil
+	}
+
+	result := make([]*items.Item, 0, len(dropped))
+	for _, item := range dropped {
+		if err := room.AddItem(item.ID); err != nil {
+			log.WithError(err).WithField("item", item.ID).Error("Error adding quest reward item to room")
+			continue
+		}
+		result = append(result, item)
+	}
+	if err := game.GetFacade().RoomsService().Update(room.ID, room); err != nil {
+		log.WithError(err).Error("Error updating room")
+	}
+	return result
+}
+
+// findOfferedQuests returns all quests offered by NPCs in the character's room
+func findOfferedQuests(game def.GameCtrl, character *characters.Character) []*quests.Quest {
+	npcIDs := make([]string, 0)
+	for _, n := range game.GetNPCInstanceManager().GetInstancesInRoom(character.CurrentRoomID) {
+		npcIDs = append(npcIDs, n.Entity.ID)
+		if n.TemplateID != "" {
+			npcIDs = append(npcIDs, n.TemplateID)
+		}
+	}
+	if len(npcIDs) == 0 {
+		return nil
+	}
+
+	offered, err := game.GetFacade().QuestsService().FindByGiver(npcIDs...)
+	if err != nil {
+		log.WithError(err).Error("Error loading quests")
+		return nil
+	}
+	return offered
+}
+
+// findQuestLogQuests returns all active quests of the character
+func findQuestLogQuests(game def.GameCtrl, character *characters.Character) []*quests.Quest {
+	result := make([]*quests.Quest, 0)
+	for _, progress := range character.QuestLog.Active() {
+		if quest, err := game.GetFacade().QuestsService().FindByID(progress.QuestID); err == nil && quest != nil {
+			result = append(result, quest)
+		}
+	}
+	return result
+}
+
+// findQuestByName finds a quest by exact or prefix name match
+func findQuestByName(candidates []*quests.Quest, name string) *quests.Quest {
+	nameLower := strings.ToLower(name)
+	var prefixMatch *quests.Quest
+	for _, quest := range candidates {
+		questName := strings.ToLower(quest.Name)
+		if questName == nameLower {
+			return quest
+		}
+		if prefixMatch == nil && strings.HasPrefix(questName, nameLower) {
+			prefixMatch = quest
+		}
+	}
+	return prefixMatch
+}
+
+// questStatus returns the quest state for display
+func questStatus(character *characters.Character, quest *quests.Quest) string {
+	if progress := character.QuestLog.Find(quest.ID); progress != nil {
+		return string(progress.State)
+	}
+	return "unavailable"
+}
+
+// describeQuest builds the quest details including objective progress
+func describeQuest(character *characters.Character, quest *quests.Quest) string {
+	var sb strings.Builder
+	sb.WriteString("[" + quest.Name + "]")
+	if quest.MinLevel > 0 {
+		sb.WriteString(fmt.Sprintf(" (level %d)", quest.MinLevel))
+	}
+	sb.WriteString("\n")
+	if quest.Description != "" {
+		sb.WriteString(quest.Description + "\n")
+	}
+
+	progress := character.QuestLog.Find(quest.ID)
+	if progress == nil || progress.State != quests.QuestStateActive {
+		progress = quests.NewQuestProgress(quest.ID)
+	}
+	writeObjectives(&sb, progress, quest)
+
+	if quest.Rewards.XP > 0 || quest.Rewards.Gold > 0 || len(quest.Rewards.ItemTemplateIDs) > 0 {
+		sb.WriteString("Rewards:")
+		if quest.Rewards.XP > 0 {
+			sb.WriteString(fmt.Sprintf(" %d XP", quest.Rewards.XP))
+		}
+		if quest.Rewards.Gold > 0 {
+			sb.WriteString(fmt.Sprintf(" %d gold", quest.Rewards.Gold))
+		}
+		if len(quest.Rewards.ItemTemplateIDs) > 0 {
+			sb.WriteString(fmt.Sprintf(" %d item(s)", len(quest.Rewards.ItemTemplateIDs)))
+		}
+	}
+
+	return strings.TrimRight(sb.String(), "\n")
+}
+
+// writeObjectives writes the objective list with progress counters
+func writeObjectives(sb *strings.Builder, progress *quests.QuestProgress, quest *quests.Quest) {
+	for i := range quest.Objectives {
+		objective := &quest.Objectives[i]
+		check := " "
+		if progress.IsObjectiveDone(objective) {
+			check = "x"
+		}
+		description := objective.Description
+		if description == "" {
+			description = string(objective.Type) + " " + objective.TargetID
+		}
+		sb.WriteString(fmt.Sprintf("  [%s] %s (%d/%d)\n", check, description, progress.Objectives[objective.ID], objective.RequiredCount()))
+	}
+}
@@ -1,10 +1,12 @@
 package commands
 
 import (
+	"github.com/talesmud/talesmud/pkg/entities/quests"
 	"github.com/talesmud/talesmud/pkg/entities/rooms"
 	"github.com/talesmud/talesmud/pkg/mudserver/game/def"
 	"github.com/talesmud/talesmud/pkg/mudserver/game/messages"
 	m "github.com/talesmud/talesmud/pkg/mudserver/game/messages"
+	"github.com/talesmud/talesmud/pkg/scripts/events"
 )
 
 // TakeExit ... executes scream command
@@ -14,11 +16,28 @@ func TakeExit(exit string) RoomCommand {
 
 		if exit, ok := room.GetExit(exit); ok {
 
+			if !exit.CanPass() {
+				game.SendMessage() <- message.Reply("The " + exit.Door.DisplayName() + " to the " + exit.Name + " is closed.")
+				return true
+			}
+
 			characterID := message.Character.ID
 
 			// find next room
 			if next, err := game.GetFacade().RoomsService().FindByID(exit.Target); err == nil {
 
+				// event scripts may block the character from leaving
+				if game.DispatchEvent(events.NewEventContext(events.EventPlayerLeaveRoom).
+					WithCharacter(message.Character).
+					WithRoom(room).
+					WithMovement(room, next).
+					Set("exit", exit.Name)) {
+					game.SendMessage() <- message.Reply("You can't go that way right now.")
+					return true
+				}
+
+				game.GetTradeManager().CancelTrade(characterID, message.Character.Name+" left the room")
+
 				// update old room
 				room.RemoveCharacter(characterID)
 				game.GetFacade().RoomsService().Update(room.ID, room)
@@ -59,6 +78,13 @@ func TakeExit(exit string) RoomCommand {
 					},
 				}
 
+				game.DispatchEvent(events.NewEventContext(events.EventPlayerEnterRoom).
+					WithCharacter(character).
+					WithRoom(next).
+					WithMovement(room, next))
+
+				UpdateQuestProgress(game, character, quests.ObjectiveTypeVisit, 1, next.ID)
+
 				return true
 			}
 		}
@@ -12,6 +12,7 @@ import (
 	"github.com/talesmud/talesmud/pkg/mudserver/game/def"
 	"github.com/talesmud/talesmud/pkg/mudserver/game/messages"
 	m "github.com/talesmud/talesmud/pkg/mudserver/game/messages"
+	"github.com/talesmud/talesmud/pkg/scripts/events"
 )
 
 // SelectCharacterCommand ... select a character
@@ -65,6 +66,12 @@ func handleCharacterSelected(game def.GameCtrl, user *entities.User, character *
 		}
 	}
 
+	// characters created before skills existed get their resource pools on first selection
+	if character.MaxMana == 0 && character.MaxStamina == 0 {
+		character.InitResources()
+		game.GetFacade().CharactersService().Update(character.ID, character)
+	}
+
 	// update player
 	user.LastCharacter = character.ID
 	game.GetFacade().UsersService().Update(user.RefID, user)
@@ -137,4 +144,12 @@ func handleCharacterSelected(game def.GameCtrl, user *entities.User, character *
 		Gold:          character.Gold,
 	}
 
+	joinChannelsOnLogin(game, user.ID, character)
+
+	game.DispatchEvent(events.NewEventContext(events.EventPlayerJoin).
+		WithCharacter(character).
+		WithRoom(currentRoom).
+		Set("user", user))
+
+	NotifyParty(game, character, character.Name+" is online.")
 }
@@ -0,0 +1,311 @@
+package commands
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/talesmud/talesmud/pkg/entities/characters"
+	"github.com/talesmud/talesmud/pkg/entities/combat"
+	"github.com/talesmud/talesmud/pkg/entities/skills"
+	"github.com/talesmud/talesmud/pkg/mudserver/game/def"
+	"github.com/talesmud/talesmud/pkg/mudserver/game/messages"
+	"github.com/talesmud/talesmud/pkg/scripts"
+)
+
+// SkillsCommand lists the skills available to the character
+type SkillsCommand struct {
+}
+
+// Key returns the command key matcher
+func (command *SkillsCommand) Key() CommandKey { return &ExactCommandKey{} }
+
+// Execute handles the skills command
+func (command *SkillsCommand) Execute(game def.GameCtrl, message *messages.Message) bool {
+	if message.Character == nil {
+		game.SendMessage() <- message.Reply("You need to select a character first.")
+		return true
+	}
+
+	available, err := game.GetFacade().SkillsService().FindAvailable(message.Character)
+	if err != nil {
+		game.SendMessage() <- message.Reply("Could not load your skills.")
+		return true
+	}
+	if len(available) == 0 {
+		game.SendMessage() <- message.Reply("You don't know any skills yet.")
+		return true
+	}
+
+	char := message.Character
+	char.RegenerateResources(time.Now())
+	mana, stamina := char.CurrentMana, char.CurrentStamina
+	cooldowns := char.SkillCooldowns
+
+	// while fighting the combat instance holds the current resources and cooldowns
+	if instance := game.GetCombatEngine().GetCombatInstance(char.Entity.ID); instance != nil {
+		if player := instance.GetPlayerByID(char.Entity.ID); player != nil {
+			mana, stamina = player.CurrentMana, player.CurrentStamina
+			cooldowns = player.SkillCooldowns
+		}
+	}
+
+	var sb strings.Builder
+	sb.WriteString("=== Skills ===\n")
+	sb.WriteString(fmt.Sprintf("Mana: %d/%d | Stamina: %d/%d\n\n", mana, char.MaxMana, stamina, char.MaxStamina))
+
+	now := time.Now()
+	for _, skill := range available {
+		sb.WriteString(fmt.Sprintf("  %-18s %-11s", skill.Name, string(skill.Target)))
+		if skill.Cost > 0 {
+			sb.WriteString(fmt.Sprintf(" %d %s", skill.Cost, skill.ResourceType))
+		}
+		if skill.CooldownSec > 0 {
+			sb.WriteString(fmt.Sprintf(" | cooldown %ds", skill.CooldownSec))
+		}
+		if readyAt, ok := cooldowns[skill.ID]; ok && readyAt.After(now) {
+			sb.WriteString(fmt.Sprintf(" (ready in %ds)", int(readyAt.Sub(now).Seconds())+1))
+		}
+		sb.WriteString("\n")
+		if skill.Description != "" {
+			sb.WriteString("      ")
+			sb.WriteString(skill.Description)
+			sb.WriteString("\n")
+		}
+	}
+	sb.WriteString("\nUse a skill with: cast <skill> [target]")
+
+	game.SendMessage() <- message.Reply(sb.String())
+	return true
+}
+
+// CastCommand uses a skill, in combat the skill is queued for the next turn
+type CastCommand struct {
+}
+
+// Key returns the command key matcher
+func (command *CastCommand) Key() CommandKey { return &StartsWithCommandKey{} }
+
+// Execute handles the cast command
+func (command *CastCommand) Execute(game def.GameCtrl, message *messages.Message) bool {
+	parts := strings.Fields(message.Data)
+	if len(parts) < 1 {
+		return false
+	}
+	return command.cast(game, message, parts[1:])
+}
+
+// cast resolves the skill and target from the arguments: <skill name> [target name]
+func (command *CastCommand) cast(game def.GameCtrl, message *messages.Message, args []string) bool {
+	if message.Character == nil {
+		game.SendMessage() <- message.Reply("You need to select a character first.")
+		return true
+	}
+	if len(args) == 0 {
+		game.SendMessage() <- message.Reply("Cast what? Usage: cast <skill> [target]. Type skills to list your skills.")
+		return true
+	}
+
+	skill, targetName := findSkillArgs(game, message.Character, args)
+	if skill == nil {
+		game.SendMessage() <- message.Reply(fmt.Sprintf("You don't know a skill called '%s'.", strings.Join(args, " ")))
+		return true
+	}
+
+	combatEngine := game.GetCombatEngine()
+	if combatEngine != nil && combatEngine.IsPlayerInCombat(message.Character.Entity.ID) {
+		return command.castInCombat(game, message, combatEngine, skill, targetName)
+	}
+
+	if skill.IsHostile() {
+		game.SendMessage() <- message.Reply(fmt.Sprintf("You can only use %s in combat. Use attack to start a fight first.", skill.Name))
+		return true
+	}
+	return command.castOutOfCombat(game, message, skill, targetName)
+}
+
+// findSkillArgs finds the available skill named by the first arguments, the longest skill name wins
+// and the remaining words are the target name. Returns nil if no skill matches
+func findSkillArgs(game def.GameCtrl, character *characters.Character, args []string) (*skills.Skill, string) {
+	for i := len(args); i > 0; i-- {
+		found, err := game.GetFacade().SkillsService().FindAvailableByName(character, strings.Join(args[:i], " "))
+		if err == nil && found != nil {
+			return found, strings.Join(args[i:], " ")
+		}
+	}
+	return nil, ""
+}
+
+// castInCombat validates the skill against the combat snapshot and queues it for the next turn
+func (command *CastCommand) castInCombat(game def.GameCtrl, message *messages.Message, combatEngine def.CombatEngineCtrl, skill *skills.Skill, targetName string) bool {
+	instance := combatEngine.GetCombatInstance(message.Character.Entity.ID)
+	if instance == nil {
+		game.SendMessage() <- message.Reply("You are not in combat.")
+		return true
+	}
+	player := instance.GetPlayerByID(message.Character.Entity.ID)
+	if player == nil || !player.IsAlive || player.HasFled {
+		game.SendMessage() <- message.Reply("You can't use skills right now.")
+		return true
+	}
+
+	if readyAt, ok := player.SkillCooldowns[skill.ID]; ok && readyAt.After(time.Now()) {
+		game.SendMessage() <- message.Reply(fmt.Sprintf("%s is not ready yet (%ds).", skill.Name, int(time.Until(readyAt).Seconds())+1))
+		return true
+	}
+	if !hasCombatResource(player, skill) {
+		game.SendMessage() <- message.Reply(fmt.Sprintf("You don't have enough %s for %s.", skill.ResourceType, skill.Name))
+		return true
+	}
+
+	targetID := ""
+	targetDisplayName := ""
+	if targetName != "" && skill.Target != skills.TargetTypeSelf && skill.Target != skills.TargetTypeAllEnemies {
+		candidates := instance.GetLivingEnemies()
+		if skill.Target == skills.TargetTypeAlly {
+			candidates = instance.GetLivingPlayers()
+		}
+		targetNameLower := strings.ToLower(targetName)
+		for _, candidate := range candidates {
+			if strings.Contains(strings.ToLower(candidate.Name), targetNameLower) {
+				targetID = candidate.ID
+				targetDisplayName = candidate.Name
+				break
+			}
+		}
+		if targetID == "" {
+			game.SendMessage() <- message.Reply(fmt.Sprintf("Invalid target '%s'.", targetName))
+			return true
+		}
+	}
+
+	combatEngine.QueuePlayerSkill(message.Character.Entity.ID, skill.ID, targetID)
+
+	if targetDisplayName != "" {
+		game.SendMessage() <- message.Reply(fmt.Sprintf("You prepare %s on %s.", skill.Name, targetDisplayName))
+	} else {
+		game.SendMessage() <- message.Reply(fmt.Sprintf("You prepare %s.", skill.Name))
+	}
+	return true
+}
+
+// castOutOfCombat applies a self or ally skill immediately
+func (command *CastCommand) castOutOfCombat(game def.GameCtrl, message *messages.Message, skill *skills.Skill, targetName string) bool {
+	char := message.Character
+	now := time.Now()
+	char.RegenerateResources(now)
+
+	if remaining := char.SkillReadyIn(skill.ID, now); remaining > 0 {
+		game.SendMessage() <- message.Reply(fmt.Sprintf("%s is not ready yet (%ds).", skill.Name, int(remaining.Seconds())+1))
+		return true
+	}
+
+	target := char
+	if targetName != "" && skill.Target == skills.TargetTypeAlly {
+		target = nil
+		targetNameLower := strings.ToLower(targetName)
+		if strings.HasPrefix(strings.ToLower(char.Name), targetNameLower) {
+			target = char
+		}
+		for _, member := range GetPartyMembersInRoom(game, char, char.CurrentRoomID) {
+			if target == nil && strings.HasPrefix(strings.ToLower(member.Name), targetNameLower) {
+				target = member
+			}
+		}
+		if target == nil {
+			game.SendMessage() <- message.Reply(fmt.Sprintf("There is no group member called '%s' here.", targetName))
+			return true
+		}
+	}
+
+	if !char.SpendResource(skill.ResourceType, skill.Cost) {
+		game.SendMessage() <- message.Reply(fmt.Sprintf("You don't have enough %s for %s.", skill.ResourceType, skill.Name))
+		return true
+	}
+	char.StartCooldown(skill, now)
+
+	amount := skill.RollAmount(char.GetAttributeModifier(skill.Formula.Attribute), char.Level)
+	// party members in combat are healed by the combat engine, combat writes their HP back when it ends
+	targetInCombat := false
+	if skill.Effect == skills.EffectTypeHeal && target != char {
+		if combatEngine := game.GetCombatEngine(); combatEngine != nil {
+			targetInCombat, amount = combatEngine.HealCombatant(target.ID, char, skill, amount)
+		}
+	}
+	if skill.Effect == skills.EffectTypeHeal && !targetInCombat {
+		before := target.CurrentHitPoints
+		target.CurrentHitPoints += amount
+		if target.CurrentHitPoints > target.MaxHitPoints {
+			target.CurrentHitPoints = target.MaxHitPoints
+		}
+		amount = target.CurrentHitPoints - before
+	}
+
+	if err := game.GetFacade().CharactersService().Update(char.ID, char); err != nil {
+		log.WithError(err).Error("Failed to update character after skill use")
+	}
+	if target != char && !targetInCombat {
+		if err := game.GetFacade().CharactersService().Update(target.ID, target); err != nil {
+			log.WithError(err).Error("Failed to update skill target")
+		}
+	}
+
+	switch {
+	case skill.Effect != skills.EffectTypeHeal:
+		game.SendMessage() <- message.Reply(fmt.Sprintf("You use %s.", skill.Name))
+	case target == char:
+		game.SendMessage() <- message.Reply(fmt.Sprintf("You use %s and heal yourself for %d. (%d/%d HP)", skill.Name, amount, char.CurrentHitPoints, char.MaxHitPoints))
+	case targetInCombat:
+		// the fight announces the heal to the target
+		game.SendMessage() <- message.Reply(fmt.Sprintf("You use %s and heal %s for %d.", skill.Name, target.Name, amount))
+	default:
+		game.SendMessage() <- message.Reply(fmt.Sprintf("You use %s and heal %s for %d.", skill.Name, target.Name, amount))
+		game.SendMessage() <- messages.Reply(target.BelongsUserID, fmt.Sprintf("%s uses %s on you and heals you for %d. (%d/%d HP)", char.Name, skill.Name, amount, target.CurrentHitPoints, target.MaxHitPoints))
+	}
+
+	RunSkillScript(game, char, skill, []string{target.ID}, amount)
+	return true
+}
+
+// hasCombatResource returns true if the combatant can pay the skill cost
+func hasCombatResource(player *combat.CombatantRef, skill *skills.Skill) bool {
+	switch skill.ResourceType {
+	case skills.ResourceTypeMana:
+		return player.CurrentMana >= skill.Cost
+	case skills.ResourceTypeStamina:
+		return player.CurrentStamina >= skill.Cost
+	}
+	return true
+}
+
+// RunSkillScript executes the optional Lua script of a skill after it resolved
+func RunSkillScript(game def.GameCtrl, character *characters.Character, skill *skills.Skill, targetIDs []string, amount int32) {
+	if skill.ScriptID == "" {
+		return
+	}
+
+	script, err := game.GetFacade().ScriptsService().FindByID(skill.ScriptID)
+	if err != nil || script == nil {
+		log.WithField("scriptID", skill.ScriptID).WithError(err).Warn("Skill script not found")
+		return
+	}
+
+	ctx := scripts.NewScriptContext()
+	ctx.Set("eventType", "skill.use")
+	ctx.Set("skill", skill)
+	ctx.Set("character", character)
+	ctx.Set("targetIds", targetIDs)
+	ctx.Set("amount", int(amount))
+	if character.CurrentRoomID != "" {
+		if room, err := game.GetFacade().RoomsService().FindByID(character.CurrentRoomID); err == nil {
+			ctx.Set("room", room)
+		}
+	}
+
+	result := game.GetFacade().Runner().RunWithResult(*script, ctx)
+	if result != nil && !result.Success {
+		log.WithField("script", script.Name).WithField("error", result.Error).Warn("Skill script failed")
+	}
+}
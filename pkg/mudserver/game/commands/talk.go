@@ -7,6 +7,7 @@ import (
 
 	"github.com/talesmud/talesmud/pkg/entities/conversations"
 	"github.com/talesmud/talesmud/pkg/entities/dialogs"
+	"github.com/talesmud/talesmud/pkg/entities/quests"
 	"github.com/talesmud/talesmud/pkg/mudserver/game/def"
 	"github.com/talesmud/talesmud/pkg/mudserver/game/messages"
 )
@@ -57,6 +58,9 @@ func (command *TalkCommand) Execute(game def.GameCtrl, message *messages.Message
 		return true
 	}
 
+	// Talking to an NPC fulfills talk objectives
+	UpdateQuestProgress(game, message.Character, quests.ObjectiveTypeTalk, 1, npc.TemplateID, npc.ID)
+
 	// Check if NPC has a dialog
 	if !npc.HasDialog() {
 		game.SendMessage() <- message.Reply(npc.Name + " doesn't seem to want to talk.")
@@ -87,6 +91,10 @@ func (command *TalkCommand) Execute(game def.GameCtrl, message *messages.Message
 	// Set context for template rendering
 	conv.SetContext("PLAYER", message.Character.Name)
 	conv.SetContext("NPC", npc.Name)
+	// every talk shows the current node again, count it so ordered texts advance
+	if node := game.GetFacade().ConversationsService().GetCurrentNode(conv, dialog); node != nil {
+		conv.MarkVisited(node.NodeID)
+	}
 	game.GetFacade().ConversationsService().Update(conv.ID, conv)
 
 	// Send dialog message
@@ -0,0 +1,42 @@
+package commands
+
+import (
+	"strings"
+
+	"github.com/talesmud/talesmud/pkg/mudserver/game/def"
+	"github.com/talesmud/talesmud/pkg/mudserver/game/messages"
+)
+
+const minTelnetPasswordLength = 6
+
+// TelnetPasswordCommand sets the password used to log in with a telnet client
+type TelnetPasswordCommand struct {
+}
+
+// Key ...
+func (command *TelnetPasswordCommand) Key() CommandKey { return &StartsWithCommandKey{} }
+
+// Execute ... executes the telnetpassword command
+func (command *TelnetPasswordCommand) Execute(game def.GameCtrl, message *messages.Message) bool {
+
+	parts := strings.Fields(message.Data)
+	if len(parts) != 2 {
+		game.SendMessage() <- message.Reply("Usage: telnetpassword [password]")
+		return true
+	}
+
+	if len(parts[1]) < minTelnetPasswordLength {
+		game.SendMessage() <- message.Reply("The password needs at least 6 characters.")
+		return true
+	}
+
+	user := message.FromUser
+	if err := user.SetTelnetPassword(parts[1]); err != nil {
+		game.SendMessage() <- message.Reply("Could not set telnet password.")
+		return true
+	}
+	game.GetFacade().UsersService().Update(user.RefID, user)
+
+	game.SendMessage() <- message.Reply("Telnet password set. Connect with your telnet client and log in as [" + user.Nickname + "].")
+	return true
+}
@@ -145,7 +145,6 @@ func (command *BuyCommand) Execute(game def.GameCtrl, message *messages.Message)
 
 	// Find item in merchant inventory
 	var foundItem *npc.MerchantItem
-	var foundIndex int
 	itemNameLower := strings.ToLower(itemName)
 
 	for i := range merchant.MerchantTrait.Inventory {
@@ -158,7 +157,6 @@ func (command *BuyCommand) Execute(game def.GameCtrl, message *messages.Message)
 		if strings.EqualFold(itemTemplate.Name, itemName) ||
 			strings.HasPrefix(strings.ToLower(itemTemplate.Name), itemNameLower) {
 			foundItem = invItem
-			foundIndex = i
 			break
 		}
 	}
@@ -201,38 +199,59 @@ func (command *BuyCommand) Execute(game def.GameCtrl, message *messages.Message)
 		return true
 	}
 
+	// Take the items from the stock of this merchant instance, another buyer may have been faster
+	inStock := true
+	game.GetNPCInstanceManager().UpdateInstance(merchant.Entity.ID, func(n *npc.NPC) {
+		inStock = n.MerchantTrait != nil && n.MerchantTrait.TakeStock(foundItem.ItemTemplateID, quantity)
+	})
+	if !inStock {
+		game.SendMessage() <- message.Reply("That item is out of stock.")
+		return true
+	}
+
 	// Create item instance(s)
-	for i := int32(0); i < quantity; i++ {
+	delivered := int32(0)
+	for ; delivered < quantity; delivered++ {
 		newItem, err := game.GetFacade().ItemsService().CreateInstanceFromTemplate(foundItem.ItemTemplateID)
 		if err != nil {
 			log.WithError(err).Error("Failed to create item instance")
-			game.SendMessage() <- message.Reply("Error creating item.")
-			return true
+			break
 		}
 
 		// Store the item
 		storedItem, err := game.GetFacade().ItemsService().Store(newItem)
 		if err != nil {
 			log.WithError(err).Error("Failed to store item")
-			continue
+			break
 		}
 
 		// Add to inventory
 		err = message.Character.Inventory.AddItem(storedItem)
 		if err != nil {
 			log.WithError(err).Error("Failed to add item to inventory")
-			continue
+			game.GetFacade().ItemsService().Delete(storedItem.ID)
+			break
+		}
+	}
+
+	// Items that could not be created go back into the stock and are not paid for
+	if delivered < quantity {
+		game.GetNPCInstanceManager().UpdateInstance(merchant.Entity.ID, func(n *npc.NPC) {
+			if n.MerchantTrait != nil {
+				n.MerchantTrait.ReturnStock(foundItem.ItemTemplateID, quantity-delivered)
+			}
+		})
+		if delivered == 0 {
+			game.SendMessage() <- message.Reply("Error creating item.")
+			return true
 		}
+		quantity = delivered
+		totalPrice = price * int64(quantity)
 	}
 
 	// Deduct gold
 	message.Character.Gold -= totalPrice
 
-	// Update stock
-	if foundItem.Quantity >= 0 {
-		merchant.MerchantTrait.Inventory[foundIndex].Quantity -= quantity
-	}
-
 	// Persist character
 	err := game.GetFacade().CharactersService().Update(message.Character.ID, message.Character)
 	if err != nil {
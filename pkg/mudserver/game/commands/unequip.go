@@ -7,6 +7,7 @@ import (
 	"github.com/talesmud/talesmud/pkg/entities/items"
 	"github.com/talesmud/talesmud/pkg/mudserver/game/def"
 	"github.com/talesmud/talesmud/pkg/mudserver/game/messages"
+	"github.com/talesmud/talesmud/pkg/scripts/events"
 )
 
 // UnequipCommand handles unequipping items
@@ -74,6 +75,14 @@ func (command *UnequipCommand) Execute(game def.GameCtrl, message *messages.Mess
 		return true
 	}
 
+	// Event scripts may prevent unequipping the item (e.g. cursed items)
+	if game.DispatchEvent(events.NewEventContext(events.EventItemUnequip).
+		WithCharacter(message.Character).
+		WithItem(item)) {
+		game.SendMessage() <- message.Reply("You can't unequip " + item.Name + " right now.")
+		return true
+	}
+
 	// Handle two-handed weapons (remove from both slots)
 	slotsToRemove := []items.ItemSlot{foundSlot}
 	if item.SubType == items.ItemSubTypeTwoHandSword {
@@ -5,7 +5,9 @@ import (
 
 	log "github.com/sirupsen/logrus"
 
+	"github.com/talesmud/talesmud/pkg/entities/effects"
 	"github.com/talesmud/talesmud/pkg/entities/items"
+	"github.com/talesmud/talesmud/pkg/entities/skills"
 	"github.com/talesmud/talesmud/pkg/mudserver/game/def"
 	"github.com/talesmud/talesmud/pkg/mudserver/game/messages"
 	"github.com/talesmud/talesmud/pkg/scripts"
@@ -27,6 +29,12 @@ func (command *UseCommand) Execute(game def.GameCtrl, message *messages.Message)
 
 	// Parse command: "use potion" or "use health potion"
 	parts := strings.Fields(message.Data)
+	if len(parts) >= 3 && strings.ToLower(parts[1]) == "skill" {
+		// "use skill <name> [target]" is an alias for cast if a skill matches, items like "skill book" are used otherwise
+		if skill, _ := findSkillArgs(game, message.Character, parts[2:]); skill != nil {
+			return (&CastCommand{}).cast(game, message, parts[2:])
+		}
+	}
 	if len(parts) < 2 {
 		game.SendMessage() <- message.Reply("Use what? Usage: use <item>")
 		return true
@@ -111,6 +119,12 @@ func isUsable(item *items.Item) bool {
 		if _, ok := item.Attributes["manaRestore"]; ok {
 			return true
 		}
+		if _, ok := item.Attributes["applyEffect"]; ok {
+			return true
+		}
+		if _, ok := item.Attributes["cureEffect"]; ok {
+			return true
+		}
 	}
 	return false
 }
@@ -143,12 +157,45 @@ func applyBuiltInEffects(game def.GameCtrl, message *messages.Message, item *ite
 		}
 	}
 
-	// Mana restoration (placeholder for future mana system)
+	// Mana restoration
 	if val, ok := item.Attributes["manaRestore"]; ok {
 		amount := toInt32(val)
 		if amount > 0 {
-			game.SendMessage() <- message.Reply("You use " + item.Name + " and feel your magical energy restored.")
+			restored := char.RestoreResource(skills.ResourceTypeMana, amount)
+			if restored > 0 {
+				game.SendMessage() <- message.Reply("You use " + item.Name + " and restore " + itoa(int(restored)) + " mana.")
+			} else {
+				game.SendMessage() <- message.Reply("You use " + item.Name + " but your mana is already full.")
+			}
+			applied = true
+		}
+	}
+
+	// Status effect removal, e.g. an antidote with cureEffect "poison"
+	if val, ok := item.Attributes["cureEffect"]; ok {
+		if idOrType, isStr := val.(string); isStr && idOrType != "" {
+			if RemoveCharacterEffect(game, char, idOrType) {
+				game.SendMessage() <- message.Reply("You use " + item.Name + " and feel the " + idOrType + " fade.")
+			} else {
+				game.SendMessage() <- message.Reply("You use " + item.Name + " but nothing happens.")
+			}
+			applied = true
+		}
+	}
+
+	// Status effect, either a preset ID ("haste") or an effect definition
+	if val, ok := item.Attributes["applyEffect"]; ok {
+		if effect, valid := effects.Parse(val); valid {
+			effect.SourceID = char.Entity.ID
+			effect.SourceName = char.Name
+			if ApplyCharacterEffect(game, char, effect) {
+				game.SendMessage() <- message.Reply("You use " + item.Name + " and are affected by " + effect.DisplayName() + ".")
+			} else {
+				game.SendMessage() <- message.Reply("You use " + item.Name + " but " + effect.DisplayName() + " is already active.")
+			}
 			applied = true
+		} else {
+			log.WithField("itemID", item.ID).Warn("Item has an invalid applyEffect attribute")
 		}
 	}
 
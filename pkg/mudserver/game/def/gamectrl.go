@@ -1,9 +1,14 @@
 package def
 
 import (
+	"github.com/talesmud/talesmud/pkg/entities/channels"
 	"github.com/talesmud/talesmud/pkg/entities/characters"
 	"github.com/talesmud/talesmud/pkg/entities/combat"
+	"github.com/talesmud/talesmud/pkg/entities/effects"
 	npc "github.com/talesmud/talesmud/pkg/entities/npcs"
+	"github.com/talesmud/talesmud/pkg/entities/skills"
+	"github.com/talesmud/talesmud/pkg/entities/trades"
+	"github.com/talesmud/talesmud/pkg/scripts/events"
 	"github.com/talesmud/talesmud/pkg/service"
 )
 
@@ -17,6 +22,8 @@ type NPCInstanceCtrl interface {
 	GetAllInstances() []*npc.NPC
 	// SpawnInstanceDirect spawns an NPC from a template (not via spawner)
 	SpawnInstanceDirect(templateID, roomID string) (*npc.NPC, error)
+	// RemoveInstance removes an NPC instance without killing it, spawners replace it later
+	RemoveInstance(id string)
 	// KillInstance marks an NPC instance as dead
 	KillInstance(id string) bool
 	// DamageInstance applies damage and returns true if the NPC died
@@ -41,6 +48,8 @@ type CombatEngineCtrl interface {
 	GetCombatInstance(characterID string) *combat.CombatInstance
 	// InitiateCombat starts combat between players and enemies
 	InitiateCombat(roomID string, players []*characters.Character, enemies []*npc.NPC) *combat.CombatInstance
+	// JoinCombat adds a player to a running combat instance
+	JoinCombat(instanceID string, character *characters.Character) bool
 	// ProcessPlayerAttack handles a player attacking a target in combat
 	ProcessPlayerAttack(characterID, targetID string) (message string, combatEnded bool, endState combat.CombatState)
 	// ProcessPlayerDefend handles a player defending
@@ -53,10 +62,46 @@ type CombatEngineCtrl interface {
 	EndCombatForPlayer(characterID string)
 	// QueuePlayerAction queues an action for a player's next auto-attack turn
 	QueuePlayerAction(characterID string, action combat.CombatAction, targetID string)
+	// ApplyStatusEffect applies a status effect to a player or NPC in combat
+	ApplyStatusEffect(combatantID string, effect effects.StatusEffect) (inCombat bool, applied bool)
+	// RemoveStatusEffect removes a status effect by ID or type from a player or NPC in combat
+	RemoveStatusEffect(combatantID string, idOrType string) (inCombat bool, removed bool)
+	// HealCombatant heals a player or NPC in combat with a skill used by someone outside the fight
+	HealCombatant(combatantID string, healer *characters.Character, skill *skills.Skill, amount int32) (inCombat bool, healed int32)
+	// QueuePlayerSkill queues a skill for a player's next turn, an empty targetID uses the default target
+	QueuePlayerSkill(characterID string, skillID string, targetID string)
 	// SetAutoAttackTarget sets the persistent auto-attack target for a player
 	SetAutoAttackTarget(characterID string, targetID string)
 }
 
+// TradeCtrl keeps the trade windows between players
+type TradeCtrl interface {
+	// FindTrade returns a copy of the trade or trade request the character takes part in, nil if there is none
+	FindTrade(characterID string) *trades.Trade
+	// StartTrade registers a trade request, fails if one of the characters is already trading
+	StartTrade(trade *trades.Trade) error
+	// UpdateTrade changes the trade of the character using a callback and returns a copy of the result
+	UpdateTrade(characterID string, updater func(*trades.Trade)) *trades.Trade
+	// CloseTrade removes the trade, returns false if it was already closed
+	CloseTrade(tradeID string) bool
+	// CancelTrade closes the trade of the character and tells both sides why
+	CancelTrade(characterID string, reason string) bool
+}
+
+// ChannelCtrl delivers chat channel messages and keeps the channel history
+type ChannelCtrl interface {
+	// Broadcast sends the text to all online members of the channel and adds it to the history
+	Broadcast(channel *channels.Channel, sender *characters.Character, text string)
+	// ReplayHistory sends the recent lines of the joined channels to the user
+	ReplayHistory(userID string, character *characters.Character)
+	// Channels returns the stored chat channels
+	Channels() []*channels.Channel
+	// FindChannel returns the channel with the case insensitive name, nil if there is none
+	FindChannel(name string) *channels.Channel
+	// ReloadChannels drops the cached channels, call it after channels changed
+	ReloadChannels()
+}
+
 // GameCtrl def
 // interface for commands package to communicate back to game instance
 type GameCtrl interface {
@@ -70,4 +115,14 @@ type GameCtrl interface {
 	GetNPCInstanceManager() NPCInstanceCtrl
 	// GetCombatEngine returns the combat engine controller
 	GetCombatEngine() CombatEngineCtrl
+	// GetTradeManager returns the controller of the trades between players
+	GetTradeManager() TradeCtrl
+	// GetChannelManager returns the controller of the chat channels
+	GetChannelManager() ChannelCtrl
+	// DispatchEvent runs all script handlers registered for the event, returns true if a handler canceled it
+	DispatchEvent(ctx *events.EventContext) bool
+	// ReloadEventHandlers registers the event bindings of the stored scripts again, call it after scripts changed
+	ReloadEventHandlers()
+	// NotifyLevelUps sends the level-up messages and events for levels a character gained outside of a command
+	NotifyLevelUps(character *characters.Character, levelUps []characters.LevelUpRecord)
 }
@@ -0,0 +1,89 @@
+package game
+
+import (
+	log "github.com/sirupsen/logrus"
+	"github.com/talesmud/talesmud/pkg/entities/characters"
+	"github.com/talesmud/talesmud/pkg/entities/combat"
+	npc "github.com/talesmud/talesmud/pkg/entities/npcs"
+	"github.com/talesmud/talesmud/pkg/scripts"
+	"github.com/talesmud/talesmud/pkg/scripts/events"
+	"github.com/talesmud/talesmud/pkg/service"
+)
+
+// enemyScriptOutcome contains the changes an EnemyTrait script requested
+// Scripts return a table, e.g. { suppressLoot = true, reinforcements = { "NPC0005" } }
+type enemyScriptOutcome struct {
+	// SuppressLoot prevents the item and gold drop of the dying NPC
+	SuppressLoot bool
+	// Reinforcements are NPC template IDs spawned in the room of the fight
+	Reinforcements []string
+}
+
+// runEnemyScript runs one of the EnemyTrait lifecycle scripts (OnAggroScript, OnDeathScript, OnFleeScript)
+// with the NPC instance, combat instance, room and participating characters in the context
+func runEnemyScript(facade service.Facade, scriptID string, eventType events.EventType, inst *npc.NPC, instance *combat.CombatInstance, ctxData map[string]interface{}) enemyScriptOutcome {
+	outcome := enemyScriptOutcome{}
+	if scriptID == "" {
+		return outcome
+	}
+
+	script, err := facade.ScriptsService().FindByID(scriptID)
+	if err != nil || script == nil {
+		log.WithField("scriptID", scriptID).WithError(err).Warn("Enemy script not found")
+		return outcome
+	}
+
+	ctx := scripts.NewScriptContext()
+	ctx.Set("eventType", string(eventType))
+	ctx.Set("npc", inst)
+
+	roomID := inst.CurrentRoomID
+	participants := make([]*characters.Character, 0)
+	if instance != nil {
+		roomID = instance.OriginRoomID
+		ctx.Set("combat", instance)
+		ctx.Set("combatId", instance.ID)
+		for _, player := range instance.Players {
+			if char, err := facade.CharactersService().FindByID(player.ID); err == nil {
+				participants = append(participants, char)
+			}
+		}
+	}
+	ctx.Set("characters", participants)
+	if room, err := facade.RoomsService().FindByID(roomID); err == nil {
+		ctx.Set("room", room)
+	}
+	for key, value := range ctxData {
+		ctx.Set(key, value)
+	}
+
+	result := facade.Runner().RunWithResult(*script, ctx)
+	if result == nil {
+		return outcome
+	}
+	if !result.Success {
+		log.WithFields(log.Fields{
+			"script": script.Name,
+			"npc":    inst.GetTargetName(),
+			"error":  result.Error,
+		}).Warn("Enemy script failed")
+		return outcome
+	}
+
+	if values, ok := result.Result.(map[string]interface{}); ok {
+		if suppress, ok := values["suppressLoot"].(bool); ok {
+			outcome.SuppressLoot = suppress
+		}
+		switch reinforcements := values["reinforcements"].(type) {
+		case string:
+			outcome.Reinforcements = append(outcome.Reinforcements, reinforcements)
+		case []interface{}:
+			for _, templateID := range reinforcements {
+				if id, ok := templateID.(string); ok && id != "" {
+					outcome.Reinforcements = append(outcome.Reinforcements, id)
+				}
+			}
+		}
+	}
+	return outcome
+}
@@ -5,10 +5,12 @@ import (
 
 	log "github.com/sirupsen/logrus"
 	"github.com/talesmud/talesmud/pkg/entities"
+	"github.com/talesmud/talesmud/pkg/entities/characters"
 
 	c "github.com/talesmud/talesmud/pkg/mudserver/game/commands"
 	def "github.com/talesmud/talesmud/pkg/mudserver/game/def"
 	m "github.com/talesmud/talesmud/pkg/mudserver/game/messages"
+	"github.com/talesmud/talesmud/pkg/scripts/events"
 	"github.com/talesmud/talesmud/pkg/service"
 )
 
@@ -22,12 +24,21 @@ type Game struct {
 
 	SystemUser *entities.User
 
+	// Events dispatches game events to registered event scripts
+	Events *events.EventRegistry
+
 	// NPC instance manager for runtime NPC instances
 	NPCManager *NPCInstanceManager
 
 	// Combat controller for combat system
 	CombatController *CombatController
 
+	// TradeManager keeps the trade windows between players
+	TradeManager *TradeManager
+
+	// ChannelManager delivers chat channel messages
+	ChannelManager *ChannelManager
+
 	// messages
 	onMessageReceived chan interface{}
 	sendMessage       chan interface{}
@@ -45,6 +56,14 @@ type Game struct {
 
 	Avatars map[string]*Avatar
 
+	// roomArrivals tracks when online characters entered their room, used for the aggro grace period
+	roomArrivals map[string]roomArrival
+	// aggroCanceled contains the NPC/player pairs whose npc.aggro event a script canceled
+	aggroCanceled map[string]canceledAggro
+
+	// idleDialogs tracks the idle dialog progress of NPC instances
+	idleDialogs map[string]*idleDialogState
+
 	//world *World
 }
 
@@ -66,17 +85,29 @@ func New(facade service.Facade) *Game {
 		// game update listeners
 		//	Receivers: make([]Receiver, 0, 10),
 
-		Avatars: make(map[string]*Avatar),
+		Avatars:      make(map[string]*Avatar),
+		roomArrivals: make(map[string]roomArrival),
+		idleDialogs:  make(map[string]*idleDialogState),
 
 		Facade: facade,
 	}
 
+	// Initialize event registry, handlers are loaded from event scripts when the game starts
+	g.Events = events.NewEventRegistry(facade.Runner())
+	g.Events.SetScriptsRepository(facade.ScriptsService())
+
 	// Initialize NPC instance manager
-	g.NPCManager = NewNPCInstanceManager(facade)
+	g.NPCManager = NewNPCInstanceManager(facade, g.Events)
 
 	// Initialize Combat controller
 	g.CombatController = NewCombatController(g)
 
+	// Initialize trades between players
+	g.TradeManager = NewTradeManager(g)
+
+	// Initialize chat channels
+	g.ChannelManager = NewChannelManager(g)
+
 	return g
 }
 
@@ -130,17 +161,33 @@ func (g *Game) GetCombatEngine() def.CombatEngineCtrl {
 	return g.CombatController
 }
 
+// GetTradeManager returns the trade manager
+func (g *Game) GetTradeManager() def.TradeCtrl {
+	return g.TradeManager
+}
+
+// GetChannelManager returns the chat channel manager
+func (g *Game) GetChannelManager() def.ChannelCtrl {
+	return g.ChannelManager
+}
+
 const roomUpdateInterval = 10
 const npcUpdateInterval = 10
 const spawnerUpdateInterval = 5
 const combatUpdateInterval = 2 // Combat checks every 2 seconds
 
+// NotifyLevelUps implements def.GameCtrl.NotifyLevelUps
+func (g *Game) NotifyLevelUps(character *characters.Character, levelUps []characters.LevelUpRecord) {
+	c.NotifyLevelUps(g, character, levelUps)
+}
+
 func (g *Game) handleGameUpdates() {
 
 	roomTicker := time.NewTicker(roomUpdateInterval * time.Second)
 	npcTicker := time.NewTicker(npcUpdateInterval * time.Second)
 	spawnerTicker := time.NewTicker(spawnerUpdateInterval * time.Second)
 	combatTicker := time.NewTicker(combatUpdateInterval * time.Second)
+	snapshotTicker := time.NewTicker(snapshotInterval * time.Second)
 
 	for {
 		select {
@@ -152,6 +199,10 @@ func (g *Game) handleGameUpdates() {
 			g.handleSpawnerUpdates()
 		case <-combatTicker.C:
 			g.handleCombatUpdates()
+			g.handleAggro()
+			g.handleTradeUpdates()
+		case <-snapshotTicker.C:
+			g.SaveWorldState()
 		}
 	}
 }
@@ -166,11 +217,23 @@ func (g *Game) handleCombatUpdates() {
 //Run main game loop
 func (g *Game) Run() {
 
-	// Initialize NPC instance manager (spawns initial NPCs from spawners)
+	// Register script handlers for game events
+	g.loadEventHandlers()
+
+	// Initialize NPC instance manager (restores the world snapshot, spawns initial NPCs from spawners)
 	if err := g.NPCManager.Initialize(); err != nil {
 		log.WithError(err).Error("Failed to initialize NPC instance manager")
 	}
 
+	// Continue saved fights and clear combat flags left over from the last run
+	g.restoreCombatState()
+	g.reconcileCombatFlags()
+
+	// Create the ooc, newbie and trade channels on first start
+	if err := g.Facade.ChannelsService().EnsureDefaults(); err != nil {
+		log.WithError(err).Error("Failed to create default chat channels")
+	}
+
 	go g.handleGameUpdates()
 
 	go func() {
@@ -194,9 +257,12 @@ func (g *Game) Run() {
 					if !g.CommandProcessor.Process(g, message) {
 						// check room commands
 						if !g.RoomProcessor.Process(g, message) {
-							// generic messages will be converted to plain OutgoingMessages (type message)
-							// and send to the room audience including the origin nickname or charactername
-							g.handleDefaultMessage(message)
+							// talk on a chat channel: ".trade hello" or "ooc hello"
+							if !c.ProcessChannelMessage(g, message) {
+								// generic messages will be converted to plain OutgoingMessages (type message)
+								// and send to the room audience including the origin nickname or charactername
+								g.handleDefaultMessage(message)
+							}
 						}
 					}
 				}
@@ -0,0 +1,342 @@
+package game
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/talesmud/talesmud/pkg/entities/characters"
+	"github.com/talesmud/talesmud/pkg/entities/effects"
+	npc "github.com/talesmud/talesmud/pkg/entities/npcs"
+	"github.com/talesmud/talesmud/pkg/mudserver/game/commands"
+	m "github.com/talesmud/talesmud/pkg/mudserver/game/messages"
+	"github.com/talesmud/talesmud/pkg/scripts/events"
+)
+
+// aggro timing of enemies with AggroOnSight
+const (
+	// aggroGracePeriod gives players a moment to react after entering a room before enemies attack
+	aggroGracePeriod = 3 * time.Second
+	// aggroChaseInterval is the time an enemy needs to move one room closer to a detected player
+	aggroChaseInterval = 4 * time.Second
+)
+
+// roomArrival remembers when a character entered its current room
+type roomArrival struct {
+	roomID string
+	since  time.Time
+}
+
+// aggroTarget is a player detected by an aggressive enemy
+type aggroTarget struct {
+	character *characters.Character
+	distance  int
+}
+
+// canceledAggro remembers an npc.aggro event a script canceled, it is not sent again
+// while the player stays in the room they were in
+type canceledAggro struct {
+	characterID string
+	arrival     time.Time
+}
+
+// aggroScan holds the lookups of one aggro tick, they are shared by all aggressive enemies
+type aggroScan struct {
+	now     time.Time
+	players map[string][]*characters.Character
+	// rooms is loaded once per tick for all room searches
+	rooms roomGraph
+	// distances caches the room searches by start room and radius
+	distances map[string]map[string]int
+}
+
+// handleAggro lets aggressive enemies detect players within their aggro radius and start combat
+func (g *Game) handleAggro() {
+	aggressive := make([]*npc.NPC, 0)
+	for _, inst := range g.NPCManager.GetAllInstances() {
+		if g.canAggro(inst) {
+			aggressive = append(aggressive, inst)
+		}
+	}
+
+	if len(aggressive) == 0 {
+		return
+	}
+
+	now := time.Now()
+	players := g.trackRoomArrivals(now)
+	if len(players) == 0 {
+		return
+	}
+
+	scan := &aggroScan{now: now, players: players, rooms: g.loadRoomGraph(), distances: make(map[string]map[string]int)}
+	for _, inst := range aggressive {
+		target := g.findAggroTarget(inst, scan)
+		if target == nil {
+			continue
+		}
+
+		if target.distance == 0 {
+			if g.startAggroCombat(inst, target.character) {
+				// the player is busy now, other enemies look for someone else
+				removeAggroPlayer(players, target.character)
+			}
+			continue
+		}
+		g.chaseAggroTarget(inst, target.character, scan.rooms)
+	}
+}
+
+// trackRoomArrivals updates the room arrival times of all online characters and returns
+// the characters that can be attacked, grouped by room
+func (g *Game) trackRoomArrivals(now time.Time) map[string][]*characters.Character {
+	players := make(map[string][]*characters.Character)
+	if g.roomArrivals == nil {
+		g.roomArrivals = make(map[string]roomArrival)
+	}
+
+	users, err := g.Facade.UsersService().FindAllOnline()
+	if err != nil {
+		log.WithError(err).Error("Could not load online users for aggro checks")
+		return players
+	}
+
+	online := make(map[string]bool, len(users))
+	for _, user := range users {
+		if user.LastCharacter == "" {
+			continue
+		}
+		character, err := g.Facade.CharactersService().FindByID(user.LastCharacter)
+		if err != nil || character == nil || character.CurrentRoomID == "" {
+			continue
+		}
+		online[character.ID] = true
+
+		arrival, ok := g.roomArrivals[character.ID]
+		if !ok || arrival.roomID != character.CurrentRoomID {
+			g.roomArrivals[character.ID] = roomArrival{roomID: character.CurrentRoomID, since: now}
+			continue
+		}
+		if now.Sub(arrival.since) < aggroGracePeriod {
+			continue
+		}
+
+		if character.CurrentHitPoints <= 0 || character.InCombat || g.CombatController.IsPlayerInCombat(character.Entity.ID) {
+			continue
+		}
+		players[character.CurrentRoomID] = append(players[character.CurrentRoomID], character)
+	}
+
+	// forget characters that went offline
+	for characterID := range g.roomArrivals {
+		if !online[characterID] {
+			delete(g.roomArrivals, characterID)
+		}
+	}
+	// canceled aggro ends when the player leaves the room
+	for key, canceled := range g.aggroCanceled {
+		if arrival, ok := g.roomArrivals[canceled.characterID]; !ok || !arrival.since.Equal(canceled.arrival) {
+			delete(g.aggroCanceled, key)
+		}
+	}
+	return players
+}
+
+// removeAggroPlayer removes a character that was pulled into combat from the aggro candidates
+func removeAggroPlayer(players map[string][]*characters.Character, character *characters.Character) {
+	roomPlayers := players[character.CurrentRoomID]
+	for i, player := range roomPlayers {
+		if player.ID == character.ID {
+			players[character.CurrentRoomID] = append(roomPlayers[:i], roomPlayers[i+1:]...)
+			return
+		}
+	}
+}
+
+// canAggro returns true if the NPC is an aggressive enemy that is free to start a fight
+func (g *Game) canAggro(inst *npc.NPC) bool {
+	if inst.IsTemplate || inst.IsDead || inst.InCombat || !inst.IsEnemy() || !inst.EnemyTrait.AggroOnSight {
+		return false
+	}
+	if g.CombatController.IsNPCInCombat(inst.Entity.ID) {
+		return false
+	}
+	return !g.isNPCInDialog(inst)
+}
+
+// findAggroTarget returns the closest player the NPC notices, players in the NPC's room come first
+func (g *Game) findAggroTarget(inst *npc.NPC, scan *aggroScan) *aggroTarget {
+	var best *aggroTarget
+
+	key := fmt.Sprintf("%s/%d", inst.CurrentRoomID, inst.EnemyTrait.AggroRadius)
+	distances, ok := scan.distances[key]
+	if !ok {
+		distances = scan.rooms.withinDistance(inst.CurrentRoomID, inst.EnemyTrait.AggroRadius, false)
+		scan.distances[key] = distances
+	}
+	for roomID, distance := range distances {
+		if best != nil && distance >= best.distance {
+			continue
+		}
+		for _, character := range scan.players[roomID] {
+			if g.noticesPlayer(inst, character, scan.now) {
+				best = &aggroTarget{character: character, distance: distance}
+				break
+			}
+		}
+	}
+	return best
+}
+
+// noticesPlayer applies the level and stealth checks and skips players a script already protected
+func (g *Game) noticesPlayer(inst *npc.NPC, character *characters.Character, now time.Time) bool {
+	if inst.EnemyTrait.IgnoresLevel(character.Level, inst.Level) {
+		return false
+	}
+	if character.StatusEffects.Has(effects.EffectTypeStealth, now) {
+		return false
+	}
+	_, canceled := g.aggroCanceled[inst.Entity.ID+"/"+character.ID]
+	return !canceled
+}
+
+// dispatchAggro sends the npc.aggro event right before an enemy attacks, a canceled event
+// is remembered until the player leaves the room so handlers run once per aggro
+func (g *Game) dispatchAggro(inst *npc.NPC, character *characters.Character) bool {
+	ctx := events.NewEventContext(events.EventNPCAggro).
+		WithNPC(inst).
+		WithCharacter(character)
+	if room, err := g.Facade.RoomsService().FindByID(character.CurrentRoomID); err == nil {
+		ctx.WithRoom(room)
+	}
+	if !g.DispatchEvent(ctx) {
+		return true
+	}
+
+	if g.aggroCanceled == nil {
+		g.aggroCanceled = make(map[string]canceledAggro)
+	}
+	g.aggroCanceled[inst.Entity.ID+"/"+character.ID] = canceledAggro{
+		characterID: character.ID,
+		arrival:     g.roomArrivals[character.ID].since,
+	}
+	return false
+}
+
+// chaseAggroTarget moves the NPC one room closer to a player it detected in another room
+func (g *Game) chaseAggroTarget(inst *npc.NPC, target *characters.Character, graph roomGraph) {
+	if !g.canNPCMove(inst, aggroChaseInterval) {
+		return
+	}
+
+	room, err := g.Facade.RoomsService().FindByID(inst.CurrentRoomID)
+	if err != nil {
+		return
+	}
+	exit := graph.nextExitTowards(room, target.CurrentRoomID, inst.EnemyTrait.AggroRadius)
+	if exit == nil {
+		return
+	}
+	next, err := g.Facade.RoomsService().FindByID(exit.Target)
+	if err != nil {
+		return
+	}
+	g.moveNPC(inst, room, next, exit.Name, nil)
+}
+
+// startAggroCombat starts a fight between the aggressive NPC and the player, party members in the room join in
+func (g *Game) startAggroCombat(inst *npc.NPC, target *characters.Character) bool {
+	if !g.dispatchAggro(inst, target) {
+		return false
+	}
+	roomID := target.CurrentRoomID
+
+	// enemies that call for help pull their aggressive friends into the fight
+	enemies := []*npc.NPC{inst}
+	if inst.EnemyTrait.CallForHelp {
+		for _, nearby := range g.NPCManager.GetInstancesInRoom(roomID) {
+			if nearby.Entity.ID == inst.Entity.ID || !nearby.IsEnemy() || nearby.IsDead || g.CombatController.IsNPCInCombat(nearby.Entity.ID) {
+				continue
+			}
+			if nearby.EnemyTrait.CallForHelp || nearby.EnemyTrait.AggroOnSight {
+				enemies = append(enemies, nearby)
+			}
+		}
+	}
+
+	log.WithFields(log.Fields{
+		"npc":       inst.GetTargetName(),
+		"character": target.Name,
+		"room":      roomID,
+	}).Info("Enemy aggroed on player")
+
+	return g.startNPCCombat(enemies, target)
+}
+
+// startNPCCombat starts a fight of the enemies against the player, the first enemy is the attacker
+// Party members in the room join in and the enemies run their OnAggroScript
+func (g *Game) startNPCCombat(enemies []*npc.NPC, target *characters.Character) bool {
+	if len(enemies) == 0 {
+		return false
+	}
+	inst := enemies[0]
+	roomID := target.CurrentRoomID
+
+	players := []*characters.Character{target}
+	for _, member := range commands.GetPartyMembersInRoom(g, target, roomID) {
+		if member.CurrentHitPoints > 0 && !g.CombatController.IsPlayerInCombat(member.Entity.ID) {
+			players = append(players, member)
+		}
+	}
+
+	instance := g.CombatController.InitiateCombat(roomID, players, enemies)
+	if instance == nil {
+		return false
+	}
+
+	for _, player := range players {
+		player.InCombat = true
+		player.CombatInstanceID = instance.ID
+		g.Facade.CharactersService().Update(player.ID, player)
+	}
+	for _, enemy := range enemies {
+		g.NPCManager.UpdateInstance(enemy.Entity.ID, func(n *npc.NPC) {
+			n.InCombat = true
+			n.CombatInstanceID = instance.ID
+			n.State = "combat"
+		})
+	}
+	g.CombatController.runAggroScripts(instance, enemies)
+
+	var enemyNames []string
+	for _, e := range enemies {
+		enemyNames = append(enemyNames, e.GetDisplayName())
+	}
+
+	for _, player := range players {
+		g.CombatController.SetAutoAttackTarget(player.Entity.ID, inst.Entity.ID)
+
+		intro := fmt.Sprintf("%s attacks you!", inst.GetDisplayName())
+		if player.ID != target.ID {
+			intro = fmt.Sprintf("%s attacks %s! You join the fight.", inst.GetDisplayName(), target.Name)
+		}
+		startMsg := commands.CombatStartMessage(g.CombatController, instance, player.Entity.ID, intro, enemyNames)
+
+		g.SendMessage() <- m.MessageResponse{
+			Audience:   m.MessageAudienceUser,
+			AudienceID: player.BelongsUserID,
+			Type:       m.MessageTypeCombatStart,
+			Message:    startMsg,
+		}
+	}
+
+	g.SendMessage() <- m.MessageResponse{
+		Audience:   m.MessageAudienceRoomWithoutOrigin,
+		AudienceID: roomID,
+		OriginID:   target.BelongsUserID,
+		Type:       m.MessageTypeCombatStart,
+		Message:    fmt.Sprintf("%s attacks %s!", strings.Join(enemyNames, ", "), target.Name),
+	}
+	return true
+}
@@ -3,15 +3,21 @@ package game
 import (
 	"fmt"
 	"strings"
+	"sync"
 	"time"
 
 	log "github.com/sirupsen/logrus"
 	"github.com/talesmud/talesmud/pkg/entities/characters"
 	"github.com/talesmud/talesmud/pkg/entities/combat"
+	"github.com/talesmud/talesmud/pkg/entities/effects"
 	npc "github.com/talesmud/talesmud/pkg/entities/npcs"
+	"github.com/talesmud/talesmud/pkg/entities/quests"
+	"github.com/talesmud/talesmud/pkg/entities/skills"
 	combatpkg "github.com/talesmud/talesmud/pkg/mudserver/game/combat"
+	"github.com/talesmud/talesmud/pkg/mudserver/game/commands"
 	"github.com/talesmud/talesmud/pkg/mudserver/game/def"
 	"github.com/talesmud/talesmud/pkg/mudserver/game/messages"
+	"github.com/talesmud/talesmud/pkg/scripts/events"
 )
 
 // CombatController wraps the combat engine and implements CombatEngineCtrl interface
@@ -19,6 +25,9 @@ type CombatController struct {
 	manager *combatpkg.Manager
 	engine  *combatpkg.Engine
 	game    *Game
+
+	// tickMu keeps the combat tick and the world snapshot apart
+	tickMu sync.Mutex
 }
 
 // NewCombatController creates a new combat controller
@@ -50,7 +59,98 @@ func (c *CombatController) GetCombatInstance(characterID string) *combat.CombatI
 
 // InitiateCombat starts combat between players and enemies
 func (c *CombatController) InitiateCombat(roomID string, players []*characters.Character, enemies []*npc.NPC) *combat.CombatInstance {
-	return c.engine.InitiateCombat(roomID, players, enemies)
+	instance := c.engine.InitiateCombat(roomID, players, enemies)
+	if instance == nil {
+		return nil
+	}
+
+	// fighting players can't keep trading
+	for _, player := range players {
+		c.game.TradeManager.CancelTrade(player.ID, player.Name+" is in combat")
+	}
+
+	ctx := events.NewEventContext(events.EventCombatStart).
+		Set("combatId", instance.ID).
+		Set("players", players).
+		Set("enemies", enemies)
+	if room, err := c.game.Facade.RoomsService().FindByID(roomID); err == nil {
+		ctx.WithRoom(room)
+	}
+	if len(players) > 0 {
+		ctx.WithCharacter(players[0])
+	}
+	if len(enemies) > 0 {
+		ctx.WithNPC(enemies[0])
+	}
+	c.game.DispatchEvent(ctx)
+
+	return instance
+}
+
+// runAggroScripts runs the aggro scripts of enemies that attacked on their own
+// Fights started by players don't run them
+func (c *CombatController) runAggroScripts(instance *combat.CombatInstance, enemies []*npc.NPC) {
+	for _, enemy := range enemies {
+		if enemy.EnemyTrait == nil || enemy.EnemyTrait.OnAggroScript == "" {
+			continue
+		}
+		outcome := runEnemyScript(c.game.Facade, enemy.EnemyTrait.OnAggroScript, events.EventNPCAggro, enemy, instance, nil)
+		c.addReinforcements(instance, outcome.Reinforcements)
+	}
+}
+
+// addReinforcements spawns NPCs requested by an enemy script and adds them to the running fight
+func (c *CombatController) addReinforcements(instance *combat.CombatInstance, templateIDs []string) {
+	if len(templateIDs) == 0 {
+		return
+	}
+	for _, reinforcement := range c.game.NPCManager.spawnReinforcements(templateIDs, instance.OriginRoomID) {
+		if !c.engine.AddEnemy(instance, reinforcement) {
+			continue
+		}
+		c.game.NPCManager.UpdateInstance(reinforcement.Entity.ID, func(n *npc.NPC) {
+			n.InCombat = true
+			n.CombatInstanceID = instance.ID
+			n.State = "combat"
+		})
+		c.notifyPlayersInCombat(instance, fmt.Sprintf("%s joins the fight!", reinforcement.GetDisplayName()))
+	}
+}
+
+// processNPCFlee lets an NPC try to escape, a successful escape runs its flee script
+func (c *CombatController) processNPCFlee(instance *combat.CombatInstance, combatant *combat.CombatantRef) {
+	result := c.engine.ProcessFlee(instance, combatant.ID)
+	c.notifyPlayersInCombat(instance, result.Message)
+	if !result.Success {
+		return
+	}
+
+	npcEntity := c.game.NPCManager.GetInstance(combatant.ID)
+	if npcEntity == nil {
+		return
+	}
+	c.game.DispatchEvent(events.NewEventContext(events.EventNPCFlee).
+		WithNPC(npcEntity).
+		Set("combatId", instance.ID))
+
+	if npcEntity.EnemyTrait != nil && npcEntity.EnemyTrait.OnFleeScript != "" {
+		outcome := runEnemyScript(c.game.Facade, npcEntity.EnemyTrait.OnFleeScript, events.EventNPCFlee, npcEntity, instance, nil)
+		c.addReinforcements(instance, outcome.Reinforcements)
+	}
+}
+
+// JoinCombat adds a player to a running combat instance
+func (c *CombatController) JoinCombat(instanceID string, character *characters.Character) bool {
+	instance := c.manager.GetInstance(instanceID)
+	if instance == nil || instance.State != combat.CombatStateActive {
+		return false
+	}
+	if instance.GetPlayerByID(character.Entity.ID) != nil {
+		return false
+	}
+
+	c.notifyPlayersInCombat(instance, fmt.Sprintf("%s joins the fight!", character.Name))
+	return c.engine.AddPlayer(instance, character)
 }
 
 // ProcessPlayerAttack handles a player attacking a target in combat
@@ -184,6 +284,9 @@ func (c *CombatController) GetCombatStatus(characterID string) string {
 			status = " [FLED]"
 		}
 		sb.WriteString(fmt.Sprintf("%s%-16s %s %d/%d HP%s\n", marker, player.Name, hpBar, player.CurrentHP, player.MaxHP, status))
+		if summary := player.Effects.Summary(time.Now()); summary != "" && player.IsAlive {
+			sb.WriteString(fmt.Sprintf("    Effects: %s\n", summary))
+		}
 	}
 
 	sb.WriteString("\nENEMIES:\n")
@@ -194,6 +297,9 @@ func (c *CombatController) GetCombatStatus(characterID string) string {
 			status = " [DEAD]"
 		}
 		sb.WriteString(fmt.Sprintf("  %-16s %s %d/%d HP%s\n", enemy.Name, hpBar, enemy.CurrentHP, enemy.MaxHP, status))
+		if summary := enemy.Effects.Summary(time.Now()); summary != "" && enemy.IsAlive {
+			sb.WriteString(fmt.Sprintf("    Effects: %s\n", summary))
+		}
 	}
 
 	// Show turn order
@@ -212,6 +318,7 @@ func (c *CombatController) GetCombatStatus(characterID string) string {
 	// Show auto-attack target and queued action for the player
 	player := instance.GetPlayerByID(characterID)
 	if player != nil {
+		sb.WriteString(fmt.Sprintf("\nMana: %d/%d  Stamina: %d/%d", player.CurrentMana, player.MaxMana, player.CurrentStamina, player.MaxStamina))
 		if player.AutoAttackTargetID != "" {
 			target := instance.GetCombatantByID(player.AutoAttackTargetID)
 			if target != nil && target.IsAlive {
@@ -226,11 +333,16 @@ func (c *CombatController) GetCombatStatus(characterID string) string {
 					queuedInfo = fmt.Sprintf("attack %s", target.Name)
 				}
 			}
+			if player.QueuedAction == combat.CombatActionSkill {
+				if skill, err := c.game.Facade.SkillsService().FindByID(player.QueuedSkillID); err == nil && skill != nil {
+					queuedInfo = fmt.Sprintf("cast %s", skill.Name)
+				}
+			}
 			sb.WriteString(fmt.Sprintf("\nQueued action: %s", queuedInfo))
 		}
 	}
 
-	sb.WriteString("\n\nCombat is automatic. Commands: attack <target> (switch target) | defend | flee | status")
+	sb.WriteString("\n\nCombat is automatic. Commands: attack <target> (switch target) | cast <skill> [target] | defend | flee | status")
 	sb.WriteString("\n═══════════════════════════════════════════════════════")
 
 	return sb.String()
@@ -293,9 +405,7 @@ func (c *CombatController) processNPCTurns(instance *combat.CombatInstance) {
 			c.notifyPlayersInCombat(instance, actionMsg)
 
 		case combat.CombatActionFlee:
-			result := c.engine.ProcessFlee(instance, current.ID)
-			actionMsg = result.Message
-			c.notifyPlayersInCombat(instance, actionMsg)
+			c.processNPCFlee(instance, current)
 		}
 
 		// Advance turn
@@ -366,6 +476,9 @@ func createHPBar(current, max int32) string {
 
 // Update handles combat updates (called from game loop)
 func (c *CombatController) Update() {
+	c.tickMu.Lock()
+	defer c.tickMu.Unlock()
+
 	instances := c.manager.GetActiveInstances()
 
 	for _, instance := range instances {
@@ -381,6 +494,13 @@ func (c *CombatController) Update() {
 	}
 }
 
+// Snapshot returns deep copies of the active fights, taken between two combat ticks
+func (c *CombatController) Snapshot() ([]*combat.CombatInstance, error) {
+	c.tickMu.Lock()
+	defer c.tickMu.Unlock()
+	return c.manager.SnapshotActiveInstances()
+}
+
 // processAllTurns processes all combatant turns (NPC and player) in sequence
 func (c *CombatController) processAllTurns(instance *combat.CombatInstance) {
 	maxTurns := len(instance.TurnOrder) + 2 // Safety limit per tick
@@ -390,8 +510,12 @@ func (c *CombatController) processAllTurns(instance *combat.CombatInstance) {
 		if current == nil {
 			break
 		}
+		round := instance.Round
+		stunned := c.engine.IsStunned(instance, current.ID)
 
-		if current.Type == combat.CombatantTypeNPC {
+		if stunned {
+			c.notifyPlayersInCombat(instance, fmt.Sprintf("%s is stunned and can't act!", current.Name))
+		} else if current.Type == combat.CombatantTypeNPC {
 			// Process NPC turn
 			npcEntity := c.game.NPCManager.GetInstance(current.ID)
 			action, targetID := c.engine.GetNPCAIAction(instance, current, npcEntity)
@@ -412,17 +536,26 @@ func (c *CombatController) processAllTurns(instance *combat.CombatInstance) {
 				result := c.engine.ProcessDefend(instance, current.ID)
 				c.notifyPlayersInCombat(instance, result.Message)
 			case combat.CombatActionFlee:
-				result := c.engine.ProcessFlee(instance, current.ID)
-				c.notifyPlayersInCombat(instance, result.Message)
+				c.processNPCFlee(instance, current)
 			}
 		} else {
 			// Process player turn via auto-attack
 			c.processPlayerAutoAttack(instance, current)
 		}
 
+		// Hasted combatants get an additional attack
+		if !stunned && c.engine.IsHasted(instance, current.ID) && c.engine.CheckCombatEnd(instance) == combat.CombatStateActive {
+			c.processHasteAttack(instance, current.ID)
+		}
+
 		// Advance turn
 		c.engine.NextTurn(instance)
 
+		// Status effects tick at the end of each round
+		if instance.Round != round {
+			c.processStatusEffects(instance)
+		}
+
 		// Check if combat ended
 		endState := c.engine.CheckCombatEnd(instance)
 		if endState != combat.CombatStateActive {
@@ -433,8 +566,102 @@ func (c *CombatController) processAllTurns(instance *combat.CombatInstance) {
 	}
 }
 
+// processHasteAttack performs the additional attack of a hasted combatant
+func (c *CombatController) processHasteAttack(instance *combat.CombatInstance, combatantID string) {
+	combatant := instance.GetCombatantByID(combatantID)
+	if combatant == nil || !combatant.IsAlive || combatant.HasFled {
+		return
+	}
+
+	if combatant.Type == combat.CombatantTypePlayer {
+		c.doAutoAttack(instance, combatant)
+		return
+	}
+
+	npcEntity := c.game.NPCManager.GetInstance(combatant.ID)
+	action, targetID := c.engine.GetNPCAIAction(instance, combatant, npcEntity)
+	if action != combat.CombatActionAttack || targetID == "" {
+		return
+	}
+	result := c.engine.ProcessAttack(instance, combatant.ID, targetID)
+	c.notifyPlayersInCombat(instance, result.Message)
+	if result.TargetDied {
+		if target := instance.GetCombatantByID(targetID); target != nil && target.Type == combat.CombatantTypePlayer {
+			c.syncPlayerHP(targetID, 0)
+		}
+	}
+}
+
+// processStatusEffects applies damage over time and expires status effects at the end of a round
+func (c *CombatController) processStatusEffects(instance *combat.CombatInstance) {
+	result := c.engine.ProcessStatusEffects(instance)
+	for _, message := range result.Messages {
+		c.notifyPlayersInCombat(instance, message)
+	}
+	for _, id := range result.Died {
+		if target := instance.GetCombatantByID(id); target != nil && target.Type == combat.CombatantTypePlayer {
+			c.syncPlayerHP(id, 0)
+		}
+	}
+}
+
+// ApplyStatusEffect applies a status effect to a player or NPC in combat
+// Returns inCombat false if the combatant is not in combat, the caller applies the effect to the entity instead
+func (c *CombatController) ApplyStatusEffect(combatantID string, effect effects.StatusEffect) (inCombat bool, applied bool) {
+	instance := c.manager.GetInstanceByPlayerID(combatantID)
+	if instance == nil {
+		instance = c.manager.GetInstanceByNPCID(combatantID)
+	}
+	if instance == nil {
+		return false, false
+	}
+
+	if !c.engine.ApplyEffect(instance, combatantID, effect) {
+		return true, false
+	}
+	if combatant := instance.GetCombatantByID(combatantID); combatant != nil {
+		c.notifyPlayersInCombat(instance, fmt.Sprintf("%s is affected by %s.", combatant.Name, effect.DisplayName()))
+	}
+	return true, true
+}
+
+// RemoveStatusEffect removes a status effect by ID or type from a player or NPC in combat
+// Returns false if the combatant is not in combat
+func (c *CombatController) RemoveStatusEffect(combatantID string, idOrType string) (inCombat bool, removed bool) {
+	instance := c.manager.GetInstanceByPlayerID(combatantID)
+	if instance == nil {
+		instance = c.manager.GetInstanceByNPCID(combatantID)
+	}
+	if instance == nil {
+		return false, false
+	}
+	return true, c.engine.RemoveEffect(instance, combatantID, idOrType)
+}
+
+// HealCombatant heals a player or NPC in combat with a skill used by someone outside the fight
+// Returns inCombat false if the combatant is not in combat, the caller heals the entity instead
+func (c *CombatController) HealCombatant(combatantID string, healer *characters.Character, skill *skills.Skill, amount int32) (inCombat bool, healed int32) {
+	instance := c.manager.GetInstanceByPlayerID(combatantID)
+	if instance == nil {
+		instance = c.manager.GetInstanceByNPCID(combatantID)
+	}
+	if instance == nil {
+		return false, 0
+	}
+
+	target, healed := c.engine.HealCombatant(instance, healer.ID, healer.Name, skill, combatantID, amount)
+	if target == nil {
+		return true, 0
+	}
+	c.notifyPlayersInCombat(instance, fmt.Sprintf("%s uses %s on %s and heals %d. (%d/%d HP)", healer.Name, skill.Name, target.Name, healed, target.CurrentHP, target.MaxHP))
+	return true, healed
+}
+
 // cleanupCombatInstance cleans up after combat ends
 func (c *CombatController) cleanupCombatInstance(instance *combat.CombatInstance, endState combat.CombatState) {
+	room, _ := c.game.Facade.RoomsService().FindByID(instance.OriginRoomID)
+	chars := make(map[string]*characters.Character)
+
 	// Clear combat state from players
 	for _, player := range instance.Players {
 		char, err := c.game.Facade.CharactersService().FindByID(player.ID)
@@ -444,31 +671,182 @@ func (c *CombatController) cleanupCombatInstance(instance *combat.CombatInstance
 		char.InCombat = false
 		char.CombatInstanceID = ""
 
-		// Sync HP
+		// Sync HP, resources and cooldowns
 		char.CurrentHitPoints = player.CurrentHP
+		char.CurrentMana = player.CurrentMana
+		char.CurrentStamina = player.CurrentStamina
+		char.SkillCooldowns = player.SkillCooldowns
+		char.ResourcesUpdatedAt = time.Now()
+
+		// Round based effects only last for the fight, death clears all effects
+		char.StatusEffects = player.Effects.WithoutRoundBased()
+		if !player.IsAlive {
+			char.StatusEffects = nil
+		}
 
 		c.game.Facade.CharactersService().Update(player.ID, char)
+		chars[player.ID] = char
+
+		if !player.IsAlive {
+			c.game.DispatchEvent(events.NewEventContext(events.EventPlayerDeath).
+				WithCharacter(char).
+				WithRoom(room).
+				Set("combatId", instance.ID))
+		}
 	}
 
 	// Clear combat state from NPCs
+	loot := make(map[string]*LootDropResult)
+	reinforcements := make([]string, 0)
 	for _, enemy := range instance.Enemies {
 		c.game.NPCManager.UpdateInstance(enemy.ID, func(n *npc.NPC) {
 			n.InCombat = false
 			n.CombatInstanceID = ""
 			n.CurrentHitPoints = enemy.CurrentHP
+			n.StatusEffects = enemy.Effects.WithoutRoundBased()
 			if enemy.IsAlive {
 				n.State = "idle"
 			}
 		})
+
+		if !enemy.IsAlive {
+			var killer interface{}
+			killerLevel := int32(1)
+			if char, ok := chars[findKillerID(instance, enemy.ID)]; ok {
+				killer = char
+				killerLevel = char.Level
+			}
+			targetIDs := []string{enemy.ID}
+			if n := c.game.NPCManager.GetInstance(enemy.ID); n != nil {
+				targetIDs = append(targetIDs, n.TemplateID)
+			}
+
+			if killed, outcome := c.game.NPCManager.killInstance(enemy.ID, killer, instance); killed {
+				// every surviving participant gets credit for kill objectives
+				for _, player := range instance.Players {
+					if char, ok := chars[player.ID]; ok && player.IsAlive {
+						commands.UpdateQuestProgress(c.game, char, quests.ObjectiveTypeKill, 1, targetIDs...)
+					}
+				}
+
+				// the death script can suppress the loot drop
+				if n := c.game.NPCManager.GetInstance(enemy.ID); n != nil && room != nil && !outcome.SuppressLoot {
+					if result, err := DropLootFromNPC(c.game.Facade, n, room, killerLevel); err == nil {
+						loot[enemy.ID] = result
+						// gold is split among the players in distributeRewards
+						if msg := FormatLootMessage(&LootDropResult{Items: result.Items}, enemy.Name); msg != "" {
+							c.game.sendMessage <- messages.MessageResponse{
+								Audience:   messages.MessageAudienceRoom,
+								AudienceID: room.ID,
+								Type:       messages.MessageTypeCombatEnd,
+								Message:    msg,
+							}
+						}
+					}
+				}
+				reinforcements = append(reinforcements, outcome.Reinforcements...)
+			}
+		}
 	}
 
+	c.distributeRewards(instance, loot, chars)
+
 	// Remove the instance
 	c.manager.RemoveInstance(instance.ID)
 
+	c.game.DispatchEvent(events.NewEventContext(events.EventCombatEnd).
+		WithRoom(room).
+		Set("combatId", instance.ID).
+		Set("endState", string(endState)))
+
 	log.WithFields(log.Fields{
 		"instanceID": instance.ID,
 		"endState":   endState,
 	}).Info("Combat instance cleaned up")
+
+	c.startReinforcementFight(instance, reinforcements, chars)
+}
+
+// startReinforcementFight spawns the reinforcements requested by death scripts, they attack the surviving players
+func (c *CombatController) startReinforcementFight(instance *combat.CombatInstance, templateIDs []string, chars map[string]*characters.Character) {
+	if len(templateIDs) == 0 {
+		return
+	}
+	spawned := c.game.NPCManager.spawnReinforcements(templateIDs, instance.OriginRoomID)
+	if len(spawned) == 0 {
+		return
+	}
+
+	for _, player := range instance.Players {
+		char, ok := chars[player.ID]
+		if !ok || !player.IsAlive || player.HasFled || char.CurrentRoomID != instance.OriginRoomID {
+			continue
+		}
+		c.game.startNPCCombat(spawned, char)
+		return
+	}
+}
+
+// distributeRewards splits XP and the dropped gold of all defeated enemies among the surviving players
+func (c *CombatController) distributeRewards(instance *combat.CombatInstance, loot map[string]*LootDropResult, chars map[string]*characters.Character) {
+	var totalXP int64
+	var totalGold int64
+	for _, enemy := range instance.Enemies {
+		if enemy.IsAlive {
+			continue
+		}
+		if n := c.game.NPCManager.GetInstance(enemy.ID); n != nil && n.EnemyTrait != nil {
+			totalXP += n.EnemyTrait.XPReward
+		}
+		if drop, ok := loot[enemy.ID]; ok {
+			totalGold += drop.Gold
+		}
+	}
+	if totalXP == 0 && totalGold == 0 {
+		return
+	}
+
+	receivers := make([]*characters.Character, 0)
+	for _, player := range instance.Players {
+		if char, ok := chars[player.ID]; ok && player.IsAlive && !player.HasFled {
+			receivers = append(receivers, char)
+		}
+	}
+	if len(receivers) == 0 {
+		return
+	}
+
+	xpShare := totalXP / int64(len(receivers))
+	goldShare := totalGold / int64(len(receivers))
+
+	for _, char := range receivers {
+		levelUps := c.game.Facade.CharactersService().GainXP(char, int32(xpShare), "combat")
+		char.Gold += goldShare
+		c.game.Facade.CharactersService().Update(char.ID, char)
+
+		msg := fmt.Sprintf("You receive %d XP and %d gold.", xpShare, goldShare)
+		if len(receivers) > 1 {
+			msg = fmt.Sprintf("You receive %d XP and %d gold (split between %d players).", xpShare, goldShare, len(receivers))
+		}
+		c.game.sendMessage <- messages.MessageResponse{
+			Audience:   messages.MessageAudienceUser,
+			AudienceID: char.BelongsUserID,
+			Type:       messages.MessageTypeCombatEnd,
+			Message:    msg,
+		}
+		commands.NotifyLevelUps(c.game, char, levelUps)
+	}
+}
+
+// findKillerID returns the ID of the combatant who dealt the last damage to the target
+func findKillerID(instance *combat.CombatInstance, targetID string) string {
+	for i := len(instance.Log) - 1; i >= 0; i-- {
+		entry := instance.Log[i]
+		if entry.TargetID == targetID && entry.Damage > 0 {
+			return entry.ActorID
+		}
+	}
+	return ""
 }
 
 // QueuePlayerAction queues an action for a player's next auto-attack turn
@@ -485,6 +863,25 @@ func (c *CombatController) QueuePlayerAction(characterID string, action combat.C
 
 	player.QueuedAction = action
 	player.QueuedTargetID = targetID
+	player.QueuedSkillID = ""
+	c.engine.UpdateCombatant(instance, player)
+}
+
+// QueuePlayerSkill queues a skill for a player's next turn
+func (c *CombatController) QueuePlayerSkill(characterID string, skillID string, targetID string) {
+	instance := c.manager.GetInstanceByPlayerID(characterID)
+	if instance == nil {
+		return
+	}
+
+	player := instance.GetPlayerByID(characterID)
+	if player == nil {
+		return
+	}
+
+	player.QueuedAction = combat.CombatActionSkill
+	player.QueuedTargetID = targetID
+	player.QueuedSkillID = skillID
 	c.engine.UpdateCombatant(instance, player)
 }
 
@@ -521,6 +918,11 @@ func (c *CombatController) processPlayerAutoAttack(instance *combat.CombatInstan
 			result := c.engine.ProcessDefend(instance, player.ID)
 			c.notifyPlayersInCombat(instance, result.Message)
 
+		case combat.CombatActionSkill:
+			if !c.processPlayerSkill(instance, player) {
+				c.doAutoAttack(instance, player)
+			}
+
 		case combat.CombatActionAttack:
 			targetID := player.QueuedTargetID
 			if targetID != "" {
@@ -552,6 +954,7 @@ func (c *CombatController) processPlayerAutoAttack(instance *combat.CombatInstan
 		if playerRef != nil {
 			playerRef.QueuedAction = ""
 			playerRef.QueuedTargetID = ""
+			playerRef.QueuedSkillID = ""
 			c.engine.UpdateCombatant(instance, playerRef)
 		}
 		return
@@ -561,6 +964,35 @@ func (c *CombatController) processPlayerAutoAttack(instance *combat.CombatInstan
 	c.doAutoAttack(instance, player)
 }
 
+// processPlayerSkill uses the queued skill of a player, returns false if the skill could not be used
+func (c *CombatController) processPlayerSkill(instance *combat.CombatInstance, player *combat.CombatantRef) bool {
+	skill, err := c.game.Facade.SkillsService().FindByID(player.QueuedSkillID)
+	if err != nil || skill == nil {
+		return false
+	}
+
+	result := c.engine.ProcessSkill(instance, player.ID, skill, player.QueuedTargetID)
+	c.notifyPlayersInCombat(instance, result.Message)
+	if !result.Success {
+		return false
+	}
+
+	for _, id := range result.Died {
+		if target := instance.GetCombatantByID(id); target != nil && target.Type == combat.CombatantTypePlayer {
+			c.syncPlayerHP(id, 0)
+		}
+	}
+
+	if skill.ScriptID != "" {
+		char, err := c.game.Facade.CharactersService().FindByID(player.ID)
+		if err != nil {
+			return true
+		}
+		commands.RunSkillScript(c.game, char, skill, result.Affected, result.Total)
+	}
+	return true
+}
+
 // doAutoAttack performs the default auto-attack for a player
 func (c *CombatController) doAutoAttack(instance *combat.CombatInstance, player *combat.CombatantRef) {
 	targetID := player.AutoAttackTargetID
@@ -0,0 +1,68 @@
+package game
+
+import (
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/talesmud/talesmud/pkg/entities/rooms"
+	m "github.com/talesmud/talesmud/pkg/mudserver/game/messages"
+	"github.com/talesmud/talesmud/pkg/mudserver/game/util"
+)
+
+// resetDoors puts the doors of the room back into their default state once their reset time passed,
+// returns true if the stored room may differ from the given copy
+func (g *Game) resetDoors(room *rooms.Room, now time.Time) bool {
+	if room.Exits == nil {
+		return false
+	}
+
+	changed := false
+	for _, exit := range *room.Exits {
+		if exit.Door == nil || !exit.Door.NeedsReset(now) {
+			continue
+		}
+
+		// the other side may have been reset already, UpdateDoor checks the stored state again
+		changed = true
+		change, err := util.UpdateDoor(g, room.ID, exit.Name, func(door *rooms.Door) bool {
+			return door.Reset(now)
+		})
+		if err != nil {
+			log.WithError(err).WithField("room", room.ID).Error("Could not reset door")
+			continue
+		}
+		if change == nil {
+			continue
+		}
+
+		log.WithFields(log.Fields{
+			"room": room.ID,
+			"exit": exit.Name,
+		}).Debug("Door reset")
+
+		g.announceDoorReset(change.Room.ID, change.Exit)
+		if change.LinkedExit != nil {
+			g.announceDoorReset(change.LinkedRoomID, change.LinkedExit)
+		}
+	}
+	return changed
+}
+
+// announceDoorReset tells the players in the room that a door changed by itself
+func (g *Game) announceDoorReset(roomID string, exit *rooms.Exit) {
+	text := "The " + exit.Door.DisplayName() + " (" + exit.Name + ")"
+	switch exit.Door.State() {
+	case rooms.DoorStateLocked:
+		text += " swings shut and its lock clicks."
+	case rooms.DoorStateClosed:
+		text += " swings shut."
+	default:
+		text += " swings open."
+	}
+
+	g.SendMessage() <- m.MessageResponse{
+		Audience:   m.MessageAudienceRoom,
+		AudienceID: roomID,
+		Message:    text,
+	}
+}
@@ -0,0 +1,40 @@
+package game
+
+import (
+	log "github.com/sirupsen/logrus"
+	"github.com/talesmud/talesmud/pkg/scripts/events"
+)
+
+// ReloadEventHandlers implements def.GameCtrl.ReloadEventHandlers
+func (g *Game) ReloadEventHandlers() {
+	g.loadEventHandlers()
+}
+
+// loadEventHandlers registers the event bindings of all event scripts
+func (g *Game) loadEventHandlers() {
+	allScripts, err := g.Facade.ScriptsService().FindAll()
+	if err != nil {
+		log.WithError(err).Error("Failed to load event scripts")
+		return
+	}
+
+	g.Events.LoadScripts(allScripts)
+
+	log.WithField("handlers", g.Events.Stats()).Info("Event handlers loaded")
+}
+
+// DispatchEvent runs all script handlers registered for the event, returns true if a handler canceled it
+func (g *Game) DispatchEvent(ctx *events.EventContext) bool {
+	if g.Events == nil || ctx == nil || !g.Events.HasHandlers(ctx.EventType) {
+		return false
+	}
+
+	results := g.Events.Dispatch(ctx.EventType, ctx)
+	for _, result := range results {
+		if !result.Success {
+			log.WithField("event", ctx.EventType).WithField("script", result.ScriptID).WithField("error", result.Error).Warn("Event handler failed")
+		}
+	}
+
+	return events.IsCanceled(results)
+}
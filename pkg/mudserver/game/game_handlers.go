@@ -1,11 +1,15 @@
 package game
 
 import (
+	"strconv"
 	"time"
 
 	log "github.com/sirupsen/logrus"
 	"github.com/talesmud/talesmud/pkg/entities"
+	"github.com/talesmud/talesmud/pkg/entities/characters"
+	c "github.com/talesmud/talesmud/pkg/mudserver/game/commands"
 	"github.com/talesmud/talesmud/pkg/mudserver/game/messages"
+	"github.com/talesmud/talesmud/pkg/scripts/events"
 )
 
 func (game *Game) handleDefaultMessage(message *messages.Message) {
@@ -51,6 +55,16 @@ func (game *Game) handleUserQuit(user *entities.User) {
 			Message:    character.Name + " left.",
 		},
 	}
+
+	game.TradeManager.CancelTrade(character.ID, character.Name+" went offline")
+
+	// the party is kept so the character can rejoin it after reconnecting
+	c.NotifyParty(game, character, character.Name+" has gone offline.")
+
+	game.DispatchEvent(events.NewEventContext(events.EventPlayerQuit).
+		WithCharacter(character).
+		WithRoom(room).
+		Set("user", user))
 }
 
 // Find the matching character for the user where the message originated
@@ -107,5 +121,20 @@ func (game *Game) handleUserJoined(user *entities.User) {
 		// send message as userwould do it
 		selectCharacterMsg := messages.NewMessage(user, "selectcharacter "+character.Name)
 		game.OnMessageReceived() <- selectCharacterMsg
+
+		game.notifyUnreadMail(user, character)
+	}
+}
+
+// notifyUnreadMail tells a joining player about mail that arrived while they were offline
+func (game *Game) notifyUnreadMail(user *entities.User, character *characters.Character) {
+	unread, err := game.Facade.MailService().CountUnread(character.ID)
+	if err != nil || unread == 0 {
+		return
+	}
+	text := "You have 1 unread mail."
+	if unread > 1 {
+		text = "You have " + strconv.Itoa(unread) + " unread mails."
 	}
+	game.SendMessage() <- messages.Reply(user.ID, text+" Use 'mail list' to see your mail.")
 }
@@ -0,0 +1,200 @@
+package game
+
+import (
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/talesmud/talesmud/pkg/entities/dialogs"
+	npc "github.com/talesmud/talesmud/pkg/entities/npcs"
+	"github.com/talesmud/talesmud/pkg/entities/rooms"
+)
+
+// idle dialog limits
+const (
+	// idleLineCooldown is the time before an NPC repeats an idle line to a character that already heard it
+	idleLineCooldown = 10 * time.Minute
+	// maxIdleDialogNodes limits how many nodes are checked for an unheard line per update
+	maxIdleDialogNodes = 20
+)
+
+// idleDialogState tracks the position of an NPC in its idle dialog and who heard which line
+type idleDialogState struct {
+	nodeID   string
+	visits   map[string]int
+	lastLine time.Time
+	// heard maps a character ID to the lines it heard and when
+	heard map[string]map[string]time.Time
+}
+
+// updateIdleDialog lets an NPC speak the next line of its idle dialog to the players in its room
+// The dialog is walked along the answers of the nodes and starts over after a dialog exit
+func (g *Game) updateIdleDialog(inst *npc.NPC) {
+	if !inst.HasIdleDialog() || inst.IdleDialogTimeout <= 0 {
+		return
+	}
+
+	now := time.Now()
+	state, ok := g.idleDialogs[inst.Entity.ID]
+	if !ok {
+		// the first line is spoken after the timeout, not right after the spawn
+		state = &idleDialogState{
+			nodeID:   "main",
+			visits:   make(map[string]int),
+			lastLine: now,
+			heard:    make(map[string]map[string]time.Time),
+		}
+		g.idleDialogs[inst.Entity.ID] = state
+		return
+	}
+	if now.Sub(state.lastLine) < inst.IdleDialogTimeout {
+		return
+	}
+
+	room, err := g.Facade.RoomsService().FindByID(inst.CurrentRoomID)
+	if err != nil || room.Characters == nil || len(*room.Characters) == 0 {
+		return
+	}
+	// don't interrupt players talking to the NPC
+	if g.isNPCInDialog(inst) {
+		return
+	}
+
+	dialog, err := g.Facade.DialogsService().FindByID(inst.IdleDialogID)
+	if err != nil || dialog == nil {
+		log.WithError(err).WithField("dialogID", inst.IdleDialogID).Debug("Idle dialog not found")
+		return
+	}
+
+	audience := g.idleDialogAudience(room)
+	if len(audience) == 0 {
+		return
+	}
+
+	// skip lines everyone present heard recently, stay quiet if the whole dialog was heard
+	for tries := 0; tries < maxIdleDialogNodes; tries++ {
+		node := dialog.FindDialog(state.nodeID)
+		if node == nil {
+			node = dialog
+		}
+
+		visits := state.visits[node.NodeID] + 1
+		dialogState := dialogs.NewDialogState()
+		dialogState.CurrentDialogID = node.NodeID
+		dialogState.DialogVisited = map[string]int{node.NodeID: visits}
+		dialogState.Context["NPC"] = inst.GetDisplayName()
+		dialogState.Context["ROOM"] = room.Name
+		if len(audience) == 1 {
+			dialogState.Context["PLAYER"] = audience[0].name
+		}
+		line := strings.TrimSpace(node.Render(dialogState))
+
+		state.nodeID = nextIdleDialogNode(dialog, node)
+		if line == "" || state.heardByAll(audience, line, now) {
+			continue
+		}
+
+		state.visits[node.NodeID] = visits
+		state.lastLine = now
+		for _, listener := range audience {
+			state.markHeard(listener.id, line, now)
+		}
+
+		g.sendToRoomIfOccupied(room, inst.GetDisplayName()+" says: \""+line+"\"")
+		return
+	}
+
+	// nothing new to say, try again after the next timeout
+	state.lastLine = now
+}
+
+// pruneIdleDialogs forgets the idle dialog state of dead or removed NPC instances and lines heard before the cooldown
+func (g *Game) pruneIdleDialogs(instances []*npc.NPC) {
+	alive := make(map[string]bool, len(instances))
+	for _, inst := range instances {
+		if !inst.IsDead {
+			alive[inst.Entity.ID] = true
+		}
+	}
+
+	now := time.Now()
+	for id, state := range g.idleDialogs {
+		if !alive[id] {
+			delete(g.idleDialogs, id)
+			continue
+		}
+		for characterID, lines := range state.heard {
+			for text, heardAt := range lines {
+				if now.Sub(heardAt) >= idleLineCooldown {
+					delete(lines, text)
+				}
+			}
+			if len(lines) == 0 {
+				delete(state.heard, characterID)
+			}
+		}
+	}
+}
+
+// idleListener is a character that can hear an idle line
+type idleListener struct {
+	id   string
+	name string
+}
+
+// idleDialogAudience returns the online characters in the room
+func (g *Game) idleDialogAudience(room *rooms.Room) []idleListener {
+	audience := make([]idleListener, 0)
+	for _, characterID := range *room.Characters {
+		character, err := g.Facade.CharactersService().FindByID(characterID)
+		if err != nil || character.CurrentRoomID != room.ID {
+			continue
+		}
+		user, err := g.Facade.UsersService().FindByID(character.BelongsUserID)
+		if err != nil || !user.IsOnline || user.LastCharacter != character.ID {
+			continue
+		}
+		audience = append(audience, idleListener{id: character.ID, name: character.Name})
+	}
+	return audience
+}
+
+// nextIdleDialogNode returns the node spoken after the given node, idle dialogs restart after an exit
+func nextIdleDialogNode(dialog *dialogs.Dialog, node *dialogs.Dialog) string {
+	if node.IsDialogExit != nil && *node.IsDialogExit {
+		return dialog.NodeID
+	}
+	if node.Answer != nil {
+		return node.Answer.NodeID
+	}
+	if len(node.Options) > 0 {
+		return node.Options[0].NodeID
+	}
+	return dialog.NodeID
+}
+
+// heardByAll returns true if every listener heard the line within the cooldown
+func (s *idleDialogState) heardByAll(audience []idleListener, line string, now time.Time) bool {
+	for _, listener := range audience {
+		heardAt, ok := s.heard[listener.id][line]
+		if !ok || now.Sub(heardAt) >= idleLineCooldown {
+			return false
+		}
+	}
+	return true
+}
+
+// markHeard remembers that the character heard the line and forgets lines heard before the cooldown
+func (s *idleDialogState) markHeard(characterID string, line string, now time.Time) {
+	lines, ok := s.heard[characterID]
+	if !ok {
+		lines = make(map[string]time.Time)
+		s.heard[characterID] = lines
+	}
+	for text, heardAt := range lines {
+		if now.Sub(heardAt) >= idleLineCooldown {
+			delete(lines, text)
+		}
+	}
+	lines[line] = now
+}
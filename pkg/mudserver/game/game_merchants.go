@@ -0,0 +1,46 @@
+package game
+
+import (
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	npc "github.com/talesmud/talesmud/pkg/entities/npcs"
+)
+
+// restockMerchant refills the stock of a merchant instance when its restock time has come
+// Merchants without a schedule (new spawns, older snapshots) get one without being refilled
+func (g *Game) restockMerchant(inst *npc.NPC) {
+	if !inst.IsMerchant() || inst.MerchantTrait.RestockMinutes <= 0 {
+		return
+	}
+
+	restocked := false
+	var nextRestock time.Time
+	g.NPCManager.UpdateInstance(inst.Entity.ID, func(n *npc.NPC) {
+		trait := n.MerchantTrait
+		if trait == nil {
+			return
+		}
+		if trait.NextRestock.IsZero() {
+			from := trait.LastRestock
+			if from.IsZero() {
+				from = time.Now()
+			}
+			trait.ScheduleRestock(from)
+			return
+		}
+		if trait.NeedsRestock() {
+			trait.Restock()
+			restocked = true
+			nextRestock = trait.NextRestock
+		}
+	})
+
+	if restocked {
+		log.WithFields(log.Fields{
+			"npc":         inst.GetTargetName(),
+			"room":        inst.CurrentRoomID,
+			"nextRestock": nextRestock,
+		}).Debug("Merchant restocked")
+	}
+}
@@ -1,13 +1,31 @@
 package game
 
 import (
+	"fmt"
+	"math/rand"
+	"time"
+
 	log "github.com/sirupsen/logrus"
 	npc "github.com/talesmud/talesmud/pkg/entities/npcs"
+	"github.com/talesmud/talesmud/pkg/entities/rooms"
+	m "github.com/talesmud/talesmud/pkg/mudserver/game/messages"
+)
+
+// movement timing of wandering and patrolling NPCs
+const (
+	defaultWanderInterval = 45 * time.Second
+	defaultPatrolInterval = 20 * time.Second
+	// npcDialogHoldTime keeps an NPC in place while a player talks to it
+	npcDialogHoldTime = time.Minute
+	// maxPatrolDetour is the longest way back to the patrol path, e.g. after an NPC chased a player
+	maxPatrolDetour = 10
 )
 
 // handleNPCUpdates processes all NPC instances for state updates
 func (g *Game) handleNPCUpdates() {
 	instances := g.NPCManager.GetAllInstances()
+	// the room graph is only loaded if an NPC moves this tick
+	graph := g.roomGraphLoader()
 
 	for _, inst := range instances {
 		// Skip templates (shouldn't be in manager, but safety check)
@@ -23,18 +41,28 @@ func (g *Game) handleNPCUpdates() {
 			continue
 		}
 
+		// Merchants refill their stock on schedule
+		g.restockMerchant(inst)
+
+		// NPCs that are not fighting speak their idle dialog
+		if inst.State == "idle" || inst.State == "patrol" {
+			g.updateIdleDialog(inst)
+		}
+
 		// Update NPC based on state
 		switch inst.State {
 		case "idle":
-			g.updateIdleNPC(inst)
+			g.updateIdleNPC(inst, graph)
 		case "patrol":
-			g.updatePatrolNPC(inst)
+			g.updatePatrolNPC(inst, graph)
 		case "combat":
 			// Future: combat logic in separate PRD
 		case "fleeing":
 			// Future: flee logic
 		}
 	}
+
+	g.pruneIdleDialogs(instances)
 }
 
 // respawnNPC resets an NPC to alive state at its spawn room
@@ -49,23 +77,24 @@ func (g *Game) respawnNPC(inst *npc.NPC) {
 }
 
 // updateIdleNPC handles idle state behavior
-func (g *Game) updateIdleNPC(inst *npc.NPC) {
-	// Future: check aggro radius for nearby players
-	// For now, just handle wandering if configured
-	if inst.WanderRadius > 0 {
-		// TODO: implement wandering behavior
-		// This would pick a random adjacent room within wander radius
+func (g *Game) updateIdleNPC(inst *npc.NPC, graph func() roomGraph) {
+	// NPCs with a patrol path return to patrolling, e.g. after combat reset them to idle
+	if inst.IsPatrolling() {
+		g.NPCManager.UpdateInstance(inst.Entity.ID, func(n *npc.NPC) {
+			n.State = "patrol"
+		})
+		g.updatePatrolNPC(inst, graph)
+		return
 	}
 
-	// Check if NPC has idle dialog and should trigger
-	if inst.HasIdleDialog() && inst.IdleDialogTimeout > 0 {
-		// TODO: implement idle dialog triggering
+	if inst.IsWandering() {
+		g.wanderNPC(inst, graph)
 	}
 }
 
-// updatePatrolNPC handles patrol state behavior
-func (g *Game) updatePatrolNPC(inst *npc.NPC) {
-	if len(inst.PatrolPath) == 0 {
+// updatePatrolNPC handles patrol state behavior, the NPC walks through exits to the next room of its path
+func (g *Game) updatePatrolNPC(inst *npc.NPC, graph func() roomGraph) {
+	if !inst.IsPatrolling() {
 		// No patrol path, switch to idle
 		g.NPCManager.UpdateInstance(inst.Entity.ID, func(n *npc.NPC) {
 			n.State = "idle"
@@ -73,8 +102,275 @@ func (g *Game) updatePatrolNPC(inst *npc.NPC) {
 		return
 	}
 
-	// TODO: implement patrol path following
-	// This would track current patrol index and move to next room
+	if !g.canNPCMove(inst, defaultPatrolInterval) {
+		return
+	}
+
+	room, err := g.Facade.RoomsService().FindByID(inst.CurrentRoomID)
+	if err != nil {
+		return
+	}
+
+	index, reverse := inst.NextPatrolStep()
+	targetID := inst.PatrolPath[index]
+	if targetID == room.ID {
+		// single room patrol path or already there
+		g.NPCManager.UpdateInstance(inst.Entity.ID, func(n *npc.NPC) {
+			n.PatrolIndex, n.PatrolReverse = index, reverse
+		})
+		return
+	}
+
+	exit := graph().nextExitTowards(room, targetID, maxPatrolDetour)
+	if exit == nil {
+		log.WithFields(log.Fields{
+			"npc":  inst.GetTargetName(),
+			"room": targetID,
+		}).Warn("Patrol room can't be reached through exits, skipping it")
+		g.skipPatrolStep(inst, targetID, index, reverse)
+		return
+	}
+
+	next, err := g.Facade.RoomsService().FindByID(exit.Target)
+	if err != nil {
+		return
+	}
+	if next.ID != targetID {
+		// not next to the path room (yet), walk towards it and keep the patrol position
+		g.moveNPC(inst, room, next, exit.Name, nil)
+		return
+	}
+	g.moveNPC(inst, room, next, exit.Name, func(n *npc.NPC) {
+		n.PatrolIndex, n.PatrolReverse = index, reverse
+	})
+}
+
+// skipPatrolStep continues the patrol with the step after a room the NPC can't reach
+func (g *Game) skipPatrolStep(inst *npc.NPC, targetID string, index int, reverse bool) {
+	skipped := *inst
+	skipped.CurrentRoomID = targetID
+	skipped.PatrolIndex, skipped.PatrolReverse = index, reverse
+	index, reverse = skipped.NextPatrolStep()
+	g.NPCManager.UpdateInstance(inst.Entity.ID, func(n *npc.NPC) {
+		n.PatrolIndex, n.PatrolReverse = index, reverse
+	})
+}
+
+// wanderNPC moves the NPC through a random visible exit, staying within WanderRadius of its spawn room
+func (g *Game) wanderNPC(inst *npc.NPC, graph func() roomGraph) {
+	if !g.canNPCMove(inst, defaultWanderInterval) {
+		return
+	}
+	// wanderers take a break now and then so they don't move in lockstep
+	if rand.Intn(2) == 0 {
+		return
+	}
+
+	room, err := g.Facade.RoomsService().FindByID(inst.CurrentRoomID)
+	if err != nil || room.Exits == nil {
+		return
+	}
+
+	home := inst.SpawnRoomID
+	if home == "" {
+		home = room.ID
+	}
+	reachable := graph().withinDistance(home, inst.WanderRadius, false)
+
+	candidates := make([]rooms.Exit, 0, len(*room.Exits))
+	for _, exit := range *room.Exits {
+		if exit.Hidden || !exit.CanPass() || exit.Target == "" || exit.Target == room.ID {
+			continue
+		}
+		if _, ok := reachable[exit.Target]; ok {
+			candidates = append(candidates, exit)
+		}
+	}
+	if len(candidates) == 0 {
+		return
+	}
+
+	exit := candidates[rand.Intn(len(candidates))]
+	target, err := g.Facade.RoomsService().FindByID(exit.Target)
+	if err != nil {
+		return
+	}
+	g.moveNPC(inst, room, target, exit.Name, nil)
+}
+
+// canNPCMove returns true if the NPC is free to move and its move interval has passed
+func (g *Game) canNPCMove(inst *npc.NPC, defaultInterval time.Duration) bool {
+	if inst.IsDead || inst.InCombat {
+		return false
+	}
+	if g.CombatController != nil && g.CombatController.IsNPCInCombat(inst.Entity.ID) {
+		return false
+	}
+
+	interval := inst.MoveInterval
+	if interval <= 0 {
+		interval = defaultInterval
+	}
+	if time.Since(inst.LastMoveTime) < interval {
+		return false
+	}
+
+	return !g.isNPCInDialog(inst)
+}
+
+// isNPCInDialog returns true if a character in the NPC's room recently talked to it
+func (g *Game) isNPCInDialog(inst *npc.NPC) bool {
+	if !inst.HasDialog() {
+		return false
+	}
+	room, err := g.Facade.RoomsService().FindByID(inst.CurrentRoomID)
+	if err != nil || room.Characters == nil {
+		return false
+	}
+
+	now := time.Now()
+	for _, characterID := range *room.Characters {
+		conv, err := g.Facade.ConversationsService().FindByCharacterAndTarget(characterID, inst.Entity.ID)
+		if err == nil && conv != nil && conv.InteractedWithin(npcDialogHoldTime, now) {
+			return true
+		}
+	}
+	return false
+}
+
+// moveNPC moves the NPC to the target room and notifies the players in both rooms
+func (g *Game) moveNPC(inst *npc.NPC, from *rooms.Room, to *rooms.Room, exitName string, update func(*npc.NPC)) bool {
+	if !g.NPCManager.MoveInstance(inst.Entity.ID, to.ID) {
+		return false
+	}
+	g.NPCManager.UpdateInstance(inst.Entity.ID, func(n *npc.NPC) {
+		n.LastMoveTime = time.Now()
+		if update != nil {
+			update(n)
+		}
+	})
+
+	name := inst.GetDisplayName()
+	leaves := name + " leaves."
+	if exitName != "" {
+		leaves = fmt.Sprintf("%s leaves %s.", name, exitName)
+	}
+	g.sendToRoomIfOccupied(from, leaves)
+	g.sendToRoomIfOccupied(to, name+" arrives.")
+
+	log.WithFields(log.Fields{
+		"npc":  inst.GetTargetName(),
+		"from": from.ID,
+		"to":   to.ID,
+	}).Debug("NPC moved")
+	return true
+}
+
+// sendToRoomIfOccupied sends a message to all characters in the room, rooms without characters are skipped
+func (g *Game) sendToRoomIfOccupied(room *rooms.Room, message string) {
+	if room.Characters == nil || len(*room.Characters) == 0 {
+		return
+	}
+	g.SendMessage() <- m.MessageResponse{
+		Audience:   m.MessageAudienceRoom,
+		AudienceID: room.ID,
+		Type:       m.MessageTypeDefault,
+		Message:    message,
+	}
+}
+
+// roomGraph maps room IDs to the rooms of the world, it is loaded once and searched in memory
+type roomGraph map[string]*rooms.Room
+
+// loadRoomGraph loads all rooms, an empty graph is returned if they can't be loaded
+func (g *Game) loadRoomGraph() roomGraph {
+	graph := make(roomGraph)
+	all, err := g.Facade.RoomsService().FindAll()
+	if err != nil {
+		log.WithError(err).Error("Could not load rooms for NPC movement")
+		return graph
+	}
+	for _, room := range all {
+		graph[room.ID] = room
+	}
+	return graph
+}
+
+// roomGraphLoader returns a function that loads the room graph on its first call and reuses it afterwards
+func (g *Game) roomGraphLoader() func() roomGraph {
+	var graph roomGraph
+	return func() roomGraph {
+		if graph == nil {
+			graph = g.loadRoomGraph()
+		}
+		return graph
+	}
+}
+
+// withinDistance returns the exit distance of all rooms reachable from the start room within maxDistance steps
+func (graph roomGraph) withinDistance(startID string, maxDistance int, includeHidden bool) map[string]int {
+	distances := map[string]int{startID: 0}
+	frontier := []string{startID}
+
+	for distance := 1; distance <= maxDistance && len(frontier) > 0; distance++ {
+		next := make([]string, 0)
+		for _, roomID := range frontier {
+			room, ok := graph[roomID]
+			if !ok || room.Exits == nil {
+				continue
+			}
+			for _, exit := range *room.Exits {
+				if exit.Target == "" || (exit.Hidden && !includeHidden) {
+					continue
+				}
+				if _, seen := distances[exit.Target]; seen {
+					continue
+				}
+				distances[exit.Target] = distance
+				next = append(next, exit.Target)
+			}
+		}
+		frontier = next
+	}
+	return distances
+}
+
+// nextExitTowards returns the first exit of the shortest passable path from the room to the target room
+// within maxDistance steps, hidden exits are not used
+func (graph roomGraph) nextExitTowards(from *rooms.Room, targetID string, maxDistance int) *rooms.Exit {
+	// firstExits maps every room found to the exit of the start room its path begins with
+	firstExits := map[string]rooms.Exit{}
+	frontier := []*rooms.Room{from}
+
+	for distance := 1; distance <= maxDistance && len(frontier) > 0; distance++ {
+		next := make([]*rooms.Room, 0)
+		for _, room := range frontier {
+			if room.Exits == nil {
+				continue
+			}
+			for _, exit := range *room.Exits {
+				if exit.Hidden || !exit.CanPass() || exit.Target == "" || exit.Target == from.ID {
+					continue
+				}
+				if _, seen := firstExits[exit.Target]; seen {
+					continue
+				}
+				first := exit
+				if room.ID != from.ID {
+					first = firstExits[room.ID]
+				}
+				if exit.Target == targetID {
+					return &first
+				}
+				firstExits[exit.Target] = first
+				if target, ok := graph[exit.Target]; ok {
+					next = append(next, target)
+				}
+			}
+		}
+		frontier = next
+	}
+	return nil
 }
 
 // updateNPC is a helper for individual NPC updates (deprecated, use handleNPCUpdates)
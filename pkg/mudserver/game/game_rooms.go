@@ -1,6 +1,8 @@
 package game
 
 import (
+	"time"
+
 	"github.com/sirupsen/logrus"
 	log "github.com/sirupsen/logrus"
 	"github.com/talesmud/talesmud/pkg/entities/rooms"
@@ -75,7 +77,14 @@ func (g *Game) handleRoomUpdates() {
 
 	if allRooms, err := g.Facade.RoomsService().FindAll(); err == nil {
 
+		now := time.Now()
 		for _, room := range allRooms {
+			if g.resetDoors(room, now) {
+				// continue with the stored room so the door state is not overwritten
+				if updated, err := g.Facade.RoomsService().FindByID(room.ID); err == nil {
+					room = updated
+				}
+			}
 			if needsUpdate(room) {
 				go g.updateRoom(room)
 			}
@@ -0,0 +1,152 @@
+package game
+
+import (
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/talesmud/talesmud/pkg/entities/combat"
+	npc "github.com/talesmud/talesmud/pkg/entities/npcs"
+	"github.com/talesmud/talesmud/pkg/entities/snapshots"
+)
+
+const snapshotInterval = 60 // World state is saved every minute
+
+// SaveWorldState stores NPC instances, spawner state and active fights in the world snapshot
+func (g *Game) SaveWorldState() {
+	snapshot := snapshots.NewWorldSnapshot()
+	if err := g.NPCManager.Snapshot(snapshot); err != nil {
+		log.WithError(err).Error("Failed to copy NPC instances for the world snapshot")
+		return
+	}
+	combats, err := g.CombatController.Snapshot()
+	if err != nil {
+		log.WithError(err).Error("Failed to copy combat instances for the world snapshot")
+		return
+	}
+	snapshot.CombatInstances = combats
+
+	if err := g.Facade.WorldSnapshotsService().Save(snapshot); err != nil {
+		log.WithError(err).Error("Failed to save world snapshot")
+		return
+	}
+
+	log.WithFields(log.Fields{
+		"npcs":    len(snapshot.NPCInstances),
+		"combats": len(snapshot.CombatInstances),
+	}).Debug("World snapshot saved")
+}
+
+// restoreCombatState restores active fights from the world snapshot. Fights whose participants
+// no longer exist are dropped, as are fights without an online player, nobody could act in them.
+// reconcileCombatFlags clears the combat flags of the dropped fights afterwards.
+func (g *Game) restoreCombatState() {
+	snapshot, err := g.Facade.WorldSnapshotsService().Get()
+	if err != nil || snapshot == nil {
+		return
+	}
+
+	restored, dropped := 0, 0
+	for _, instance := range snapshot.CombatInstances {
+		if instance == nil || instance.State != combat.CombatStateActive || !g.canRestoreCombat(instance) {
+			continue
+		}
+		if !g.hasOnlinePlayer(instance) {
+			dropped++
+			continue
+		}
+
+		// the fight continues where it stopped, the turn timer starts again
+		instance.TurnStartTime = time.Now()
+		instance.LastActionAt = time.Now()
+
+		g.CombatController.manager.RestoreInstance(instance)
+		restored++
+	}
+
+	if restored > 0 {
+		log.WithField("count", restored).Info("Restored combat instances from world snapshot")
+	}
+	if dropped > 0 {
+		log.WithField("count", dropped).Info("Dropped saved fights of offline players")
+	}
+}
+
+// hasOnlinePlayer returns true if a user plays one of the characters of the fight right now
+func (g *Game) hasOnlinePlayer(instance *combat.CombatInstance) bool {
+	for _, player := range instance.Players {
+		character, err := g.Facade.CharactersService().FindByID(player.ID)
+		if err != nil || character == nil {
+			continue
+		}
+		user, err := g.Facade.UsersService().FindByID(character.BelongsUserID)
+		if err == nil && user != nil && user.IsOnline && user.LastCharacter == character.ID {
+			return true
+		}
+	}
+	return false
+}
+
+// canRestoreCombat checks that all participants of a saved fight still exist
+func (g *Game) canRestoreCombat(instance *combat.CombatInstance) bool {
+	for _, player := range instance.Players {
+		if _, err := g.Facade.CharactersService().FindByID(player.ID); err != nil {
+			return false
+		}
+	}
+	for _, enemy := range instance.Enemies {
+		n := g.NPCManager.GetInstance(enemy.ID)
+		if n == nil || (enemy.IsAlive && n.IsDead) {
+			return false
+		}
+	}
+	return true
+}
+
+// reconcileCombatFlags clears combat flags of characters and NPCs that are not part of a running fight,
+// e.g. after a crash or when a saved fight could not be restored
+func (g *Game) reconcileCombatFlags() {
+	chars, err := g.Facade.CharactersService().FindAll()
+	if err != nil {
+		log.WithError(err).Warn("Failed to load characters for combat reconciliation")
+		return
+	}
+
+	reconciled := 0
+	for _, char := range chars {
+		instance := g.CombatController.GetCombatInstance(char.ID)
+		switch {
+		case instance != nil && (!char.InCombat || char.CombatInstanceID != instance.ID):
+			char.InCombat = true
+			char.CombatInstanceID = instance.ID
+		case instance == nil && (char.InCombat || char.CombatInstanceID != ""):
+			char.InCombat = false
+			char.CombatInstanceID = ""
+		default:
+			continue
+		}
+
+		if err := g.Facade.CharactersService().Update(char.ID, char); err != nil {
+			log.WithError(err).WithField("character", char.ID).Warn("Failed to reconcile character combat state")
+			continue
+		}
+		reconciled++
+	}
+
+	for _, n := range g.NPCManager.GetAllInstances() {
+		if !n.InCombat || g.CombatController.IsNPCInCombat(n.Entity.ID) {
+			continue
+		}
+		g.NPCManager.UpdateInstance(n.Entity.ID, func(inst *npc.NPC) {
+			inst.InCombat = false
+			inst.CombatInstanceID = ""
+			if !inst.IsDead {
+				inst.State = "idle"
+			}
+		})
+		reconciled++
+	}
+
+	if reconciled > 0 {
+		log.WithField("count", reconciled).Info("Reconciled stale combat flags")
+	}
+}
@@ -38,15 +38,7 @@ func DropLootFromNPC(facade service.Facade, deadNPC *npc.NPC, room *rooms.Room,
 	enemy := deadNPC.EnemyTrait
 
 	// Roll gold drop
-	if enemy.GoldDrop.Max > 0 {
-		goldMin := enemy.GoldDrop.Min
-		goldMax := enemy.GoldDrop.Max
-		if goldMax > goldMin {
-			result.Gold = int64(goldMin) + int64(rand.Intn(int(goldMax-goldMin+1)))
-		} else {
-			result.Gold = int64(goldMin)
-		}
-	}
+	result.Gold = RollGoldDrop(enemy)
 
 	// Process guaranteed loot
 	for _, templateID := range enemy.GuaranteedLoot {
@@ -110,6 +102,19 @@ func DropLootFromNPC(facade service.Facade, deadNPC *npc.NPC, room *rooms.Room,
 	return result, nil
 }
 
+// RollGoldDrop rolls the gold dropped by an enemy between GoldDrop.Min and GoldDrop.Max
+func RollGoldDrop(enemy *npc.EnemyTrait) int64 {
+	if enemy == nil || enemy.GoldDrop.Max <= 0 {
+		return 0
+	}
+	goldMin := enemy.GoldDrop.Min
+	goldMax := enemy.GoldDrop.Max
+	if goldMax > goldMin {
+		return int64(goldMin) + int64(rand.Intn(int(goldMax-goldMin+1)))
+	}
+	return int64(goldMin)
+}
+
 // shuffleItems randomly shuffles a slice of items in place
 func shuffleItems(items []*items.Item) {
 	for i := len(items) - 1; i > 0; i-- {
@@ -11,6 +11,12 @@ type UserJoined struct{ User *e.User }
 //UserQuit ... player joined event
 type UserQuit struct{ User *e.User }
 
+//DisconnectUser ... asks the server to close the connection of a user, e.g. when staff kicks a player
+type DisconnectUser struct {
+	UserID string
+	Reason string
+}
+
 // Message ... main message container to pass data from e to server and back
 type Message struct {
 	FromUser  *e.User
@@ -25,4 +25,14 @@ const (
 
 	// Inventory messages
 	MessageTypeInventoryUpdate = "inventoryUpdate" // Inventory/equipment changed
+
+	// Trade messages
+	MessageTypeTradeUpdate = "tradeUpdate" // Trade window with the offers of both players
+	MessageTypeTradeEnd    = "tradeEnd"    // Trade completed or canceled
+
+	// Chat messages
+	MessageTypeChat = "chat" // Say, emote, whisper, tell and channel messages
+
+	// Progression messages
+	MessageTypeLevelUp = "levelUp" // Character reached a new level
 )
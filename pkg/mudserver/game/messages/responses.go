@@ -1,10 +1,14 @@
 package messages
 
 import (
+	"fmt"
+	"strings"
+
 	"github.com/talesmud/talesmud/pkg/entities"
 	e "github.com/talesmud/talesmud/pkg/entities"
 	"github.com/talesmud/talesmud/pkg/entities/characters"
 	"github.com/talesmud/talesmud/pkg/entities/rooms"
+	"github.com/talesmud/talesmud/pkg/entities/trades"
 	"github.com/talesmud/talesmud/pkg/mudserver/game/def"
 	"github.com/talesmud/talesmud/pkg/mudserver/game/util"
 )
@@ -49,6 +53,8 @@ const (
 	MessageAudienceRoomWithoutOrigin
 	MessageAudienceGlobal
 	MessageAudienceSystem
+	// MessageAudienceParty sends to all online members of the party with the AudienceID
+	MessageAudienceParty
 )
 
 // MessageResponse ... Define our message object
@@ -167,6 +173,17 @@ func NewRoomBasedMessage(user string, message string) MessageResponse {
 	}
 }
 
+// NewPartyMessage creates a message for all members of a party
+func NewPartyMessage(partyID string, user string, message string) MessageResponse {
+	return MessageResponse{
+		Audience:   MessageAudienceParty,
+		AudienceID: partyID,
+		Type:       MessageTypeDefault,
+		Message:    message,
+		Username:   user,
+	}
+}
+
 // Reply ... creates a reply message
 func Reply(userID string, message string) MessageResponse {
 	return MessageResponse{
@@ -245,6 +262,44 @@ func NewInventoryUpdateMessage(msg *Message) *InventoryUpdateMessage {
 	}
 }
 
+// LevelUpMessage informs the client about a new character level
+type LevelUpMessage struct {
+	MessageResponse
+	Level          int32            `json:"level"`
+	XP             int32            `json:"xp"`
+	NextLevelXP    int32            `json:"nextLevelXp"`
+	MaxHitPoints   int32            `json:"maxHitPoints"`
+	HitPointsGain  int32            `json:"hitPointsGained"`
+	AttributeGains map[string]int32 `json:"attributeGains,omitempty"`
+}
+
+// NewLevelUpMessage creates a level-up message for the owner of the character
+func NewLevelUpMessage(character *characters.Character, levelUp characters.LevelUpRecord, nextLevelXP int32) *LevelUpMessage {
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("You have reached level %d!", levelUp.Level))
+	sb.WriteString(fmt.Sprintf("\n  Max HP +%d", levelUp.HitPointsGained))
+	for _, attr := range character.Attributes {
+		if gain, ok := levelUp.AttributeGains[attr.Short]; ok {
+			sb.WriteString(fmt.Sprintf("\n  %s +%d", attr.Name, gain))
+		}
+	}
+
+	return &LevelUpMessage{
+		MessageResponse: MessageResponse{
+			Audience:   MessageAudienceUser,
+			AudienceID: character.BelongsUserID,
+			Type:       MessageTypeLevelUp,
+			Message:    sb.String(),
+		},
+		Level:          levelUp.Level,
+		XP:             levelUp.XP,
+		NextLevelXP:    nextLevelXP,
+		MaxHitPoints:   character.MaxHitPoints,
+		HitPointsGain:  levelUp.HitPointsGained,
+		AttributeGains: levelUp.AttributeGains,
+	}
+}
+
 // NewDialogEndMessage creates a message indicating the conversation has ended
 func NewDialogEndMessage(userID, npcName, message string) MessageResponse {
 	return MessageResponse{
@@ -255,3 +310,98 @@ func NewDialogEndMessage(userID, npcName, message string) MessageResponse {
 		Username:   npcName,
 	}
 }
+
+// TradeWindowMessage shows the offers of an open trade to one of the two players
+type TradeWindowMessage struct {
+	MessageResponse
+	TradeID string           `json:"tradeId"`
+	Partner string           `json:"partner"`
+	Own     trades.TradeSide `json:"own"`
+	Their   trades.TradeSide `json:"their"`
+}
+
+// NewTradeWindowMessage creates the trade window as seen by the character
+func NewTradeWindowMessage(trade *trades.Trade, characterID string) *TradeWindowMessage {
+	own := trade.Side(characterID)
+	their := trade.OtherSide(characterID)
+	if own == nil || their == nil {
+		return nil
+	}
+
+	var sb strings.Builder
+	sb.WriteString("=== Trade with " + their.Name + " ===\n")
+	writeTradeOffer(&sb, "You offer:", own)
+	writeTradeOffer(&sb, their.Name+" offers:", their)
+	sb.WriteString(fmt.Sprintf("You: %s | %s: %s\n", tradeConfirmation(own), their.Name, tradeConfirmation(their)))
+	sb.WriteString("Commands: trade offer <item> | trade gold <amount> | trade remove <item> | trade confirm | trade cancel")
+
+	return &TradeWindowMessage{
+		MessageResponse: MessageResponse{
+			Audience:   MessageAudienceUser,
+			AudienceID: own.UserID,
+			Type:       MessageTypeTradeUpdate,
+			Message:    sb.String(),
+		},
+		TradeID: trade.ID,
+		Partner: their.Name,
+		Own:     *own,
+		Their:   *their,
+	}
+}
+
+// NewTradeEndMessage tells the user that the trade was completed or canceled
+func NewTradeEndMessage(userID, message string) MessageResponse {
+	return MessageResponse{
+		Audience:   MessageAudienceUser,
+		AudienceID: userID,
+		Type:       MessageTypeTradeEnd,
+		Message:    message,
+	}
+}
+
+func writeTradeOffer(sb *strings.Builder, title string, side *trades.TradeSide) {
+	sb.WriteString(title + "\n")
+	if len(side.Items) == 0 && side.Gold == 0 {
+		sb.WriteString("  (nothing)\n")
+		return
+	}
+	for _, item := range side.Items {
+		if item.Quantity > 1 {
+			sb.WriteString(fmt.Sprintf("  %dx %s\n", item.Quantity, item.Name))
+		} else {
+			sb.WriteString("  " + item.Name + "\n")
+		}
+	}
+	if side.Gold > 0 {
+		sb.WriteString(fmt.Sprintf("  %d gold\n", side.Gold))
+	}
+}
+
+func tradeConfirmation(side *trades.TradeSide) string {
+	if side.Confirmed {
+		return "confirmed"
+	}
+	return "not confirmed"
+}
+
+// ChatMessage is a say, emote, whisper, tell or channel message
+type ChatMessage struct {
+	MessageResponse
+	// Channel is the chat channel name or say, emote, whisper, tell
+	Channel string `json:"channel"`
+	Sender  string `json:"sender,omitempty"`
+}
+
+// NewChatMessage creates a chat message for a single user
+func NewChatMessage(userID, channel, sender, message string) *ChatMessage {
+	return &ChatMessage{
+		MessageResponse: MessageResponse{
+			Audience:   MessageAudienceUser,
+			AudienceID: userID,
+			Type:       MessageTypeChat,
+			Message:    message,
+		},
+		Channel: channel,
+		Sender:  sender,
+	}
+}
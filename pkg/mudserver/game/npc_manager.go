@@ -1,11 +1,15 @@
 package game
 
 import (
+	"encoding/json"
 	"sync"
 	"time"
 
 	log "github.com/sirupsen/logrus"
+	"github.com/talesmud/talesmud/pkg/entities/combat"
 	npc "github.com/talesmud/talesmud/pkg/entities/npcs"
+	"github.com/talesmud/talesmud/pkg/entities/snapshots"
+	"github.com/talesmud/talesmud/pkg/scripts/events"
 	"github.com/talesmud/talesmud/pkg/service"
 )
 
@@ -18,7 +22,7 @@ type SpawnerState struct {
 }
 
 // NPCInstanceManager manages in-memory NPC instances
-// Instances are spawned from templates and exist only in memory (not persisted)
+// Instances are spawned from templates and live in memory, the game periodically stores them in the world snapshot
 type NPCInstanceManager struct {
 	mu sync.RWMutex
 
@@ -29,18 +33,40 @@ type NPCInstanceManager struct {
 	spawnerState map[string]*SpawnerState
 
 	facade service.Facade
+
+	// events receives npc.spawn and npc.death events, may be nil
+	events *events.EventRegistry
 }
 
 // NewNPCInstanceManager creates a new NPC instance manager
-func NewNPCInstanceManager(facade service.Facade) *NPCInstanceManager {
+func NewNPCInstanceManager(facade service.Facade, registry *events.EventRegistry) *NPCInstanceManager {
 	return &NPCInstanceManager{
 		instances:    make(map[string]*npc.NPC),
 		spawnerState: make(map[string]*SpawnerState),
 		facade:       facade,
+		events:       registry,
 	}
 }
 
-// Initialize loads all spawners and creates their initial instances
+// dispatchNPCEvent fires an NPC event for the instance, must be called without holding the lock
+// as event scripts may call back into the manager
+func (m *NPCInstanceManager) dispatchNPCEvent(eventType events.EventType, inst *npc.NPC, killer interface{}) {
+	if m.events == nil || !m.events.HasHandlers(eventType) {
+		return
+	}
+
+	ctx := events.NewEventContext(eventType).WithNPC(inst)
+	if room, err := m.facade.RoomsService().FindByID(inst.CurrentRoomID); err == nil {
+		ctx.WithRoom(room)
+	}
+	if killer != nil {
+		ctx.WithCombat(nil, 0, killer)
+	}
+
+	m.events.Dispatch(eventType, ctx)
+}
+
+// Initialize restores the last world snapshot, loads all spawners and creates their initial instances
 func (m *NPCInstanceManager) Initialize() error {
 	// First, load spawners
 	spawners, err := m.facade.NPCSpawnersService().FindAll()
@@ -48,9 +74,22 @@ func (m *NPCInstanceManager) Initialize() error {
 		return err
 	}
 
+	// Restore instances and spawner state from the last snapshot
+	snapshot, err := m.facade.WorldSnapshotsService().Get()
+	if err != nil {
+		log.WithError(err).Warn("Failed to load world snapshot")
+	}
+	if snapshot != nil {
+		m.restoreSnapshot(snapshot, spawners)
+	}
+
 	log.WithField("count", len(spawners)).Info("Initializing NPC spawners")
 
 	for _, spawner := range spawners {
+		if _, restored := m.spawnerState[spawner.ID]; restored {
+			continue
+		}
+
 		m.spawnerState[spawner.ID] = &SpawnerState{
 			ActiveInstances: make([]string, 0),
 			LastSpawnTime:   time.Now(),
@@ -73,6 +112,77 @@ func (m *NPCInstanceManager) Initialize() error {
 	return nil
 }
 
+// restoreSnapshot restores NPC instances and spawner state, instances of removed spawners are dropped
+func (m *NPCInstanceManager) restoreSnapshot(snapshot *snapshots.WorldSnapshot, spawners []*npc.NPCSpawner) {
+	existing := make(map[string]bool)
+	for _, spawner := range spawners {
+		existing[spawner.ID] = true
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for _, inst := range snapshot.NPCInstances {
+		if inst == nil || inst.Entity == nil {
+			continue
+		}
+		m.instances[inst.Entity.ID] = inst
+	}
+
+	for spawnerID, state := range snapshot.Spawners {
+		if !existing[spawnerID] {
+			for _, id := range state.ActiveInstances {
+				delete(m.instances, id)
+			}
+			continue
+		}
+
+		active := make([]string, 0, len(state.ActiveInstances))
+		for _, id := range state.ActiveInstances {
+			if _, ok := m.instances[id]; ok {
+				active = append(active, id)
+			}
+		}
+		m.spawnerState[spawnerID] = &SpawnerState{
+			ActiveInstances: active,
+			LastSpawnTime:   state.LastSpawnTime,
+		}
+	}
+
+	log.WithFields(log.Fields{
+		"instances": len(m.instances),
+		"spawners":  len(m.spawnerState),
+		"savedAt":   snapshot.SavedAt,
+	}).Info("Restored NPC instances from world snapshot")
+}
+
+// Snapshot deep-copies all instances and spawner state into the world snapshot while holding the lock,
+// the copies share no maps or slices with the live instances
+func (m *NPCInstanceManager) Snapshot(snapshot *snapshots.WorldSnapshot) error {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	live := make([]*npc.NPC, 0, len(m.instances))
+	for _, inst := range m.instances {
+		live = append(live, inst)
+	}
+	data, err := json.Marshal(live)
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(data, &snapshot.NPCInstances); err != nil {
+		return err
+	}
+
+	for spawnerID, state := range m.spawnerState {
+		snapshot.Spawners[spawnerID] = &snapshots.SpawnerSnapshot{
+			ActiveInstances: append([]string(nil), state.ActiveInstances...),
+			LastSpawnTime:   state.LastSpawnTime,
+		}
+	}
+	return nil
+}
+
 // initializeResidents loads unique NPCs that are directly assigned to rooms
 func (m *NPCInstanceManager) initializeResidents() error {
 	rooms, err := m.facade.RoomsService().FindAll()
@@ -84,6 +194,11 @@ func (m *NPCInstanceManager) initializeResidents() error {
 	for _, room := range rooms {
 		npcIDs := room.GetNPCIDs()
 		for _, npcID := range npcIDs {
+			// Keep the live state of residents restored from the snapshot
+			if m.GetInstance(npcID) != nil {
+				continue
+			}
+
 			// Load the NPC from the database
 			npcData, err := m.facade.NPCsService().FindByID(npcID)
 			if err != nil {
@@ -145,6 +260,8 @@ func (m *NPCInstanceManager) SpawnInstance(spawner *npc.NPCSpawner) (*npc.NPC, e
 		"room":     spawner.RoomID,
 	}).Info("Spawned NPC instance")
 
+	m.dispatchNPCEvent(events.EventNPCSpawn, instance, nil)
+
 	return instance, nil
 }
 
@@ -166,6 +283,8 @@ func (m *NPCInstanceManager) SpawnInstanceDirect(templateID, roomID string) (*np
 		"room":     roomID,
 	}).Info("Spawned NPC instance directly")
 
+	m.dispatchNPCEvent(events.EventNPCSpawn, instance, nil)
+
 	return instance, nil
 }
 
@@ -246,36 +365,70 @@ func (m *NPCInstanceManager) GetAliveInstances() []*npc.NPC {
 	return result
 }
 
-// KillInstance marks an instance as dead
+// KillInstance marks an instance as dead, reinforcements requested by the death script spawn in its room
 func (m *NPCInstanceManager) KillInstance(id string) bool {
-	m.mu.Lock()
-	defer m.mu.Unlock()
+	killed, outcome := m.killInstance(id, nil, nil)
+	if killed {
+		if inst := m.GetInstance(id); inst != nil {
+			m.spawnReinforcements(outcome.Reinforcements, inst.CurrentRoomID)
+		}
+	}
+	return killed
+}
 
+// killInstance marks an instance as dead, runs its OnDeathScript and fires npc.death with the given killer
+// The outcome of the death script is returned to the caller, which handles loot and reinforcements
+func (m *NPCInstanceManager) killInstance(id string, killer interface{}, instance *combat.CombatInstance) (bool, enemyScriptOutcome) {
+	m.mu.Lock()
 	inst, ok := m.instances[id]
-	if !ok {
-		return false
+	if !ok || inst.IsDead {
+		m.mu.Unlock()
+		return false, enemyScriptOutcome{}
 	}
 
 	inst.IsDead = true
 	inst.DeathTime = time.Now()
 	inst.State = "dead"
 	inst.CurrentHitPoints = 0
+	m.mu.Unlock()
 
 	log.WithFields(log.Fields{
 		"instance": id,
 		"name":     inst.GetDisplayName(),
 	}).Info("NPC instance killed")
 
-	return true
+	outcome := enemyScriptOutcome{}
+	if inst.EnemyTrait != nil && inst.EnemyTrait.OnDeathScript != "" {
+		outcome = runEnemyScript(m.facade, inst.EnemyTrait.OnDeathScript, events.EventNPCDeath, inst, instance, map[string]interface{}{
+			"killer": killer,
+		})
+	}
+
+	m.dispatchNPCEvent(events.EventNPCDeath, inst, killer)
+
+	return true, outcome
+}
+
+// spawnReinforcements spawns instances of the given templates in the room, used by enemy scripts
+func (m *NPCInstanceManager) spawnReinforcements(templateIDs []string, roomID string) []*npc.NPC {
+	spawned := make([]*npc.NPC, 0, len(templateIDs))
+	for _, templateID := range templateIDs {
+		inst, err := m.SpawnInstanceDirect(templateID, roomID)
+		if err != nil {
+			log.WithError(err).WithField("template", templateID).Warn("Failed to spawn reinforcement")
+			continue
+		}
+		spawned = append(spawned, inst)
+	}
+	return spawned
 }
 
 // RespawnInstance resets an instance to alive state
 func (m *NPCInstanceManager) RespawnInstance(id string) bool {
 	m.mu.Lock()
-	defer m.mu.Unlock()
-
 	inst, ok := m.instances[id]
 	if !ok {
+		m.mu.Unlock()
 		return false
 	}
 
@@ -283,7 +436,9 @@ func (m *NPCInstanceManager) RespawnInstance(id string) bool {
 	inst.CurrentHitPoints = inst.MaxHitPoints
 	inst.State = "idle"
 	inst.CurrentRoomID = inst.SpawnRoomID
+	inst.ResetMovement()
 	inst.Updated = time.Now()
+	m.mu.Unlock()
 
 	log.WithFields(log.Fields{
 		"instance": id,
@@ -291,6 +446,8 @@ func (m *NPCInstanceManager) RespawnInstance(id string) bool {
 		"room":     inst.SpawnRoomID,
 	}).Info("NPC instance respawned")
 
+	m.dispatchNPCEvent(events.EventNPCSpawn, inst, nil)
+
 	return true
 }
 
@@ -330,10 +487,9 @@ func (m *NPCInstanceManager) UpdateInstance(id string, updater func(*npc.NPC)) b
 // DamageInstance applies damage to an instance and returns true if it died
 func (m *NPCInstanceManager) DamageInstance(id string, amount int32) (died bool) {
 	m.mu.Lock()
-	defer m.mu.Unlock()
-
 	inst, ok := m.instances[id]
 	if !ok || inst.IsDead {
+		m.mu.Unlock()
 		return false
 	}
 
@@ -345,9 +501,14 @@ func (m *NPCInstanceManager) DamageInstance(id string, amount int32) (died bool)
 		inst.IsDead = true
 		inst.DeathTime = time.Now()
 		inst.State = "dead"
-		return true
+		died = true
 	}
-	return false
+	m.mu.Unlock()
+
+	if died {
+		m.dispatchNPCEvent(events.EventNPCDeath, inst, nil)
+	}
+	return died
 }
 
 // HealInstance restores health to an instance
@@ -0,0 +1,160 @@
+package game
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/talesmud/talesmud/pkg/entities/trades"
+	m "github.com/talesmud/talesmud/pkg/mudserver/game/messages"
+)
+
+// tradeRequestTimeout is the time a player has to accept a trade request
+const tradeRequestTimeout = 2 * time.Minute
+
+// TradeManager keeps the trade windows between players in memory
+// Trades are not part of the world snapshot, they end with a restart
+type TradeManager struct {
+	mu sync.Mutex
+
+	// trades maps trade ID to trade
+	trades map[string]*trades.Trade
+
+	game *Game
+}
+
+// NewTradeManager creates a new trade manager
+func NewTradeManager(game *Game) *TradeManager {
+	return &TradeManager{
+		trades: make(map[string]*trades.Trade),
+		game:   game,
+	}
+}
+
+// findLocked returns the trade of the character, the lock must be held
+func (tm *TradeManager) findLocked(characterID string) *trades.Trade {
+	for _, trade := range tm.trades {
+		if trade.Involves(characterID) {
+			return trade
+		}
+	}
+	return nil
+}
+
+// FindTrade returns a copy of the trade or trade request the character takes part in
+func (tm *TradeManager) FindTrade(characterID string) *trades.Trade {
+	tm.mu.Lock()
+	defer tm.mu.Unlock()
+
+	if trade := tm.findLocked(characterID); trade != nil {
+		return trade.Copy()
+	}
+	return nil
+}
+
+// StartTrade registers a trade request, fails if one of the characters is already trading
+func (tm *TradeManager) StartTrade(trade *trades.Trade) error {
+	tm.mu.Lock()
+	defer tm.mu.Unlock()
+
+	if tm.findLocked(trade.Initiator.CharacterID) != nil {
+		return errors.New("you are already trading")
+	}
+	if tm.findLocked(trade.Partner.CharacterID) != nil {
+		return errors.New(trade.Partner.Name + " is already trading")
+	}
+	tm.trades[trade.ID] = trade
+	return nil
+}
+
+// UpdateTrade changes the trade of the character using a callback and returns a copy of the result
+func (tm *TradeManager) UpdateTrade(characterID string, updater func(*trades.Trade)) *trades.Trade {
+	tm.mu.Lock()
+	defer tm.mu.Unlock()
+
+	trade := tm.findLocked(characterID)
+	if trade == nil {
+		return nil
+	}
+	updater(trade)
+	return trade.Copy()
+}
+
+// CloseTrade removes the trade, returns false if it was already closed
+func (tm *TradeManager) CloseTrade(tradeID string) bool {
+	tm.mu.Lock()
+	defer tm.mu.Unlock()
+
+	if _, ok := tm.trades[tradeID]; !ok {
+		return false
+	}
+	delete(tm.trades, tradeID)
+	return true
+}
+
+// CancelTrade closes the trade of the character and tells both sides why
+func (tm *TradeManager) CancelTrade(characterID string, reason string) bool {
+	trade := tm.FindTrade(characterID)
+	if trade == nil || !tm.CloseTrade(trade.ID) {
+		return false
+	}
+
+	log.WithFields(log.Fields{
+		"trade":  trade.ID,
+		"reason": reason,
+	}).Debug("Trade canceled")
+
+	text := "The trade between " + trade.Initiator.Name + " and " + trade.Partner.Name + " was canceled"
+	if reason != "" {
+		text += ": " + reason
+	}
+	tm.game.SendMessage() <- m.NewTradeEndMessage(trade.Initiator.UserID, text+".")
+	tm.game.SendMessage() <- m.NewTradeEndMessage(trade.Partner.UserID, text+".")
+	return true
+}
+
+// handleTradeUpdates cancels trades of players that moved, entered combat or went offline
+// and trade requests nobody accepted in time
+func (g *Game) handleTradeUpdates() {
+	tm := g.TradeManager
+
+	tm.mu.Lock()
+	open := make([]*trades.Trade, 0, len(tm.trades))
+	for _, trade := range tm.trades {
+		open = append(open, trade.Copy())
+	}
+	tm.mu.Unlock()
+
+	for _, trade := range open {
+		if !trade.Open && time.Since(trade.Created) > tradeRequestTimeout {
+			tm.CancelTrade(trade.Initiator.CharacterID, "the request timed out")
+			continue
+		}
+		for _, side := range []trades.TradeSide{trade.Initiator, trade.Partner} {
+			if reason := g.tradeCancelReason(trade, side); reason != "" {
+				tm.CancelTrade(side.CharacterID, reason)
+				break
+			}
+		}
+	}
+}
+
+// tradeCancelReason returns why the character can't continue the trade, empty if it can
+func (g *Game) tradeCancelReason(trade *trades.Trade, side trades.TradeSide) string {
+	character, err := g.Facade.CharactersService().FindByID(side.CharacterID)
+	if err != nil || character == nil {
+		return side.Name + " is gone"
+	}
+	user, err := g.Facade.UsersService().FindByID(side.UserID)
+	if err != nil || user == nil || !user.IsOnline || user.LastCharacter != character.ID {
+		return side.Name + " went offline"
+	}
+	if character.CurrentRoomID != trade.RoomID {
+		return side.Name + " left the room"
+	}
+	if character.InCombat || g.CombatController.IsPlayerInCombat(character.ID) {
+		return side.Name + " is in combat"
+	}
+	return ""
+}
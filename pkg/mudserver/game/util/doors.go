@@ -0,0 +1,75 @@
+package util
+
+import (
+	"errors"
+
+	"github.com/talesmud/talesmud/pkg/entities/rooms"
+	"github.com/talesmud/talesmud/pkg/mudserver/game/def"
+)
+
+// DoorChange describes a door update, the linked exit is the other side of the door
+type DoorChange struct {
+	Room         *rooms.Room
+	Exit         *rooms.Exit
+	LinkedRoomID string
+	LinkedExit   *rooms.Exit
+}
+
+// UpdateDoor changes the door of the exit using a callback and copies the new state to the door
+// on the other side of the exit pair. The updater returns false if nothing changed.
+func UpdateDoor(game def.GameCtrl, roomID, exitName string, updater func(*rooms.Door) bool) (*DoorChange, error) {
+	// always work on the stored room, the caller may hold an outdated copy
+	room, err := game.GetFacade().RoomsService().FindByID(roomID)
+	if err != nil {
+		return nil, err
+	}
+	exit := room.FindDoor(exitName)
+	if exit == nil {
+		return nil, errors.New("there is no door " + exitName)
+	}
+	if !updater(exit.Door) {
+		return nil, nil
+	}
+	if err := game.GetFacade().RoomsService().Update(room.ID, room); err != nil {
+		return nil, err
+	}
+
+	change := &DoorChange{Room: room, Exit: exit}
+
+	if exit.Target == "" || exit.Target == room.ID {
+		return change, nil
+	}
+	linked, err := game.GetFacade().RoomsService().FindByID(exit.Target)
+	if err != nil {
+		// one sided doors are fine
+		return change, nil
+	}
+	back := linked.ExitTo(room.ID)
+	if back == nil || back.Door == nil {
+		return change, nil
+	}
+	back.Door.Closed = exit.Door.Closed
+	back.Door.Locked = exit.Door.Locked
+	back.Door.ChangedAt = exit.Door.ChangedAt
+	if err := game.GetFacade().RoomsService().Update(linked.ID, linked); err != nil {
+		return change, err
+	}
+
+	change.LinkedRoomID = linked.ID
+	change.LinkedExit = back
+	return change, nil
+}
+
+// ExitDoorSuffix describes the door of an exit for room descriptions, empty for open doors
+func ExitDoorSuffix(exit rooms.Exit) string {
+	if exit.Door == nil {
+		return ""
+	}
+	switch exit.Door.State() {
+	case rooms.DoorStateLocked:
+		return " (the " + exit.Door.DisplayName() + " is closed and locked)"
+	case rooms.DoorStateClosed:
+		return " (the " + exit.Door.DisplayName() + " is closed)"
+	}
+	return " (the " + exit.Door.DisplayName() + " is open)"
+}
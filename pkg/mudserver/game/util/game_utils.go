@@ -92,7 +92,7 @@ func CreateRoomDescription(room *rooms.Room, user *entities.User, game def.GameC
 
 	for _, exit := range *room.Exits {
 		if !exit.Hidden {
-			description += " + [" + exit.Name + "] " + exit.Description + "\n"
+			description += " + [" + exit.Name + "] " + exit.Description + ExitDoorSuffix(exit) + "\n"
 		}
 	}
 
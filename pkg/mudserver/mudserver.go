@@ -22,13 +22,17 @@ type MUDServer interface {
 	Run()
 	GameCtrl() def.GameCtrl
 	HandleConnections(*gin.Context)
+	ListenTelnet(address string) error
+	// Shutdown saves the live world state before the process exits
+	Shutdown()
 }
 
 // Connection ...
 type Connection struct {
-	User *entities.User
-	ws   *websocket.Conn
-	mu   sync.Mutex
+	User   *entities.User
+	ws     *websocket.Conn
+	telnet *telnetConn
+	mu     sync.Mutex
 
 	active bool
 }
@@ -36,9 +40,19 @@ type Connection struct {
 func (p *Connection) send(v interface{}) error {
 	p.mu.Lock()
 	defer p.mu.Unlock()
+	if p.telnet != nil {
+		return p.telnet.writeMessage(v)
+	}
 	return p.ws.WriteJSON(v)
 }
 
+func (p *Connection) close() error {
+	if p.telnet != nil {
+		return p.telnet.Close()
+	}
+	return p.ws.Close()
+}
+
 /*CheckOrigin:
  */
 type server struct {
@@ -88,6 +102,11 @@ func (server *server) Run() {
 	log.WithTime(time.Now()).Info("MUD Server running")
 }
 
+func (server *server) Shutdown() {
+	log.Info("MUD Server shutting down, saving world state ...")
+	server.Game.SaveWorldState()
+}
+
 func (server *server) handleClientTimeouts() {
 
 	pingTicker := time.NewTicker(60 * time.Second)
@@ -198,12 +217,31 @@ func (server *server) sendMessage(id string, msg interface{}) {
 			server.Facade.UsersService().Update(user.RefID, user)
 
 			log.Printf("error: %v", err)
-			client.ws.Close()
+			client.close()
 			delete(server.Clients, id)
 		}
 	}
 }
 
+// sendToParty sends a message to all party members that are currently playing the member character
+func (server *server) sendToParty(partyID string, msg interface{}) {
+	party, err := server.Facade.PartiesService().GetPartyByID(partyID)
+	if err != nil || party == nil {
+		log.WithField("party", partyID).Info("MUDServer::sendToParty - party not found")
+		return
+	}
+
+	for _, characterID := range party.Characters {
+		chr, err := server.Facade.CharactersService().FindByID(characterID)
+		if err != nil {
+			continue
+		}
+		if client, ok := server.Clients[chr.BelongsUserID]; ok && client.User.LastCharacter == chr.ID {
+			server.sendMessage(chr.BelongsUserID, msg)
+		}
+	}
+}
+
 func (server *server) sendToRoom(room *rooms.Room, msg interface{}) {
 	server.sendToRoomWithout("", room, msg)
 }
@@ -265,7 +303,7 @@ func (server *server) handleBroadcastMessages() {
 					User: client.User,
 				}
 
-				client.ws.Close()
+				client.close()
 				delete(server.Clients, client.User.ID)
 
 			}
@@ -287,6 +325,11 @@ func (server *server) receiveMessages() {
 			server.runRoomEnterScript(enter)
 		}
 
+		if disconnect, ok := message.(messages.DisconnectUser); ok {
+			server.disconnectUser(disconnect)
+			continue
+		}
+
 		if msg, ok := message.(messages.MessageResponder); ok {
 			switch msg.GetAudience() {
 			case messages.MessageAudienceOrigin:
@@ -308,6 +351,9 @@ func (server *server) receiveMessages() {
 			case messages.MessageAudienceGlobal:
 				server.Broadcast <- msg
 				break
+			case messages.MessageAudienceParty:
+				server.sendToParty(msg.GetAudienceID(), msg)
+				break
 			case messages.MessageAudienceSystem:
 
 				server.Broadcast <- messages.MessageResponse{
@@ -320,6 +366,27 @@ func (server *server) receiveMessages() {
 	}
 }
 
+// disconnectUser tells the user why and closes the connection, the read loop of the connection cleans up
+func (server *server) disconnectUser(disconnect messages.DisconnectUser) {
+	client, ok := server.Clients[disconnect.UserID]
+	if !ok {
+		return
+	}
+	if disconnect.Reason != "" {
+		client.send(messages.Reply(disconnect.UserID, disconnect.Reason))
+	}
+
+	// the telnet read loop reports the quit itself, the websocket loop only marks the user offline
+	if client.ws != nil {
+		server.Game.OnUserQuit <- &messages.UserQuit{
+			User: client.User,
+		}
+	}
+
+	log.WithField("user", client.User.Nickname).Info("Disconnecting user")
+	client.close()
+}
+
 func (server *server) runRoomEnterScript(enter *messages.EnterRoomMessage) {
 	if enter == nil {
 		return
@@ -0,0 +1,532 @@
+package mudserver
+
+import (
+	"bufio"
+	"errors"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/talesmud/talesmud/pkg/entities"
+	"github.com/talesmud/talesmud/pkg/mudserver/game/def"
+	"github.com/talesmud/talesmud/pkg/mudserver/game/messages"
+	"github.com/talesmud/talesmud/pkg/server/dto"
+	"github.com/talesmud/talesmud/pkg/service"
+)
+
+// telnet protocol bytes (RFC 854)
+const (
+	telnetIAC  = 255
+	telnetDONT = 254
+	telnetDO   = 253
+	telnetWONT = 252
+	telnetWILL = 251
+	telnetSB   = 250
+	telnetGA   = 249
+	telnetNOP  = 241
+	telnetSE   = 240
+
+	telnetOptEcho = 1
+)
+
+const (
+	telnetMaxLineLength    = 4096
+	telnetMaxLoginAttempts = 3
+)
+
+// telnetRefIDPrefix marks users that were created through the telnet login
+const telnetRefIDPrefix = "telnet|"
+
+// telnetConn wraps a raw TCP connection and handles telnet protocol details
+// (IAC command stripping, option negotiation, CRLF line endings)
+type telnetConn struct {
+	conn   net.Conn
+	reader *bufio.Reader
+	facade service.Facade
+	game   def.GameCtrl
+
+	// user is set after a successful login
+	user *entities.User
+
+	// creatingCharacter is set when the game requested a new character,
+	// the next input lines are consumed by the character creation flow
+	mu                sync.Mutex
+	creatingCharacter bool
+
+	// negotiated out-of-band protocols (see telnet_oob.go)
+	gmcp bool
+	msdp bool
+}
+
+func newTelnetConn(conn net.Conn, facade service.Facade, game def.GameCtrl) *telnetConn {
+	return &telnetConn{
+		conn:   conn,
+		reader: bufio.NewReader(conn),
+		facade: facade,
+		game:   game,
+	}
+}
+
+// Close closes the underlying connection
+func (t *telnetConn) Close() error {
+	return t.conn.Close()
+}
+
+// write sends text to the client, converting line endings to CRLF and escaping IAC bytes
+func (t *telnetConn) write(text string) error {
+	text = strings.ReplaceAll(text, "\r\n", "\n")
+	text = strings.ReplaceAll(text, "\n", "\r\n")
+	text = strings.ReplaceAll(text, string([]byte{telnetIAC}), string([]byte{telnetIAC, telnetIAC}))
+	_, err := t.conn.Write([]byte(text))
+	return err
+}
+
+// writeLine sends a single line of text
+func (t *telnetConn) writeLine(text string) error {
+	return t.write(text + "\n")
+}
+
+// command sends a raw telnet command sequence
+func (t *telnetConn) command(cmd ...byte) error {
+	_, err := t.conn.Write(append([]byte{telnetIAC}, cmd...))
+	return err
+}
+
+// prompt writes a prompt without a trailing newline followed by IAC GA
+func (t *telnetConn) prompt(text string) error {
+	if err := t.write(text); err != nil {
+		return err
+	}
+	return t.command(telnetGA)
+}
+
+// writeMessage renders a game message as ANSI text, callers must hold the connection mutex
+func (t *telnetConn) writeMessage(v interface{}) error {
+	if msg, ok := v.(messages.MessageResponse); ok && msg.Type == messages.MessageTypePing {
+		return t.command(telnetNOP)
+	}
+	if msg, ok := v.(messages.MessageResponse); ok && msg.Type == messages.MessageTypeCreateCharacter {
+		t.setCreatingCharacter(true)
+		return t.write(t.characterTemplateHelp())
+	}
+
+	text := renderANSI(v)
+	if text != "" {
+		if err := t.write(text + "\n"); err != nil {
+			return err
+		}
+	}
+
+	t.sendOutOfBand(v)
+	return nil
+}
+
+func (t *telnetConn) setCreatingCharacter(creating bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.creatingCharacter = creating
+}
+
+func (t *telnetConn) isCreatingCharacter() bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.creatingCharacter
+}
+
+// setEcho toggles local echo on the client, used to hide password input
+func (t *telnetConn) setEcho(enabled bool) error {
+	if enabled {
+		return t.command(telnetWONT, telnetOptEcho)
+	}
+	return t.command(telnetWILL, telnetOptEcho)
+}
+
+// readLine reads the next line of input, stripping telnet commands
+func (t *telnetConn) readLine() (string, error) {
+	line := make([]byte, 0, 128)
+
+	for {
+		b, err := t.reader.ReadByte()
+		if err != nil {
+			return "", err
+		}
+
+		switch b {
+		case telnetIAC:
+			if err := t.handleCommand(); err != nil {
+				return "", err
+			}
+		case '\n':
+			return strings.TrimSpace(string(line)), nil
+		case '\r', 0:
+			// CR NUL / CR LF line endings, LF terminates the line
+		case 8, 127:
+			if len(line) > 0 {
+				line = line[:len(line)-1]
+			}
+		default:
+			if len(line) >= telnetMaxLineLength {
+				return "", errors.New("telnet line too long")
+			}
+			line = append(line, b)
+		}
+	}
+}
+
+// handleCommand consumes a telnet command sequence following an IAC byte
+func (t *telnetConn) handleCommand() error {
+	cmd, err := t.reader.ReadByte()
+	if err != nil {
+		return err
+	}
+
+	switch cmd {
+	case telnetWILL, telnetWONT, telnetDO, telnetDONT:
+		opt, err := t.reader.ReadByte()
+		if err != nil {
+			return err
+		}
+		return t.negotiate(cmd, opt)
+	case telnetSB:
+		return t.readSubnegotiation()
+	}
+	return nil
+}
+
+// readSubnegotiation reads IAC SB <option> <data> IAC SE and passes the data to the option handler
+func (t *telnetConn) readSubnegotiation() error {
+	opt, err := t.reader.ReadByte()
+	if err != nil {
+		return err
+	}
+
+	data := make([]byte, 0, 64)
+	for {
+		b, err := t.reader.ReadByte()
+		if err != nil {
+			return err
+		}
+		if b == telnetIAC {
+			next, err := t.reader.ReadByte()
+			if err != nil {
+				return err
+			}
+			if next == telnetSE {
+				t.handleSubnegotiation(opt, data)
+				return nil
+			}
+			b = next
+		}
+		if len(data) >= telnetMaxLineLength {
+			return errors.New("telnet subnegotiation too long")
+		}
+		data = append(data, b)
+	}
+}
+
+// negotiate refuses every option we do not support, echo is driven by the server
+func (t *telnetConn) negotiate(cmd, opt byte) error {
+	if opt == telnetOptGMCP || opt == telnetOptMSDP {
+		switch cmd {
+		case telnetDO:
+			t.setOutOfBand(opt, true)
+		case telnetDONT:
+			t.setOutOfBand(opt, false)
+		}
+		return nil
+	}
+
+	switch cmd {
+	case telnetDO, telnetDONT:
+		if opt == telnetOptEcho {
+			return nil
+		}
+		if cmd == telnetDO {
+			return t.command(telnetWONT, opt)
+		}
+	case telnetWILL:
+		return t.command(telnetDONT, opt)
+	}
+	return nil
+}
+
+// ListenTelnet starts a raw TCP listener for classic MUD clients (Mudlet, TinTin++, ...)
+func (server *server) ListenTelnet(address string) error {
+
+	listener, err := net.Listen("tcp", address)
+	if err != nil {
+		return err
+	}
+
+	log.WithField("address", address).Info("Telnet listener running")
+
+	for {
+		conn, err := listener.Accept()
+		if err != nil {
+			log.WithError(err).Warn("Telnet accept failed")
+			continue
+		}
+		go server.handleTelnetConnection(conn)
+	}
+}
+
+func (server *server) handleTelnetConnection(conn net.Conn) {
+
+	tc := newTelnetConn(conn, server.Facade, server.Game)
+	defer tc.Close()
+
+	tc.offerOutOfBand()
+
+	serverName := "TalesMUD"
+	if ss, err := server.Facade.ServerSettingsService().Get(); err == nil && ss.ServerName != "" {
+		serverName = ss.ServerName
+	}
+	tc.writeLine(ansiBold + ansiCyan + "Welcome to " + serverName + "!" + ansiReset)
+
+	user, err := server.telnetLogin(tc)
+	if err != nil {
+		log.WithError(err).WithField("remote", conn.RemoteAddr().String()).Info("Telnet login aborted")
+		return
+	}
+
+	log.WithField("User", user.Nickname).Info("User logged in via telnet")
+	tc.user = user
+
+	// Register our new client
+	server.Clients[user.ID] = &Connection{
+		User:   user,
+		telnet: tc,
+		active: true,
+	}
+
+	server.sendMessage(user.ID, messages.NewRoomBasedMessage("", "Connected to ["+serverName+"] ..."))
+
+	server.Game.OnUserJoined <- &messages.UserJoined{
+		User: user,
+	}
+
+	for {
+		line, err := tc.readLine()
+		if err != nil {
+
+			user.IsOnline = false
+			server.Facade.UsersService().Update(user.RefID, user)
+
+			if user.LastCharacter != "" {
+				server.Game.OnUserQuit <- &messages.UserQuit{
+					User: user,
+				}
+			}
+
+			log.Printf("telnet: %v", err)
+			delete(server.Clients, user.ID)
+			break
+		}
+
+		// update user online status
+		user.LastSeen = time.Now()
+		user.IsOnline = true
+		server.Facade.UsersService().Update(user.RefID, user)
+
+		if tc.isCreatingCharacter() {
+			server.telnetCreateCharacter(tc, user, line)
+			continue
+		}
+
+		if line == "quit" {
+			tc.writeLine("Farewell!")
+			user.IsOnline = false
+			server.Facade.UsersService().Update(user.RefID, user)
+			if user.LastCharacter != "" {
+				server.Game.OnUserQuit <- &messages.UserQuit{
+					User: user,
+				}
+			}
+			delete(server.Clients, user.ID)
+			break
+		}
+
+		if line != "" {
+			server.Game.OnMessageReceived() <- messages.NewMessage(user, line)
+		}
+	}
+}
+
+// telnetLogin runs the name/password login flow and returns the authenticated user.
+// Unknown names create a new telnet-only user, existing web users need to set a
+// telnet password in game first (see the telnetpassword command).
+func (server *server) telnetLogin(tc *telnetConn) (*entities.User, error) {
+
+	for {
+		tc.prompt("By what name are you known? ")
+		name, err := tc.readLine()
+		if err != nil {
+			return nil, err
+		}
+		if !isValidTelnetName(name) {
+			tc.writeLine("Names must be 3-20 letters or digits.")
+			continue
+		}
+
+		user, err := server.Facade.UsersService().FindByNickname(name)
+		if err != nil {
+			user, err = server.telnetCreateUser(tc, name)
+			if err != nil {
+				return nil, err
+			}
+			if user == nil {
+				continue
+			}
+			return user, nil
+		}
+
+		if user.IsBanned {
+			tc.writeLine("This account is banned.")
+			return nil, errors.New("user is banned")
+		}
+
+		if !user.HasTelnetPassword() {
+			tc.writeLine("This account has no telnet password yet. Log in with the web client and use 'telnetpassword [password]' to enable telnet access.")
+			return nil, errors.New("no telnet password set")
+		}
+
+		for attempt := 0; attempt < telnetMaxLoginAttempts; attempt++ {
+			password, err := tc.readPassword("Password: ")
+			if err != nil {
+				return nil, err
+			}
+			if user.CheckTelnetPassword(password) {
+				return user, nil
+			}
+			tc.writeLine("Wrong password.")
+		}
+		return nil, errors.New("too many failed login attempts")
+	}
+}
+
+// telnetCreateUser asks to create a new user for an unknown name, returns nil if the player declined
+func (server *server) telnetCreateUser(tc *telnetConn, name string) (*entities.User, error) {
+
+	tc.prompt("Nobody is known as " + name + ". Create a new account? (y/n) ")
+	answer, err := tc.readLine()
+	if err != nil {
+		return nil, err
+	}
+	if !strings.HasPrefix(strings.ToLower(answer), "y") {
+		return nil, nil
+	}
+
+	password, err := tc.readPassword("Choose a password: ")
+	if err != nil {
+		return nil, err
+	}
+	confirm, err := tc.readPassword("Repeat the password: ")
+	if err != nil {
+		return nil, err
+	}
+	if password == "" || password != confirm {
+		tc.writeLine("Passwords do not match.")
+		return nil, nil
+	}
+
+	user := &entities.User{
+		RefID:    telnetRefIDPrefix + strings.ToLower(name),
+		Name:     name,
+		Nickname: name,
+		Created:  time.Now(),
+		LastSeen: time.Now(),
+		Role:     entities.RolePlayer,
+	}
+	if err := user.SetTelnetPassword(password); err != nil {
+		return nil, err
+	}
+
+	user, err = server.Facade.UsersService().Create(user)
+	if err != nil {
+		tc.writeLine("Could not create account.")
+		return nil, err
+	}
+
+	log.WithField("User", name).Info("Created new telnet user")
+	tc.writeLine("Welcome, " + name + "! Use 'nc' to create your first character.")
+	return user, nil
+}
+
+// readPassword prompts for input with client echo disabled
+func (t *telnetConn) readPassword(text string) (string, error) {
+	t.setEcho(false)
+	t.prompt(text)
+	password, err := t.readLine()
+	t.setEcho(true)
+	t.write("\n")
+	return password, err
+}
+
+// telnetCreateCharacter handles a line of input while the player creates a new character.
+// Input has the form "[template number] [name]", the web client uses the REST API for this.
+func (server *server) telnetCreateCharacter(tc *telnetConn, user *entities.User, line string) {
+
+	if line == "cancel" {
+		tc.setCreatingCharacter(false)
+		tc.writeLine("Character creation cancelled.")
+		return
+	}
+
+	templates := server.Facade.CharactersService().GetCharacterTemplates()
+	if len(templates) == 0 {
+		tc.write(tc.characterTemplateHelp())
+		tc.setCreatingCharacter(false)
+		return
+	}
+
+	parts := strings.Fields(line)
+	if len(parts) >= 2 {
+		if idx, err := strconv.Atoi(parts[0]); err == nil && idx >= 1 && idx <= len(templates) {
+			name := strings.Join(parts[1:], " ")
+			character, err := server.Facade.CharactersService().CreateNewCharacter(&dto.CreateCharacterDTO{
+				Name:       name,
+				TemplateID: templates[idx-1].ID,
+				UserID:     user.ID,
+			})
+			if err != nil {
+				tc.writeLine(ansiRed + "Could not create character: " + err.Error() + ansiReset)
+			} else {
+				tc.setCreatingCharacter(false)
+				server.Game.OnMessageReceived() <- messages.NewMessage(user, "selectcharacter "+character.Name)
+				return
+			}
+		}
+	}
+
+	tc.write(tc.characterTemplateHelp())
+}
+
+// characterTemplateHelp lists the available character templates for the creation flow
+func (t *telnetConn) characterTemplateHelp() string {
+	templates := t.facade.CharactersService().GetCharacterTemplates()
+	if len(templates) == 0 {
+		return "No character templates available, please contact an admin.\n"
+	}
+
+	var sb strings.Builder
+	sb.WriteString(ansiBold + "Create a new character" + ansiReset + " - choose a template and a name, e.g. '1 Aragorn' (or 'cancel'):\n")
+	for i, template := range templates {
+		sb.WriteString("  " + ansiCyan + strconv.Itoa(i+1) + ") " + template.Name + ansiReset + " - " + template.Description + "\n")
+	}
+	return sb.String()
+}
+
+func isValidTelnetName(name string) bool {
+	if len(name) < 3 || len(name) > 20 {
+		return false
+	}
+	for _, r := range name {
+		if !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9') {
+			return false
+		}
+	}
+	return true
+}
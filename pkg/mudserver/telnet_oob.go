@@ -0,0 +1,306 @@
+package mudserver
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/talesmud/talesmud/pkg/entities/characters"
+	"github.com/talesmud/talesmud/pkg/entities/combat"
+	"github.com/talesmud/talesmud/pkg/entities/rooms"
+	"github.com/talesmud/talesmud/pkg/mudserver/game/messages"
+)
+
+// out-of-band telnet options
+const (
+	telnetOptMSDP = 69
+	telnetOptGMCP = 201
+)
+
+// MSDP value markers
+const (
+	msdpVar        = 1
+	msdpVal        = 2
+	msdpTableOpen  = 3
+	msdpTableClose = 4
+	msdpArrayOpen  = 5
+	msdpArrayClose = 6
+)
+
+// GMCP package names emitted to telnet clients
+const (
+	gmcpRoomInfo     = "Room.Info"
+	gmcpCharVitals   = "Char.Vitals"
+	gmcpCombatStatus = "Combat.Status"
+)
+
+// msdpVariables maps GMCP packages to the MSDP variable used for clients without GMCP support
+var msdpVariables = map[string]string{
+	gmcpRoomInfo:     "ROOM",
+	gmcpCharVitals:   "VITALS",
+	gmcpCombatStatus: "COMBAT",
+}
+
+// offerOutOfBand announces GMCP and MSDP support, the client answers with DO/DONT
+func (t *telnetConn) offerOutOfBand() {
+	t.command(telnetWILL, telnetOptGMCP)
+	t.command(telnetWILL, telnetOptMSDP)
+}
+
+// setOutOfBand enables or disables an out-of-band protocol after negotiation
+func (t *telnetConn) setOutOfBand(opt byte, enabled bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	switch opt {
+	case telnetOptGMCP:
+		t.gmcp = enabled
+	case telnetOptMSDP:
+		t.msdp = enabled
+	}
+}
+
+// outOfBandMode returns the negotiated protocol, GMCP is preferred over MSDP
+func (t *telnetConn) outOfBandMode() byte {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.gmcp {
+		return telnetOptGMCP
+	}
+	if t.msdp {
+		return telnetOptMSDP
+	}
+	return 0
+}
+
+// handleSubnegotiation processes client data sent via IAC SB ... IAC SE
+func (t *telnetConn) handleSubnegotiation(opt byte, data []byte) {
+	switch opt {
+	case telnetOptGMCP:
+		// Core.Hello, Core.Supports.Set etc. - we always send all packages
+		log.WithField("gmcp", string(data)).Debug("Received GMCP data")
+	case telnetOptMSDP:
+		log.WithField("msdp", strings.ReplaceAll(string(data), "\x01", " ")).Debug("Received MSDP data")
+	}
+}
+
+// sendOutOfBand emits structured data for the game message that is sent as text, callers must hold the connection mutex
+func (t *telnetConn) sendOutOfBand(v interface{}) {
+
+	if t.outOfBandMode() == 0 || t.user == nil {
+		return
+	}
+
+	switch msg := v.(type) {
+	case *messages.EnterRoomMessage:
+		t.sendPackage(gmcpRoomInfo, roomInfo(&msg.Room))
+		t.sendPackage(gmcpCharVitals, t.charVitals(nil))
+	case *messages.CharacterSelected:
+		t.sendPackage(gmcpCharVitals, t.charVitals(msg.Character))
+	case *messages.LevelUpMessage:
+		t.sendPackage(gmcpCharVitals, t.charVitals(nil))
+	case messages.MessageResponse:
+		switch msg.Type {
+		case messages.MessageTypeCombatStart,
+			messages.MessageTypeCombatTurn,
+			messages.MessageTypeCombatAction,
+			messages.MessageTypeCombatEnd,
+			messages.MessageTypeCombatStatus:
+			t.sendPackage(gmcpCombatStatus, t.combatStatus())
+			t.sendPackage(gmcpCharVitals, t.charVitals(nil))
+		}
+	}
+}
+
+// sendPackage encodes the data as GMCP or MSDP depending on the negotiated protocol
+func (t *telnetConn) sendPackage(pkg string, data map[string]interface{}) {
+
+	if data == nil {
+		return
+	}
+
+	var payload []byte
+
+	switch t.outOfBandMode() {
+	case telnetOptGMCP:
+		encoded, err := json.Marshal(data)
+		if err != nil {
+			log.WithError(err).WithField("package", pkg).Error("Could not encode GMCP package")
+			return
+		}
+		payload = append([]byte(pkg+" "), encoded...)
+		payload = append([]byte{telnetIAC, telnetSB, telnetOptGMCP}, escapeIAC(payload)...)
+	case telnetOptMSDP:
+		payload = []byte{telnetIAC, telnetSB, telnetOptMSDP, msdpVar}
+		payload = append(payload, msdpVariables[pkg]...)
+		payload = append(payload, msdpVal)
+		payload = append(payload, escapeIAC(encodeMSDP(data))...)
+	default:
+		return
+	}
+
+	payload = append(payload, telnetIAC, telnetSE)
+	t.conn.Write(payload)
+}
+
+// charVitals builds Char.Vitals, combat HP is taken from the running combat instance
+func (t *telnetConn) charVitals(character *characters.Character) map[string]interface{} {
+
+	if character == nil {
+		if t.user.LastCharacter == "" {
+			return nil
+		}
+		var err error
+		if character, err = t.facade.CharactersService().FindByID(t.user.LastCharacter); err != nil {
+			return nil
+		}
+	}
+
+	hp, maxHP := character.CurrentHitPoints, character.MaxHitPoints
+	mana, stamina := character.CurrentMana, character.CurrentStamina
+	if t.game != nil {
+		if instance := t.game.GetCombatEngine().GetCombatInstance(character.ID); instance != nil {
+			if player := instance.GetPlayerByID(character.ID); player != nil {
+				hp, maxHP = player.CurrentHP, player.MaxHP
+				mana, stamina = player.CurrentMana, player.CurrentStamina
+			}
+		}
+	}
+
+	return map[string]interface{}{
+		"name":    character.Name,
+		"hp":      hp,
+		"maxhp":   maxHP,
+		"mana":    mana,
+		"maxmana": character.MaxMana,
+		"sp":      stamina,
+		"maxsp":   character.MaxStamina,
+		"level":   character.Level,
+		"xp":      character.XP,
+		"gold":    character.Gold,
+	}
+}
+
+// combatStatus builds Combat.Status from the combat instance the player is in
+func (t *telnetConn) combatStatus() map[string]interface{} {
+
+	if t.game == nil || t.user.LastCharacter == "" {
+		return nil
+	}
+
+	instance := t.game.GetCombatEngine().GetCombatInstance(t.user.LastCharacter)
+	if instance == nil {
+		return map[string]interface{}{
+			"inCombat": false,
+		}
+	}
+
+	status := map[string]interface{}{
+		"inCombat": true,
+		"id":       instance.ID,
+		"round":    instance.Round,
+		"state":    string(instance.State),
+		"players":  combatants(instance.Players),
+		"enemies":  combatants(instance.Enemies),
+	}
+	if current := instance.GetCurrentTurnCombatant(); current != nil {
+		status["turn"] = current.Name
+	}
+	return status
+}
+
+func combatants(refs []combat.CombatantRef) []interface{} {
+	result := make([]interface{}, 0, len(refs))
+	for _, ref := range refs {
+		result = append(result, map[string]interface{}{
+			"id":      ref.ID,
+			"name":    ref.Name,
+			"hp":      ref.CurrentHP,
+			"maxhp":   ref.MaxHP,
+			"alive":   ref.IsAlive,
+			"fled":    ref.HasFled,
+			"effects": ref.Effects.Summary(time.Now()),
+		})
+	}
+	return result
+}
+
+// roomInfo builds Room.Info with the visible exits mapped to their target room ids
+func roomInfo(room *rooms.Room) map[string]interface{} {
+
+	exits := map[string]interface{}{}
+	if room.Exits != nil {
+		for _, exit := range *room.Exits {
+			if !exit.Hidden {
+				exits[exit.Name] = exit.Target
+			}
+		}
+	}
+
+	info := map[string]interface{}{
+		"num":   room.ID,
+		"name":  room.Name,
+		"area":  room.Area,
+		"zone":  room.Area,
+		"type":  room.RoomType,
+		"exits": exits,
+	}
+	if room.Coords != nil {
+		info["coords"] = map[string]interface{}{
+			"x": room.Coords.X,
+			"y": room.Coords.Y,
+			"z": room.Coords.Z,
+		}
+	}
+	return info
+}
+
+// encodeMSDP converts a value to its MSDP representation (tables, arrays and plain values)
+func encodeMSDP(value interface{}) []byte {
+
+	switch v := value.(type) {
+	case map[string]interface{}:
+		keys := make([]string, 0, len(v))
+		for key := range v {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		out := []byte{msdpTableOpen}
+		for _, key := range keys {
+			out = append(out, msdpVar)
+			out = append(out, strings.ToUpper(key)...)
+			out = append(out, msdpVal)
+			out = append(out, encodeMSDP(v[key])...)
+		}
+		return append(out, msdpTableClose)
+	case []interface{}:
+		out := []byte{msdpArrayOpen}
+		for _, elem := range v {
+			out = append(out, msdpVal)
+			out = append(out, encodeMSDP(elem)...)
+		}
+		return append(out, msdpArrayClose)
+	case bool:
+		if v {
+			return []byte("1")
+		}
+		return []byte("0")
+	case nil:
+		return []byte{}
+	}
+	return []byte(fmt.Sprint(value))
+}
+
+func escapeIAC(data []byte) []byte {
+	out := make([]byte, 0, len(data))
+	for _, b := range data {
+		out = append(out, b)
+		if b == telnetIAC {
+			out = append(out, telnetIAC)
+		}
+	}
+	return out
+}
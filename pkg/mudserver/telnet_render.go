@@ -0,0 +1,133 @@
+package mudserver
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/talesmud/talesmud/pkg/entities/items"
+	"github.com/talesmud/talesmud/pkg/mudserver/game/messages"
+)
+
+// ANSI escape sequences used to render game messages for telnet clients
+const (
+	ansiReset   = "\x1b[0m"
+	ansiBold    = "\x1b[1m"
+	ansiDim     = "\x1b[2m"
+	ansiRed     = "\x1b[31m"
+	ansiGreen   = "\x1b[32m"
+	ansiYellow  = "\x1b[33m"
+	ansiMagenta = "\x1b[35m"
+	ansiCyan    = "\x1b[36m"
+)
+
+// renderANSI converts an outgoing game message into ANSI colored text.
+// The websocket clients receive the same messages as JSON.
+func renderANSI(v interface{}) string {
+
+	switch msg := v.(type) {
+	case *messages.EnterRoomMessage:
+		return renderRoom(msg.Message)
+	case *messages.DialogMessage:
+		return renderDialog(msg)
+	case *messages.InventoryUpdateMessage:
+		return renderInventoryUpdate(msg)
+	case *messages.CharacterSelected:
+		return renderResponse(msg.MessageResponse)
+	case *messages.ChatMessage:
+		return ansiCyan + msg.Message + ansiReset
+	case *messages.LevelUpMessage:
+		return ansiBold + ansiGreen + msg.Message + ansiReset
+	case messages.CharacterJoinedRoom:
+		return ansiDim + msg.Message + ansiReset
+	case messages.CharacterLeftRoom:
+		return ansiDim + msg.Message + ansiReset
+	case messages.MessageResponse:
+		return renderResponse(msg)
+	case *messages.MessageResponse:
+		return renderResponse(*msg)
+	case messages.MultiResponse:
+		parts := make([]string, 0, len(msg.Responses))
+		for _, rsp := range msg.Responses {
+			parts = append(parts, renderResponse(rsp))
+		}
+		return strings.Join(parts, "\n")
+	case messages.MessageResponder:
+		return msg.GetMessage()
+	}
+	return ""
+}
+
+func renderResponse(msg messages.MessageResponse) string {
+
+	switch msg.Type {
+	case messages.MessageTypeCombatStart:
+		return ansiBold + ansiRed + msg.Message + ansiReset
+	case messages.MessageTypeCombatEnd:
+		return ansiBold + ansiYellow + msg.Message + ansiReset
+	case messages.MessageTypeCombatAction:
+		return ansiRed + msg.Message + ansiReset
+	case messages.MessageTypeCombatTurn, messages.MessageTypeCombatStatus:
+		return ansiYellow + msg.Message + ansiReset
+	case messages.MessageTypeCharacterSelected:
+		return ansiBold + ansiGreen + msg.Message + ansiReset
+	case messages.MessageTypeDialogEnd:
+		if msg.Username != "" {
+			return ansiYellow + msg.Username + ansiReset + ": " + msg.Message
+		}
+		return msg.Message
+	}
+
+	switch msg.Username {
+	case "":
+		return msg.Message
+	case "#SYSTEM":
+		return ansiBold + ansiMagenta + "[SYSTEM] " + msg.Message + ansiReset
+	}
+	return ansiBold + msg.Username + ansiReset + ": " + msg.Message
+}
+
+// renderRoom colors the room description built by util.CreateRoomDescription line by line
+func renderRoom(description string) string {
+
+	lines := strings.Split(description, "\n")
+	for i, line := range lines {
+		switch {
+		case strings.HasPrefix(line, "[") && strings.HasSuffix(line, "]"):
+			lines[i] = ansiBold + ansiCyan + line + ansiReset
+		case strings.HasPrefix(line, "- In the room:"):
+			lines[i] = ansiMagenta + line + ansiReset
+		case strings.HasPrefix(line, "- Enemies:"):
+			lines[i] = ansiRed + line + ansiReset
+		case strings.HasPrefix(line, "- NPCs:"):
+			lines[i] = ansiYellow + line + ansiReset
+		case strings.HasPrefix(line, "- The visible exits"), strings.HasPrefix(line, " + ["):
+			lines[i] = ansiGreen + line + ansiReset
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+func renderDialog(msg *messages.DialogMessage) string {
+
+	var sb strings.Builder
+	sb.WriteString(ansiBold + ansiYellow + msg.NPCName + ansiReset + " says: \"" + msg.NPCText + "\"")
+	for _, option := range msg.Options {
+		sb.WriteString(fmt.Sprintf("\n  %s%d)%s %s", ansiCyan, option.Index, ansiReset, option.Text))
+	}
+	return sb.String()
+}
+
+// renderInventoryUpdate only prints a short summary, the full inventory is available via the inventory command
+func renderInventoryUpdate(msg *messages.InventoryUpdateMessage) string {
+
+	itemCount := 0
+	switch inv := msg.Inventory.(type) {
+	case items.Inventory:
+		itemCount = inv.Count()
+	case *items.Inventory:
+		if inv != nil {
+			itemCount = inv.Count()
+		}
+	}
+	return fmt.Sprintf("%s[Inventory: %d items, %d gold]%s", ansiDim, itemCount, msg.Gold, ansiReset)
+}
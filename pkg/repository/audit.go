@@ -0,0 +1,74 @@
+package repository
+
+import (
+	"errors"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/talesmud/talesmud/pkg/db"
+	"github.com/talesmud/talesmud/pkg/entities"
+	"github.com/talesmud/talesmud/pkg/entities/audit"
+)
+
+type auditRepository struct {
+	*genericRepo
+}
+
+// NewAuditRepository creates a new audit log repository.
+func NewAuditRepository(client db.Client) AuditRepository {
+	return &auditRepository{
+		genericRepo: newGenericRepo(client, "audit_log", func() interface{} {
+			return &audit.Entry{}
+		}),
+	}
+}
+
+func (repo *auditRepository) Drop() error {
+	return repo.genericRepo.DropCollection()
+}
+
+func (repo *auditRepository) FindByID(id string) (*audit.Entry, error) {
+	if id == "" {
+		log.Error("Audit::FindByID - id is empty")
+		return nil, errors.New("empty id")
+	}
+	result, err := repo.genericRepo.FindByID(id)
+	if err == nil {
+		return result.(*audit.Entry), nil
+	}
+	return nil, err
+}
+
+func (repo *auditRepository) FindAllForUser(userID string) ([]*audit.Entry, error) {
+	if userID == "" {
+		log.Error("Audit::FindAllForUser - userID is empty")
+		return nil, errors.New("empty userID")
+	}
+	results := make([]*audit.Entry, 0)
+	if err := repo.genericRepo.FindAllWithParam(
+		db.NewQueryParams(db.QueryParam{Key: "userId", Value: userID}),
+		func(elem interface{}) {
+			results = append(results, elem.(*audit.Entry))
+		}); err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+func (repo *auditRepository) FindAll() ([]*audit.Entry, error) {
+	results := make([]*audit.Entry, 0)
+	if err := repo.genericRepo.FindAll(func(elem interface{}) {
+		results = append(results, elem.(*audit.Entry))
+	}); err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+func (repo *auditRepository) Store(entry *audit.Entry) (*audit.Entry, error) {
+	entry.Entity = entities.NewEntity()
+	result, err := repo.genericRepo.Store(entry)
+	if result == nil {
+		return nil, err
+	}
+	return result.(*audit.Entry), nil
+}
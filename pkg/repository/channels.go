@@ -0,0 +1,80 @@
+package repository
+
+import (
+	"errors"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/talesmud/talesmud/pkg/db"
+	"github.com/talesmud/talesmud/pkg/entities"
+	"github.com/talesmud/talesmud/pkg/entities/channels"
+)
+
+type channelsRepository struct {
+	*genericRepo
+}
+
+// NewChannelsRepository creates a new chat channels repository.
+func NewChannelsRepository(client db.Client) ChannelsRepository {
+	return &channelsRepository{
+		genericRepo: newGenericRepo(client, "channels", func() interface{} {
+			return &channels.Channel{}
+		}),
+	}
+}
+
+func (repo *channelsRepository) Drop() error {
+	return repo.genericRepo.DropCollection()
+}
+
+func (repo *channelsRepository) FindByID(id string) (*channels.Channel, error) {
+	if id == "" {
+		log.Error("Channels::FindByID - id is empty")
+		return nil, errors.New("empty id")
+	}
+	result, err := repo.genericRepo.FindByID(id)
+	if err == nil {
+		return result.(*channels.Channel), nil
+	}
+	return nil, err
+}
+
+func (repo *channelsRepository) FindByName(name string) ([]*channels.Channel, error) {
+	results := make([]*channels.Channel, 0)
+	_ = repo.genericRepo.FindAllWithParam(
+		db.NewQueryParams(db.QueryParam{Key: "name", Value: name}),
+		func(elem interface{}) {
+			results = append(results, elem.(*channels.Channel))
+		})
+	return results, nil
+}
+
+func (repo *channelsRepository) FindAll() ([]*channels.Channel, error) {
+	results := make([]*channels.Channel, 0)
+	if err := repo.genericRepo.FindAll(func(elem interface{}) {
+		results = append(results, elem.(*channels.Channel))
+	}); err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+func (repo *channelsRepository) Update(id string, channel *channels.Channel) error {
+	return repo.genericRepo.Update(channel, id)
+}
+
+func (repo *channelsRepository) Delete(id string) error {
+	return repo.genericRepo.Delete(id)
+}
+
+func (repo *channelsRepository) Store(channel *channels.Channel) (*channels.Channel, error) {
+	channel.Entity = entities.NewEntity()
+	return repo.Import(channel)
+}
+
+func (repo *channelsRepository) Import(channel *channels.Channel) (*channels.Channel, error) {
+	result, err := repo.genericRepo.Store(channel)
+	if result == nil {
+		return nil, err
+	}
+	return result.(*channels.Channel), nil
+}
@@ -0,0 +1,99 @@
+package repository
+
+import (
+	"errors"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/talesmud/talesmud/pkg/db"
+	"github.com/talesmud/talesmud/pkg/entities"
+	e "github.com/talesmud/talesmud/pkg/entities/characters"
+	"github.com/talesmud/talesmud/pkg/entities/items"
+)
+
+type charactersRepository struct {
+	*genericRepo
+}
+
+// NewCharactersRepository creates a new characters repository.
+func NewCharactersRepository(client db.Client) CharactersRepository {
+	return &charactersRepository{
+		genericRepo: newGenericRepo(client, "characters", func() interface{} {
+			return &e.Character{
+				EquippedItems: make(map[items.ItemSlot]*items.Item),
+			}
+		}),
+	}
+}
+
+func (repo *charactersRepository) Drop() error {
+	return repo.genericRepo.DropCollection()
+}
+
+func (repo *charactersRepository) FindByID(id string) (*e.Character, error) {
+	if id == "" {
+		log.Error("Characters::FindByID - id is empty")
+		return nil, errors.New("empty id")
+	}
+	result, err := repo.genericRepo.FindByID(id)
+	if err == nil {
+		return result.(*e.Character), nil
+	}
+	return nil, err
+}
+
+func (repo *charactersRepository) FindAllForUser(userID string) ([]*e.Character, error) {
+	if userID == "" {
+		log.Error("Characters::FindAllForUser - userID is empty")
+		return nil, errors.New("empty userID")
+	}
+	results := make([]*e.Character, 0)
+	if err := repo.genericRepo.FindAllWithParam(
+		db.NewQueryParams(db.QueryParam{Key: "belongsUser", Value: userID}),
+		func(elem interface{}) {
+			results = append(results, elem.(*e.Character))
+		}); err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+func (repo *charactersRepository) FindByName(name string) ([]*e.Character, error) {
+	results := make([]*e.Character, 0)
+	_ = repo.genericRepo.FindAllWithParam(
+		db.NewQueryParams(db.QueryParam{Key: "name", Value: name}),
+		func(elem interface{}) {
+			results = append(results, elem.(*e.Character))
+		})
+	return results, nil
+}
+
+func (repo *charactersRepository) FindAll() ([]*e.Character, error) {
+	results := make([]*e.Character, 0)
+	if err := repo.genericRepo.FindAll(func(elem interface{}) {
+		results = append(results, elem.(*e.Character))
+	}); err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+func (repo *charactersRepository) Update(id string, charachterSheet *e.Character) error {
+	return repo.genericRepo.Update(charachterSheet, id)
+}
+
+func (repo *charactersRepository) Delete(id string) error {
+	return repo.genericRepo.Delete(id)
+}
+
+func (repo *charactersRepository) Store(character *e.Character) (*e.Character, error) {
+	character.Entity = entities.NewEntity()
+	return repo.Import(character)
+}
+
+func (repo *charactersRepository) Import(character *e.Character) (*e.Character, error) {
+	result, err := repo.genericRepo.Store(character)
+	if result == nil {
+		return nil, err
+	}
+	return result.(*e.Character), nil
+}
@@ -0,0 +1,88 @@
+package repository
+
+import (
+	"errors"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/talesmud/talesmud/pkg/db"
+	"github.com/talesmud/talesmud/pkg/entities"
+	c "github.com/talesmud/talesmud/pkg/entities/characters"
+)
+
+type characterTemplatesRepository struct {
+	*genericRepo
+}
+
+// NewCharacterTemplatesRepository creates a new character templates repository.
+func NewCharacterTemplatesRepository(client db.Client) CharacterTemplatesRepository {
+	return &characterTemplatesRepository{
+		genericRepo: newGenericRepo(client, "charactertemplates", func() interface{} {
+			return &c.CharacterTemplate{}
+		}),
+	}
+}
+
+func (repo *characterTemplatesRepository) Drop() error {
+	return repo.genericRepo.DropCollection()
+}
+
+func (repo *characterTemplatesRepository) FindByID(id string) (*c.CharacterTemplate, error) {
+	if id == "" {
+		log.Error("CharacterTemplates::FindByID - id is empty")
+		return nil, errors.New("empty id")
+	}
+	result, err := repo.genericRepo.FindByID(id)
+	if err == nil {
+		return result.(*c.CharacterTemplate), nil
+	}
+	return nil, err
+}
+
+func (repo *characterTemplatesRepository) FindByName(name string) ([]*c.CharacterTemplate, error) {
+	results := make([]*c.CharacterTemplate, 0)
+	_ = repo.genericRepo.FindAllWithParam(
+		db.NewQueryParams(db.QueryParam{Key: "name", Value: name}),
+		func(elem interface{}) {
+			results = append(results, elem.(*c.CharacterTemplate))
+		})
+	return results, nil
+}
+
+func (repo *characterTemplatesRepository) FindAll() ([]*c.CharacterTemplate, error) {
+	results := make([]*c.CharacterTemplate, 0)
+	if err := repo.genericRepo.FindAll(func(elem interface{}) {
+		results = append(results, elem.(*c.CharacterTemplate))
+	}); err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+func (repo *characterTemplatesRepository) Count() (int, error) {
+	templates, err := repo.FindAll()
+	if err != nil {
+		return 0, err
+	}
+	return len(templates), nil
+}
+
+func (repo *characterTemplatesRepository) Update(id string, template *c.CharacterTemplate) error {
+	return repo.genericRepo.Update(template, id)
+}
+
+func (repo *characterTemplatesRepository) Delete(id string) error {
+	return repo.genericRepo.Delete(id)
+}
+
+func (repo *characterTemplatesRepository) Store(template *c.CharacterTemplate) (*c.CharacterTemplate, error) {
+	template.Entity = entities.NewEntity()
+	return repo.Import(template)
+}
+
+func (repo *characterTemplatesRepository) Import(template *c.CharacterTemplate) (*c.CharacterTemplate, error) {
+	result, err := repo.genericRepo.Store(template)
+	if result == nil {
+		return nil, err
+	}
+	return result.(*c.CharacterTemplate), nil
+}
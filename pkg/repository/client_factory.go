@@ -0,0 +1,89 @@
+package repository
+
+import "github.com/talesmud/talesmud/pkg/db"
+
+// clientFactory builds the JSON document repositories on top of a database client,
+// it is shared by all SQL backends.
+type clientFactory struct {
+	client db.Client
+}
+
+func (f *clientFactory) Characters() CharactersRepository {
+	return NewCharactersRepository(f.client)
+}
+
+func (f *clientFactory) Parties() PartiesRepository {
+	return NewPartiesRepository(f.client)
+}
+
+func (f *clientFactory) Users() UsersRepository {
+	return NewUsersRepository(f.client)
+}
+
+func (f *clientFactory) Rooms() RoomsRepository {
+	return NewRoomsRepository(f.client)
+}
+
+func (f *clientFactory) Scripts() ScriptsRepository {
+	return NewScriptsRepository(f.client)
+}
+
+func (f *clientFactory) Items() ItemsRepository {
+	return NewItemsRepository(f.client)
+}
+
+func (f *clientFactory) CharacterTemplates() CharacterTemplatesRepository {
+	return NewCharacterTemplatesRepository(f.client)
+}
+
+func (f *clientFactory) NPCs() NPCsRepository {
+	return NewNPCsRepository(f.client)
+}
+
+func (f *clientFactory) NPCSpawners() NPCSpawnersRepository {
+	return NewNPCSpawnersRepository(f.client)
+}
+
+func (f *clientFactory) Dialogs() DialogsRepository {
+	return NewDialogsRepository(f.client)
+}
+
+func (f *clientFactory) Conversations() ConversationsRepository {
+	return NewConversationsRepository(f.client)
+}
+
+func (f *clientFactory) LootTables() LootTablesRepository {
+	return NewLootTablesRepository(f.client)
+}
+
+func (f *clientFactory) Quests() QuestsRepository {
+	return NewQuestsRepository(f.client)
+}
+
+func (f *clientFactory) Skills() SkillsRepository {
+	return NewSkillsRepository(f.client)
+}
+
+func (f *clientFactory) ServerSettings() ServerSettingsRepository {
+	return NewServerSettingsRepository(f.client)
+}
+
+func (f *clientFactory) WorldSnapshots() WorldSnapshotsRepository {
+	return NewWorldSnapshotsRepository(f.client)
+}
+
+func (f *clientFactory) Channels() ChannelsRepository {
+	return NewChannelsRepository(f.client)
+}
+
+func (f *clientFactory) Mail() MailRepository {
+	return NewMailRepository(f.client)
+}
+
+func (f *clientFactory) Audit() AuditRepository {
+	return NewAuditRepository(f.client)
+}
+
+func (f *clientFactory) Close() error {
+	return f.client.Close()
+}
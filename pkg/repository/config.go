@@ -0,0 +1,74 @@
+package repository
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+	"strings"
+
+	"github.com/talesmud/talesmud/pkg/db/postgres"
+	"github.com/talesmud/talesmud/pkg/db/sqlite"
+)
+
+// supported storage backends
+const (
+	DriverSQLite   = "sqlite"
+	DriverPostgres = "postgres"
+)
+
+// Config selects and configures the storage backend.
+type Config struct {
+	// Driver is sqlite (default) or postgres
+	Driver      string
+	SQLitePath  string
+	PostgresDSN string
+}
+
+// ConfigFromEnv reads the storage configuration from DB_DRIVER, SQLITE_PATH and POSTGRES_DSN.
+func ConfigFromEnv() Config {
+	cfg := Config{
+		Driver:      strings.ToLower(strings.TrimSpace(os.Getenv("DB_DRIVER"))),
+		SQLitePath:  strings.TrimSpace(os.Getenv("SQLITE_PATH")),
+		PostgresDSN: strings.TrimSpace(os.Getenv("POSTGRES_DSN")),
+	}
+	if cfg.Driver == "" {
+		cfg.Driver = DriverSQLite
+	}
+	if cfg.SQLitePath == "" {
+		cfg.SQLitePath = "talesmud.db"
+	}
+	return cfg
+}
+
+// Open connects to the configured backend and returns its repository factory.
+func Open(cfg Config) (Factory, error) {
+	switch cfg.Driver {
+	case "", DriverSQLite:
+		client, err := sqlite.Open(cfg.SQLitePath)
+		if err != nil {
+			return nil, err
+		}
+		return NewSQLiteFactory(client), nil
+	case DriverPostgres, "postgresql":
+		if cfg.PostgresDSN == "" {
+			return nil, fmt.Errorf("POSTGRES_DSN is required for the postgres driver")
+		}
+		client, err := postgres.Open(cfg.PostgresDSN)
+		if err != nil {
+			return nil, err
+		}
+		return NewPostgresFactory(client), nil
+	}
+	return nil, fmt.Errorf("unknown database driver: %s", cfg.Driver)
+}
+
+// String describes the configured database without credentials.
+func (cfg Config) String() string {
+	if cfg.Driver != DriverPostgres && cfg.Driver != "postgresql" {
+		return "SQLite " + cfg.SQLitePath
+	}
+	if u, err := url.Parse(cfg.PostgresDSN); err == nil && u.Host != "" {
+		return fmt.Sprintf("PostgreSQL %s%s", u.Host, u.Path)
+	}
+	return "PostgreSQL"
+}
@@ -0,0 +1,597 @@
+package repository_test
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/talesmud/talesmud/pkg/entities"
+	"github.com/talesmud/talesmud/pkg/entities/audit"
+	"github.com/talesmud/talesmud/pkg/entities/channels"
+	"github.com/talesmud/talesmud/pkg/entities/characters"
+	"github.com/talesmud/talesmud/pkg/entities/conversations"
+	"github.com/talesmud/talesmud/pkg/entities/dialogs"
+	"github.com/talesmud/talesmud/pkg/entities/items"
+	"github.com/talesmud/talesmud/pkg/entities/mail"
+	npc "github.com/talesmud/talesmud/pkg/entities/npcs"
+	"github.com/talesmud/talesmud/pkg/entities/quests"
+	"github.com/talesmud/talesmud/pkg/entities/rooms"
+	"github.com/talesmud/talesmud/pkg/entities/settings"
+	"github.com/talesmud/talesmud/pkg/entities/skills"
+	"github.com/talesmud/talesmud/pkg/entities/snapshots"
+	"github.com/talesmud/talesmud/pkg/repository"
+	"github.com/talesmud/talesmud/pkg/scripts"
+)
+
+// The conformance checks every repository.Factory implementation has to pass. They write and delete
+// their own documents and refuse to run against a database that contains game data.
+
+type check struct {
+	name string
+	run  func(f repository.Factory) error
+}
+
+var checks = []check{
+	{"rooms crud", checkRooms},
+	{"rooms query", checkRoomsQuery},
+	{"items templates and instances", checkItems},
+	{"characters by user and name", checkCharacters},
+	{"character templates count", checkCharacterTemplates},
+	{"users by field", checkUsers},
+	{"npcs by room", checkNPCs},
+	{"npc spawners by room and template", checkNPCSpawners},
+	{"dialogs by name", checkDialogs},
+	{"conversations by character and target", checkConversations},
+	{"skills by name", checkSkills},
+	{"quests by name", checkQuests},
+	{"parties by id", checkParties},
+	{"loot tables by name", checkLootTables},
+	{"scripts by name", checkScripts},
+	{"channels by name", checkChannels},
+	{"mail for character", checkMail},
+	{"audit for user", checkAudit},
+	{"server settings upsert", checkServerSettings},
+	{"world snapshot upsert and drop", checkWorldSnapshot},
+}
+
+func TestSQLiteConformance(t *testing.T) {
+	runConformance(t, repository.Config{
+		Driver:     repository.DriverSQLite,
+		SQLitePath: filepath.Join(t.TempDir(), "conformance.db"),
+	})
+}
+
+// TestPostgresConformance needs POSTGRES_DSN pointing to a scratch database
+func TestPostgresConformance(t *testing.T) {
+	dsn := strings.TrimSpace(os.Getenv("POSTGRES_DSN"))
+	if dsn == "" {
+		t.Skip("POSTGRES_DSN not set")
+	}
+	runConformance(t, repository.Config{Driver: repository.DriverPostgres, PostgresDSN: dsn})
+}
+
+func runConformance(t *testing.T, cfg repository.Config) {
+	f, err := repository.Open(cfg)
+	if err != nil {
+		t.Fatalf("open: %v", err)
+	}
+	defer f.Close()
+
+	if err := ensureEmpty(f); err != nil {
+		t.Fatal(err)
+	}
+	for _, c := range checks {
+		c := c
+		t.Run(c.name, func(t *testing.T) {
+			if err := c.run(f); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+// ensureEmpty refuses to run against a database containing game data
+func ensureEmpty(f repository.Factory) error {
+	allRooms, err := f.Rooms().FindAll()
+	if err != nil {
+		return err
+	}
+	allCharacters, err := f.Characters().FindAll()
+	if err != nil {
+		return err
+	}
+	allUsers, err := f.Users().FindAll()
+	if err != nil {
+		return err
+	}
+	if len(allRooms)+len(allCharacters)+len(allUsers) > 0 {
+		return errors.New("database is not empty, the conformance suite needs a scratch database")
+	}
+	return nil
+}
+
+func entity(id string) *entities.Entity {
+	return &entities.Entity{ID: "conformance-" + id}
+}
+
+func expectCount(what string, got, want int) error {
+	if got != want {
+		return fmt.Errorf("%s: expected %d results, got %d", what, want, got)
+	}
+	return nil
+}
+
+func checkRooms(f repository.Factory) error {
+	repo := f.Rooms()
+	room := &rooms.Room{Entity: entity("room-1"), Name: "The \"Prancing\" Pony's Cellar", Description: "Dusty"}
+	if _, err := repo.Import(room); err != nil {
+		return fmt.Errorf("import: %w", err)
+	}
+	defer repo.Delete(room.ID)
+
+	if _, err := repo.Import(&rooms.Room{Entity: entity("room-1")}); err == nil {
+		return errors.New("import of a duplicate id succeeded")
+	}
+
+	stored, err := repo.FindByID(room.ID)
+	if err != nil {
+		return fmt.Errorf("find by id: %w", err)
+	}
+	if stored.Name != room.Name || stored.Description != room.Description {
+		return fmt.Errorf("find by id: got %q/%q", stored.Name, stored.Description)
+	}
+
+	byName, err := repo.FindByName(room.Name)
+	if err != nil {
+		return fmt.Errorf("find by name: %w", err)
+	}
+	if err := expectCount("find by name", len(byName), 1); err != nil {
+		return err
+	}
+
+	stored.Description = "Clean"
+	if err := repo.Update(stored.ID, stored); err != nil {
+		return fmt.Errorf("update: %w", err)
+	}
+	if updated, err := repo.FindByID(room.ID); err != nil || updated.Description != "Clean" {
+		return fmt.Errorf("update was not persisted: %v", err)
+	}
+
+	if err := repo.Delete(room.ID); err != nil {
+		return fmt.Errorf("delete: %w", err)
+	}
+	if _, err := repo.FindByID(room.ID); err == nil {
+		return errors.New("room still exists after delete")
+	}
+	if _, err := repo.FindByID("conformance-missing"); err == nil {
+		return errors.New("find by id of a missing room succeeded")
+	}
+	return nil
+}
+
+func checkRoomsQuery(f repository.Factory) error {
+	repo := f.Rooms()
+	for i, area := range []string{"north", "north", "south"} {
+		room := &rooms.Room{Entity: entity(fmt.Sprintf("area-%d", i)), Name: fmt.Sprintf("Room %d", i), Area: area}
+		if _, err := repo.Import(room); err != nil {
+			return fmt.Errorf("import: %w", err)
+		}
+		defer repo.Delete(room.ID)
+	}
+
+	north, err := repo.FindAllWithQuery(repository.RoomsQuery{Area: "north"})
+	if err != nil {
+		return err
+	}
+	if err := expectCount("area query", len(north), 2); err != nil {
+		return err
+	}
+	single, err := repo.FindAllWithQuery(repository.RoomsQuery{Area: "north", Name: "Room 1"})
+	if err != nil {
+		return err
+	}
+	if err := expectCount("area and name query", len(single), 1); err != nil {
+		return err
+	}
+	all, err := repo.FindAll()
+	if err != nil {
+		return err
+	}
+	return expectCount("find all", len(all), 3)
+}
+
+func checkItems(f repository.Factory) error {
+	repo := f.Items()
+	template := &items.Item{Entity: entity("sword"), Name: "Sword", Type: items.ItemTypeWeapon, IsTemplate: true}
+	instance := &items.Item{Entity: entity("sword-1"), Name: "Sword", Type: items.ItemTypeWeapon, TemplateID: template.ID}
+	for _, item := range []*items.Item{template, instance} {
+		if _, err := repo.Import(item); err != nil {
+			return fmt.Errorf("import: %w", err)
+		}
+		defer repo.Delete(item.ID)
+	}
+
+	templates, err := repo.FindTemplateByName("Sword")
+	if err != nil {
+		return err
+	}
+	if err := expectCount("template by name", len(templates), 1); err != nil {
+		return err
+	}
+	instances, err := repo.FindByTemplateID(template.ID)
+	if err != nil {
+		return err
+	}
+	if err := expectCount("instances by template", len(instances), 1); err != nil {
+		return err
+	}
+	byName, err := repo.FindByName("Sword")
+	if err != nil {
+		return err
+	}
+	return expectCount("items by name", len(byName), 2)
+}
+
+func checkCharacters(f repository.Factory) error {
+	repo := f.Characters()
+	character := &characters.Character{Entity: entity("char"), Name: "Aria"}
+	character.BelongsUserID = "conformance-user"
+	if _, err := repo.Import(character); err != nil {
+		return fmt.Errorf("import: %w", err)
+	}
+	defer repo.Delete(character.ID)
+
+	byUser, err := repo.FindAllForUser("conformance-user")
+	if err != nil {
+		return err
+	}
+	if err := expectCount("characters for user", len(byUser), 1); err != nil {
+		return err
+	}
+	byName, err := repo.FindByName("Aria")
+	if err != nil {
+		return err
+	}
+	return expectCount("characters by name", len(byName), 1)
+}
+
+func checkCharacterTemplates(f repository.Factory) error {
+	repo := f.CharacterTemplates()
+	template := &characters.CharacterTemplate{Entity: entity("template"), Name: "Warrior"}
+	if _, err := repo.Import(template); err != nil {
+		return fmt.Errorf("import: %w", err)
+	}
+	defer repo.Delete(template.ID)
+
+	count, err := repo.Count()
+	if err != nil {
+		return err
+	}
+	return expectCount("count", count, 1)
+}
+
+func checkUsers(f repository.Factory) error {
+	repo := f.Users()
+	online := &entities.User{Entity: entity("user-1"), RefID: "conformance|1", Nickname: "alpha", IsOnline: true}
+	offline := &entities.User{Entity: entity("user-2"), RefID: "conformance|2", Nickname: "beta"}
+	for _, user := range []*entities.User{online, offline} {
+		if _, err := repo.Import(user); err != nil {
+			return fmt.Errorf("import: %w", err)
+		}
+		defer repo.Delete(user.ID)
+	}
+
+	byRef, err := repo.FindByRefID("conformance|2")
+	if err != nil || byRef.Nickname != "beta" {
+		return fmt.Errorf("find by refid: %v", err)
+	}
+	if _, err := repo.FindByNickname("alpha"); err != nil {
+		return fmt.Errorf("find by nickname: %w", err)
+	}
+
+	onlineUsers, err := repo.FindAllOnline()
+	if err != nil {
+		return err
+	}
+	if err := expectCount("online users", len(onlineUsers), 1); err != nil {
+		return err
+	}
+
+	byRef.Nickname = "gamma"
+	if err := repo.Update(byRef.RefID, byRef); err != nil {
+		return fmt.Errorf("update by refid: %w", err)
+	}
+	if _, err := repo.FindByNickname("gamma"); err != nil {
+		return fmt.Errorf("update by refid was not persisted: %w", err)
+	}
+	return nil
+}
+
+func checkNPCs(f repository.Factory) error {
+	repo := f.NPCs()
+	n := &npc.NPC{Entity: entity("npc"), Name: "Guard"}
+	n.CurrentRoomID = "conformance-room"
+	if _, err := repo.Import(n); err != nil {
+		return fmt.Errorf("import: %w", err)
+	}
+	defer repo.Delete(n.ID)
+
+	inRoom, err := repo.FindByRoom("conformance-room")
+	if err != nil {
+		return err
+	}
+	if err := expectCount("npcs by room", len(inRoom), 1); err != nil {
+		return err
+	}
+	byName, err := repo.FindByName("Guard")
+	if err != nil {
+		return err
+	}
+	return expectCount("npcs by name", len(byName), 1)
+}
+
+func checkNPCSpawners(f repository.Factory) error {
+	repo := f.NPCSpawners()
+	spawner := &npc.NPCSpawner{Entity: entity("spawner"), TemplateID: "conformance-npc", RoomID: "conformance-room"}
+	if _, err := repo.Import(spawner); err != nil {
+		return fmt.Errorf("import: %w", err)
+	}
+	defer repo.Delete(spawner.ID)
+
+	inRoom, err := repo.FindByRoom("conformance-room")
+	if err != nil {
+		return err
+	}
+	if err := expectCount("spawners by room", len(inRoom), 1); err != nil {
+		return err
+	}
+	byTemplate, err := repo.FindByTemplate("conformance-npc")
+	if err != nil {
+		return err
+	}
+	return expectCount("spawners by template", len(byTemplate), 1)
+}
+
+func checkDialogs(f repository.Factory) error {
+	repo := f.Dialogs()
+	dialog := &dialogs.Dialog{Entity: entity("dialog"), Name: "Guard Greeting"}
+	if _, err := repo.Import(dialog); err != nil {
+		return fmt.Errorf("import: %w", err)
+	}
+	defer repo.Delete(dialog.ID)
+
+	found, err := repo.FindByName("Guard Greeting")
+	if err != nil {
+		return err
+	}
+	if found.ID != dialog.ID {
+		return fmt.Errorf("find by name returned %s", found.ID)
+	}
+	if _, err := repo.FindByName("conformance-missing"); err == nil {
+		return errors.New("find by name of a missing dialog succeeded")
+	}
+	return nil
+}
+
+func checkConversations(f repository.Factory) error {
+	repo := f.Conversations()
+	conversation := &conversations.Conversation{Entity: entity("conversation"), CharacterID: "conformance-char", TargetID: "conformance-npc"}
+	stored, err := repo.Store(conversation)
+	if err != nil {
+		return fmt.Errorf("store: %w", err)
+	}
+	defer repo.Delete(stored.ID)
+
+	if _, err := repo.FindByCharacterAndTarget("conformance-char", "conformance-npc"); err != nil {
+		return fmt.Errorf("find by character and target: %w", err)
+	}
+	if _, err := repo.FindByCharacterAndTarget("conformance-char", "conformance-other"); err == nil {
+		return errors.New("find by character and a different target succeeded")
+	}
+	all, err := repo.FindAllForCharacter("conformance-char")
+	if err != nil {
+		return err
+	}
+	return expectCount("conversations for character", len(all), 1)
+}
+
+func checkSkills(f repository.Factory) error {
+	repo := f.Skills()
+	skill := &skills.Skill{Entity: entity("skill"), Name: "Conformance Strike"}
+	if _, err := repo.Import(skill); err != nil {
+		return fmt.Errorf("import: %w", err)
+	}
+	defer repo.Delete(skill.ID)
+
+	byName, err := repo.FindByName("Conformance Strike")
+	if err != nil {
+		return err
+	}
+	return expectCount("skills by name", len(byName), 1)
+}
+
+func checkQuests(f repository.Factory) error {
+	repo := f.Quests()
+	quest := &quests.Quest{Entity: entity("quest"), Name: "Conformance Errand"}
+	if _, err := repo.Import(quest); err != nil {
+		return fmt.Errorf("import: %w", err)
+	}
+	defer repo.Delete(quest.ID)
+
+	byName, err := repo.FindByName("Conformance Errand")
+	if err != nil {
+		return err
+	}
+	return expectCount("quests by name", len(byName), 1)
+}
+
+func checkParties(f repository.Factory) error {
+	repo := f.Parties()
+	stored, err := repo.Store(&entities.Party{Name: "Conformance Party", LeaderID: "conformance-char", Invites: []string{"conformance-other"}})
+	if err != nil {
+		return fmt.Errorf("store: %w", err)
+	}
+	defer repo.Delete(stored.ID)
+
+	found, err := repo.FindByID(stored.ID)
+	if err != nil {
+		return fmt.Errorf("find by id: %w", err)
+	}
+	if found.LeaderID != "conformance-char" || len(found.Invites) != 1 {
+		return fmt.Errorf("find by id: got leader %q and %d invites", found.LeaderID, len(found.Invites))
+	}
+	return nil
+}
+
+func checkLootTables(f repository.Factory) error {
+	repo := f.LootTables()
+	table := &items.LootTable{Entity: entity("loottable"), Name: "Conformance Drops"}
+	if _, err := repo.Import(table); err != nil {
+		return fmt.Errorf("import: %w", err)
+	}
+	defer repo.Delete(table.ID)
+
+	byName, err := repo.FindByName("Conformance Drops")
+	if err != nil {
+		return err
+	}
+	return expectCount("loot tables by name", len(byName), 1)
+}
+
+func checkScripts(f repository.Factory) error {
+	repo := f.Scripts()
+	script := &scripts.Script{Entity: entity("script"), Name: "conformance_script", Type: scripts.ScriptTypeEvent}
+	if _, err := repo.Import(script); err != nil {
+		return fmt.Errorf("import: %w", err)
+	}
+	defer repo.Delete(script.ID)
+
+	byName, err := repo.FindByName("conformance_script")
+	if err != nil {
+		return err
+	}
+	if err := expectCount("scripts by name", len(byName), 1); err != nil {
+		return err
+	}
+	if byName[0].Type != scripts.ScriptTypeEvent {
+		return fmt.Errorf("find by name: got type %q", byName[0].Type)
+	}
+	return nil
+}
+
+func checkChannels(f repository.Factory) error {
+	repo := f.Channels()
+	channel := &channels.Channel{Entity: entity("channel"), Name: "conformance", AutoJoin: true}
+	if _, err := repo.Import(channel); err != nil {
+		return fmt.Errorf("import: %w", err)
+	}
+	defer repo.Delete(channel.ID)
+
+	byName, err := repo.FindByName("conformance")
+	if err != nil {
+		return err
+	}
+	if err := expectCount("channels by name", len(byName), 1); err != nil {
+		return err
+	}
+	if !byName[0].AutoJoin {
+		return errors.New("find by name: autoJoin was not persisted")
+	}
+	return nil
+}
+
+func checkMail(f repository.Factory) error {
+	repo := f.Mail()
+	for i, to := range []string{"conformance-char", "conformance-char", "conformance-other"} {
+		m := &mail.Mail{Entity: entity(fmt.Sprintf("mail-%d", i)), FromCharacterID: "conformance-sender", ToCharacterID: to}
+		if _, err := repo.Import(m); err != nil {
+			return fmt.Errorf("import: %w", err)
+		}
+		defer repo.Delete(m.ID)
+	}
+
+	forCharacter, err := repo.FindAllForCharacter("conformance-char")
+	if err != nil {
+		return err
+	}
+	return expectCount("mail for character", len(forCharacter), 2)
+}
+
+// checkAudit can't delete its entry, the audit log has no delete
+func checkAudit(f repository.Factory) error {
+	repo := f.Audit()
+	stored, err := repo.Store(&audit.Entry{UserID: "conformance-admin", Command: "goto"})
+	if err != nil {
+		return fmt.Errorf("store: %w", err)
+	}
+
+	forUser, err := repo.FindAllForUser("conformance-admin")
+	if err != nil {
+		return err
+	}
+	for _, entry := range forUser {
+		if entry.UserID != "conformance-admin" {
+			return fmt.Errorf("audit for user returned an entry of %q", entry.UserID)
+		}
+		if entry.ID == stored.ID {
+			return nil
+		}
+	}
+	return errors.New("audit for user is missing the stored entry")
+}
+
+func checkServerSettings(f repository.Factory) error {
+	repo := f.ServerSettings()
+	original, err := repo.Get()
+	if err != nil {
+		return err
+	}
+	defer repo.Upsert(original)
+
+	for _, name := range []string{"First", "Second"} {
+		if err := repo.Upsert(&settings.ServerSettings{ServerName: name}); err != nil {
+			return fmt.Errorf("upsert: %w", err)
+		}
+		stored, err := repo.Get()
+		if err != nil {
+			return err
+		}
+		if stored.ServerName != name {
+			return fmt.Errorf("expected server name %q, got %q", name, stored.ServerName)
+		}
+	}
+	return nil
+}
+
+func checkWorldSnapshot(f repository.Factory) error {
+	repo := f.WorldSnapshots()
+	if existing, err := repo.Get(); err != nil || existing != nil {
+		return fmt.Errorf("expected no snapshot in a scratch database: %v", err)
+	}
+
+	snapshot := &snapshots.WorldSnapshot{NPCInstances: []*npc.NPC{{Entity: entity("snapshot-npc"), Name: "Rat"}}}
+	if err := repo.Upsert(snapshot); err != nil {
+		return fmt.Errorf("upsert: %w", err)
+	}
+	if err := repo.Upsert(snapshot); err != nil {
+		return fmt.Errorf("second upsert: %w", err)
+	}
+	stored, err := repo.Get()
+	if err != nil || stored == nil {
+		return fmt.Errorf("get: %v", err)
+	}
+	if len(stored.NPCInstances) != 1 || stored.NPCInstances[0].Name != "Rat" {
+		return errors.New("snapshot content was not persisted")
+	}
+
+	if err := repo.Drop(); err != nil {
+		return fmt.Errorf("drop: %w", err)
+	}
+	if stored, _ := repo.Get(); stored != nil {
+		return errors.New("snapshot still exists after drop")
+	}
+	return nil
+}
@@ -0,0 +1,81 @@
+package repository
+
+import (
+	"errors"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/talesmud/talesmud/pkg/db"
+	"github.com/talesmud/talesmud/pkg/entities"
+	"github.com/talesmud/talesmud/pkg/entities/dialogs"
+)
+
+type dialogsRepository struct {
+	*genericRepo
+}
+
+// NewDialogsRepository creates a new dialogs repository.
+func NewDialogsRepository(client db.Client) DialogsRepository {
+	return &dialogsRepository{
+		genericRepo: newGenericRepo(client, "dialogs", func() interface{} {
+			return &dialogs.Dialog{}
+		}),
+	}
+}
+
+func (repo *dialogsRepository) FindAll() ([]*dialogs.Dialog, error) {
+	results := make([]*dialogs.Dialog, 0)
+	if err := repo.genericRepo.FindAll(func(elem interface{}) {
+		results = append(results, elem.(*dialogs.Dialog))
+	}); err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+func (repo *dialogsRepository) FindByID(id string) (*dialogs.Dialog, error) {
+	if id == "" {
+		log.Error("Dialogs::FindByID - id is empty")
+		return nil, errors.New("empty id")
+	}
+	result, err := repo.genericRepo.FindByID(id)
+	if err == nil {
+		return result.(*dialogs.Dialog), nil
+	}
+	return nil, err
+}
+
+func (repo *dialogsRepository) FindByName(name string) (*dialogs.Dialog, error) {
+	if name == "" {
+		log.Error("Dialogs::FindByName - name is empty")
+		return nil, errors.New("empty name")
+	}
+	result, err := repo.genericRepo.FindByField("name", name)
+	if err == nil {
+		return result.(*dialogs.Dialog), nil
+	}
+	return nil, err
+}
+
+func (repo *dialogsRepository) Store(dialog *dialogs.Dialog) (*dialogs.Dialog, error) {
+	dialog.Entity = entities.NewEntity()
+	return repo.Import(dialog)
+}
+
+func (repo *dialogsRepository) Import(dialog *dialogs.Dialog) (*dialogs.Dialog, error) {
+	if _, err := repo.genericRepo.Store(dialog); err != nil {
+		return nil, err
+	}
+	return dialog, nil
+}
+
+func (repo *dialogsRepository) Update(id string, dialog *dialogs.Dialog) error {
+	return repo.genericRepo.Update(dialog, id)
+}
+
+func (repo *dialogsRepository) Delete(id string) error {
+	return repo.genericRepo.Delete(id)
+}
+
+func (repo *dialogsRepository) Drop() error {
+	return repo.genericRepo.DropCollection()
+}
@@ -14,6 +14,12 @@ type Factory interface {
 	Dialogs() DialogsRepository
 	Conversations() ConversationsRepository
 	LootTables() LootTablesRepository
+	Quests() QuestsRepository
+	Skills() SkillsRepository
 	ServerSettings() ServerSettingsRepository
+	WorldSnapshots() WorldSnapshotsRepository
+	Channels() ChannelsRepository
+	Mail() MailRepository
+	Audit() AuditRepository
 	Close() error
 }
@@ -11,27 +11,35 @@ import (
 	"github.com/talesmud/talesmud/pkg/db"
 )
 
-type sqliteGenericRepo struct {
+// genericRepo stores entities as JSON documents, the SQL differences of the backends are handled by the dialect
+type genericRepo struct {
 	db        *sql.DB
+	dialect   db.Dialect
 	table     string
 	generator func() interface{}
 }
 
-func newSQLiteGenericRepo(dbConn *sql.DB, table string, gen func() interface{}) *sqliteGenericRepo {
-	return &sqliteGenericRepo{
-		db:        dbConn,
+func newGenericRepo(client db.Client, table string, gen func() interface{}) *genericRepo {
+	return &genericRepo{
+		db:        client.DB(),
+		dialect:   client.Dialect(),
 		table:     table,
 		generator: gen,
 	}
 }
 
-func (repo *sqliteGenericRepo) DropCollection() error {
+// ph returns the placeholder for the n-th query argument
+func (repo *genericRepo) ph(n int) string {
+	return repo.dialect.Placeholder(n)
+}
+
+func (repo *genericRepo) DropCollection() error {
 	_, err := repo.db.Exec(fmt.Sprintf("DELETE FROM %s", repo.table))
 	return err
 }
 
-func (repo *sqliteGenericRepo) FindByID(id string) (interface{}, error) {
-	row := repo.db.QueryRow(fmt.Sprintf("SELECT data FROM %s WHERE id = ?", repo.table), id)
+func (repo *genericRepo) FindByID(id string) (interface{}, error) {
+	row := repo.db.QueryRow(fmt.Sprintf("SELECT data FROM %s WHERE id = %s", repo.table, repo.ph(1)), id)
 	var payload string
 	if err := row.Scan(&payload); err != nil {
 		return nil, errors.New("entity not found")
@@ -43,12 +51,11 @@ func (repo *sqliteGenericRepo) FindByID(id string) (interface{}, error) {
 	return entity, nil
 }
 
-func (repo *sqliteGenericRepo) FindByField(key string, value string) (interface{}, error) {
-	path := "$." + key
+func (repo *genericRepo) FindByField(key string, value string) (interface{}, error) {
 	row := repo.db.QueryRow(
-		fmt.Sprintf("SELECT data FROM %s WHERE json_extract(data, ?) = ? LIMIT 1", repo.table),
-		path,
-		value,
+		fmt.Sprintf("SELECT data FROM %s WHERE %s = %s LIMIT 1", repo.table, repo.dialect.JSONField(repo.ph(1)), repo.ph(2)),
+		repo.dialect.JSONPath(key),
+		repo.dialect.JSONValue(value),
 	)
 	var payload string
 	if err := row.Scan(&payload); err != nil {
@@ -61,7 +68,7 @@ func (repo *sqliteGenericRepo) FindByField(key string, value string) (interface{
 	return entity, nil
 }
 
-func (repo *sqliteGenericRepo) UpdateByField(item interface{}, key string, value string) error {
+func (repo *genericRepo) UpdateByField(item interface{}, key string, value string) error {
 	id, err := extractEntityID(item)
 	if err != nil {
 		return err
@@ -70,19 +77,18 @@ func (repo *sqliteGenericRepo) UpdateByField(item interface{}, key string, value
 	if err != nil {
 		return err
 	}
-	path := "$." + key
 	_, err = repo.db.Exec(
-		fmt.Sprintf("UPDATE %s SET id = ?, data = ? WHERE json_extract(data, ?) = ?", repo.table),
+		fmt.Sprintf("UPDATE %s SET id = %s, data = %s WHERE %s = %s", repo.table, repo.ph(1), repo.ph(2), repo.dialect.JSONField(repo.ph(3)), repo.ph(4)),
 		id,
 		string(payload),
-		path,
-		value,
+		repo.dialect.JSONPath(key),
+		repo.dialect.JSONValue(value),
 	)
 	return err
 }
 
-func (repo *sqliteGenericRepo) FindAllWithParam(params *db.QueryParams, collector func(element interface{})) error {
-	where, args := buildWhere(params)
+func (repo *genericRepo) FindAllWithParam(params *db.QueryParams, collector func(element interface{})) error {
+	where, args := buildWhere(repo.dialect, params)
 	query := fmt.Sprintf("SELECT data FROM %s", repo.table)
 	if where != "" {
 		query += " WHERE " + where
@@ -108,7 +114,7 @@ func (repo *sqliteGenericRepo) FindAllWithParam(params *db.QueryParams, collecto
 	return rows.Err()
 }
 
-func (repo *sqliteGenericRepo) FindAll(collector func(element interface{})) error {
+func (repo *genericRepo) FindAll(collector func(element interface{})) error {
 	rows, err := repo.db.Query(fmt.Sprintf("SELECT data FROM %s", repo.table))
 	if err != nil {
 		return err
@@ -129,7 +135,7 @@ func (repo *sqliteGenericRepo) FindAll(collector func(element interface{})) erro
 	return rows.Err()
 }
 
-func (repo *sqliteGenericRepo) Store(entity interface{}) (interface{}, error) {
+func (repo *genericRepo) Store(entity interface{}) (interface{}, error) {
 	id, err := extractEntityID(entity)
 	if err != nil {
 		return nil, err
@@ -139,7 +145,7 @@ func (repo *sqliteGenericRepo) Store(entity interface{}) (interface{}, error) {
 		return nil, err
 	}
 	_, err = repo.db.Exec(
-		fmt.Sprintf("INSERT INTO %s (id, data) VALUES (?, ?)", repo.table),
+		fmt.Sprintf("INSERT INTO %s (id, data) VALUES (%s, %s)", repo.table, repo.ph(1), repo.ph(2)),
 		id,
 		string(payload),
 	)
@@ -149,34 +155,45 @@ func (repo *sqliteGenericRepo) Store(entity interface{}) (interface{}, error) {
 	return entity, nil
 }
 
-func (repo *sqliteGenericRepo) Delete(id string) error {
-	_, err := repo.db.Exec(fmt.Sprintf("DELETE FROM %s WHERE id = ?", repo.table), id)
+func (repo *genericRepo) Delete(id string) error {
+	_, err := repo.db.Exec(fmt.Sprintf("DELETE FROM %s WHERE id = %s", repo.table, repo.ph(1)), id)
 	return err
 }
 
-func (repo *sqliteGenericRepo) Update(item interface{}, id string) error {
+func (repo *genericRepo) Update(item interface{}, id string) error {
 	payload, err := json.Marshal(item)
 	if err != nil {
 		return err
 	}
 	_, err = repo.db.Exec(
-		fmt.Sprintf("UPDATE %s SET data = ? WHERE id = ?", repo.table),
+		fmt.Sprintf("UPDATE %s SET data = %s WHERE id = %s", repo.table, repo.ph(1), repo.ph(2)),
 		string(payload),
 		id,
 	)
 	return err
 }
 
-func buildWhere(params *db.QueryParams) (string, []interface{}) {
+// upsert inserts the entity or replaces the stored document with the same id
+func (repo *genericRepo) upsert(id string, item interface{}) error {
+	payload, err := json.Marshal(item)
+	if err != nil {
+		return err
+	}
+	_, err = repo.db.Exec(repo.dialect.Upsert(repo.table), id, string(payload))
+	return err
+}
+
+func buildWhere(dialect db.Dialect, params *db.QueryParams) (string, []interface{}) {
 	if params == nil {
 		return "", nil
 	}
 	clauses := []string{}
 	args := []interface{}{}
 	for _, param := range params.Params() {
-		path := "$." + param.Key
-		clauses = append(clauses, "json_extract(data, ?) = ?")
-		args = append(args, path, param.Value)
+		path := dialect.Placeholder(len(args) + 1)
+		value := dialect.Placeholder(len(args) + 2)
+		clauses = append(clauses, dialect.JSONField(path)+" = "+value)
+		args = append(args, dialect.JSONPath(param.Key), dialect.JSONValue(param.Value))
 	}
 	return strings.Join(clauses, " AND "), args
 }
@@ -2,13 +2,19 @@ package repository
 
 import (
 	"github.com/talesmud/talesmud/pkg/entities"
+	"github.com/talesmud/talesmud/pkg/entities/audit"
+	"github.com/talesmud/talesmud/pkg/entities/channels"
 	"github.com/talesmud/talesmud/pkg/entities/characters"
 	"github.com/talesmud/talesmud/pkg/entities/conversations"
 	"github.com/talesmud/talesmud/pkg/entities/dialogs"
 	"github.com/talesmud/talesmud/pkg/entities/items"
+	"github.com/talesmud/talesmud/pkg/entities/mail"
 	npc "github.com/talesmud/talesmud/pkg/entities/npcs"
+	"github.com/talesmud/talesmud/pkg/entities/quests"
 	"github.com/talesmud/talesmud/pkg/entities/rooms"
 	"github.com/talesmud/talesmud/pkg/entities/settings"
+	"github.com/talesmud/talesmud/pkg/entities/skills"
+	"github.com/talesmud/talesmud/pkg/entities/snapshots"
 	"github.com/talesmud/talesmud/pkg/scripts"
 )
 
@@ -75,6 +81,7 @@ type UsersRepository interface {
 	Update(refID string, user *entities.User) error
 	FindByID(id string) (*entities.User, error)
 	FindByRefID(refID string) (*entities.User, error)
+	FindByNickname(nickname string) (*entities.User, error)
 	Delete(id string) error
 }
 
@@ -194,8 +201,72 @@ type LootTablesRepository interface {
 	Drop() error
 }
 
+// QuestsRepository provides access to quest data.
+type QuestsRepository interface {
+	FindAll() ([]*quests.Quest, error)
+	FindByID(id string) (*quests.Quest, error)
+	FindByName(name string) ([]*quests.Quest, error)
+	Store(quest *quests.Quest) (*quests.Quest, error)
+	Import(quest *quests.Quest) (*quests.Quest, error)
+	Update(id string, quest *quests.Quest) error
+	Delete(id string) error
+	Drop() error
+}
+
+// SkillsRepository provides access to skill data.
+type SkillsRepository interface {
+	FindAll() ([]*skills.Skill, error)
+	FindByID(id string) (*skills.Skill, error)
+	FindByName(name string) ([]*skills.Skill, error)
+	Store(skill *skills.Skill) (*skills.Skill, error)
+	Import(skill *skills.Skill) (*skills.Skill, error)
+	Update(id string, skill *skills.Skill) error
+	Delete(id string) error
+	Drop() error
+}
+
+// ChannelsRepository provides access to chat channel data.
+type ChannelsRepository interface {
+	FindAll() ([]*channels.Channel, error)
+	FindByID(id string) (*channels.Channel, error)
+	FindByName(name string) ([]*channels.Channel, error)
+	Store(channel *channels.Channel) (*channels.Channel, error)
+	Import(channel *channels.Channel) (*channels.Channel, error)
+	Update(id string, channel *channels.Channel) error
+	Delete(id string) error
+	Drop() error
+}
+
+// MailRepository provides access to the mail between characters.
+type MailRepository interface {
+	FindAll() ([]*mail.Mail, error)
+	FindByID(id string) (*mail.Mail, error)
+	FindAllForCharacter(characterID string) ([]*mail.Mail, error)
+	Store(m *mail.Mail) (*mail.Mail, error)
+	Import(m *mail.Mail) (*mail.Mail, error)
+	Update(id string, m *mail.Mail) error
+	Delete(id string) error
+	Drop() error
+}
+
+// AuditRepository provides access to the audit log of staff commands.
+type AuditRepository interface {
+	FindAll() ([]*audit.Entry, error)
+	FindByID(id string) (*audit.Entry, error)
+	FindAllForUser(userID string) ([]*audit.Entry, error)
+	Store(entry *audit.Entry) (*audit.Entry, error)
+	Drop() error
+}
+
 // ServerSettingsRepository provides access to server settings (singleton).
 type ServerSettingsRepository interface {
 	Get() (*settings.ServerSettings, error)
 	Upsert(s *settings.ServerSettings) error
 }
+
+// WorldSnapshotsRepository provides access to the live world snapshot (singleton).
+type WorldSnapshotsRepository interface {
+	Get() (*snapshots.WorldSnapshot, error)
+	Upsert(s *snapshots.WorldSnapshot) error
+	Drop() error
+}
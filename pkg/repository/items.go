@@ -0,0 +1,135 @@
+package repository
+
+import (
+	"errors"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/talesmud/talesmud/pkg/db"
+	"github.com/talesmud/talesmud/pkg/entities"
+	i "github.com/talesmud/talesmud/pkg/entities/items"
+)
+
+type itemsRepository struct {
+	*genericRepo
+}
+
+// NewItemsRepository creates a new items repository.
+func NewItemsRepository(client db.Client) ItemsRepository {
+	return &itemsRepository{
+		genericRepo: newGenericRepo(client, "items", func() interface{} {
+			return &i.Item{}
+		}),
+	}
+}
+
+func (repo *itemsRepository) Drop() error {
+	return repo.genericRepo.DropCollection()
+}
+
+func (repo *itemsRepository) FindByID(id string) (*i.Item, error) {
+	if id == "" {
+		log.Error("Items::FindByID - id is empty")
+		return nil, errors.New("empty id")
+	}
+	result, err := repo.genericRepo.FindByID(id)
+	if err == nil {
+		return result.(*i.Item), nil
+	}
+	return nil, err
+}
+
+func (repo *itemsRepository) FindByName(name string) ([]*i.Item, error) {
+	results := make([]*i.Item, 0)
+	_ = repo.genericRepo.FindAllWithParam(
+		db.NewQueryParams(db.QueryParam{Key: "name", Value: name}),
+		func(elem interface{}) {
+			results = append(results, elem.(*i.Item))
+		})
+	return results, nil
+}
+
+func (repo *itemsRepository) FindAll(query ItemsQuery) ([]*i.Item, error) {
+	results := make([]*i.Item, 0)
+	if err := repo.genericRepo.FindAll(func(elem interface{}) {
+		item := elem.(*i.Item)
+		if query.matches(item) {
+			results = append(results, item)
+		}
+	}); err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+func (repo *itemsRepository) Update(id string, item *i.Item) error {
+	return repo.genericRepo.Update(item, id)
+}
+
+func (repo *itemsRepository) Delete(id string) error {
+	return repo.genericRepo.Delete(id)
+}
+
+func (repo *itemsRepository) Store(item *i.Item) (*i.Item, error) {
+	item.Entity = entities.NewEntity()
+	return repo.Import(item)
+}
+
+func (repo *itemsRepository) Import(item *i.Item) (*i.Item, error) {
+	result, err := repo.genericRepo.Store(item)
+	if result == nil {
+		return nil, err
+	}
+	return result.(*i.Item), nil
+}
+
+func (repo *itemsRepository) FindAllTemplates(query ItemsQuery) ([]*i.Item, error) {
+	results := make([]*i.Item, 0)
+	if err := repo.genericRepo.FindAll(func(elem interface{}) {
+		item := elem.(*i.Item)
+		if item.IsTemplate && query.matches(item) {
+			results = append(results, item)
+		}
+	}); err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+func (repo *itemsRepository) FindAllInstances(query ItemsQuery) ([]*i.Item, error) {
+	results := make([]*i.Item, 0)
+	if err := repo.genericRepo.FindAll(func(elem interface{}) {
+		item := elem.(*i.Item)
+		if !item.IsTemplate && query.matches(item) {
+			results = append(results, item)
+		}
+	}); err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+func (repo *itemsRepository) FindTemplateByName(name string) ([]*i.Item, error) {
+	results := make([]*i.Item, 0)
+	_ = repo.genericRepo.FindAllWithParam(
+		db.NewQueryParams(db.QueryParam{Key: "name", Value: name}),
+		func(elem interface{}) {
+			item := elem.(*i.Item)
+			if item.IsTemplate {
+				results = append(results, item)
+			}
+		})
+	return results, nil
+}
+
+func (repo *itemsRepository) FindByTemplateID(templateID string) ([]*i.Item, error) {
+	results := make([]*i.Item, 0)
+	if err := repo.genericRepo.FindAll(func(elem interface{}) {
+		item := elem.(*i.Item)
+		if item.TemplateID == templateID {
+			results = append(results, item)
+		}
+	}); err != nil {
+		return nil, err
+	}
+	return results, nil
+}
@@ -0,0 +1,80 @@
+package repository
+
+import (
+	"errors"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/talesmud/talesmud/pkg/db"
+	"github.com/talesmud/talesmud/pkg/entities"
+	"github.com/talesmud/talesmud/pkg/entities/items"
+)
+
+type lootTablesRepository struct {
+	*genericRepo
+}
+
+// NewLootTablesRepository creates a new loot tables repository.
+func NewLootTablesRepository(client db.Client) LootTablesRepository {
+	return &lootTablesRepository{
+		genericRepo: newGenericRepo(client, "loot_tables", func() interface{} {
+			return &items.LootTable{}
+		}),
+	}
+}
+
+func (repo *lootTablesRepository) Drop() error {
+	return repo.genericRepo.DropCollection()
+}
+
+func (repo *lootTablesRepository) FindByID(id string) (*items.LootTable, error) {
+	if id == "" {
+		log.Error("LootTables::FindByID - id is empty")
+		return nil, errors.New("empty id")
+	}
+	result, err := repo.genericRepo.FindByID(id)
+	if err == nil {
+		return result.(*items.LootTable), nil
+	}
+	return nil, err
+}
+
+func (repo *lootTablesRepository) FindByName(name string) ([]*items.LootTable, error) {
+	results := make([]*items.LootTable, 0)
+	_ = repo.genericRepo.FindAllWithParam(
+		db.NewQueryParams(db.QueryParam{Key: "name", Value: name}),
+		func(elem interface{}) {
+			results = append(results, elem.(*items.LootTable))
+		})
+	return results, nil
+}
+
+func (repo *lootTablesRepository) FindAll() ([]*items.LootTable, error) {
+	results := make([]*items.LootTable, 0)
+	if err := repo.genericRepo.FindAll(func(elem interface{}) {
+		results = append(results, elem.(*items.LootTable))
+	}); err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+func (repo *lootTablesRepository) Update(id string, lootTable *items.LootTable) error {
+	return repo.genericRepo.Update(lootTable, id)
+}
+
+func (repo *lootTablesRepository) Delete(id string) error {
+	return repo.genericRepo.Delete(id)
+}
+
+func (repo *lootTablesRepository) Store(lootTable *items.LootTable) (*items.LootTable, error) {
+	lootTable.Entity = entities.NewEntity()
+	return repo.Import(lootTable)
+}
+
+func (repo *lootTablesRepository) Import(lootTable *items.LootTable) (*items.LootTable, error) {
+	result, err := repo.genericRepo.Store(lootTable)
+	if result == nil {
+		return nil, err
+	}
+	return result.(*items.LootTable), nil
+}
@@ -0,0 +1,86 @@
+package repository
+
+import (
+	"errors"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/talesmud/talesmud/pkg/db"
+	"github.com/talesmud/talesmud/pkg/entities"
+	"github.com/talesmud/talesmud/pkg/entities/mail"
+)
+
+type mailRepository struct {
+	*genericRepo
+}
+
+// NewMailRepository creates a new mail repository.
+func NewMailRepository(client db.Client) MailRepository {
+	return &mailRepository{
+		genericRepo: newGenericRepo(client, "mail", func() interface{} {
+			return &mail.Mail{}
+		}),
+	}
+}
+
+func (repo *mailRepository) Drop() error {
+	return repo.genericRepo.DropCollection()
+}
+
+func (repo *mailRepository) FindByID(id string) (*mail.Mail, error) {
+	if id == "" {
+		log.Error("Mail::FindByID - id is empty")
+		return nil, errors.New("empty id")
+	}
+	result, err := repo.genericRepo.FindByID(id)
+	if err == nil {
+		return result.(*mail.Mail), nil
+	}
+	return nil, err
+}
+
+func (repo *mailRepository) FindAllForCharacter(characterID string) ([]*mail.Mail, error) {
+	if characterID == "" {
+		log.Error("Mail::FindAllForCharacter - characterID is empty")
+		return nil, errors.New("empty characterID")
+	}
+	results := make([]*mail.Mail, 0)
+	if err := repo.genericRepo.FindAllWithParam(
+		db.NewQueryParams(db.QueryParam{Key: "toCharacterId", Value: characterID}),
+		func(elem interface{}) {
+			results = append(results, elem.(*mail.Mail))
+		}); err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+func (repo *mailRepository) FindAll() ([]*mail.Mail, error) {
+	results := make([]*mail.Mail, 0)
+	if err := repo.genericRepo.FindAll(func(elem interface{}) {
+		results = append(results, elem.(*mail.Mail))
+	}); err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+func (repo *mailRepository) Update(id string, m *mail.Mail) error {
+	return repo.genericRepo.Update(m, id)
+}
+
+func (repo *mailRepository) Delete(id string) error {
+	return repo.genericRepo.Delete(id)
+}
+
+func (repo *mailRepository) Store(m *mail.Mail) (*mail.Mail, error) {
+	m.Entity = entities.NewEntity()
+	return repo.Import(m)
+}
+
+func (repo *mailRepository) Import(m *mail.Mail) (*mail.Mail, error) {
+	result, err := repo.genericRepo.Store(m)
+	if result == nil {
+		return nil, err
+	}
+	return result.(*mail.Mail), nil
+}
@@ -0,0 +1,99 @@
+package repository
+
+import (
+	"errors"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/talesmud/talesmud/pkg/db"
+	"github.com/talesmud/talesmud/pkg/entities"
+	npc "github.com/talesmud/talesmud/pkg/entities/npcs"
+)
+
+type npcsRepository struct {
+	*genericRepo
+}
+
+// NewNPCsRepository creates a new NPC repository.
+func NewNPCsRepository(client db.Client) NPCsRepository {
+	return &npcsRepository{
+		genericRepo: newGenericRepo(client, "npcs", func() interface{} {
+			return &npc.NPC{}
+		}),
+	}
+}
+
+func (repo *npcsRepository) FindAll() ([]*npc.NPC, error) {
+	results := make([]*npc.NPC, 0)
+	if err := repo.genericRepo.FindAll(func(elem interface{}) {
+		results = append(results, elem.(*npc.NPC))
+	}); err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+func (repo *npcsRepository) FindByID(id string) (*npc.NPC, error) {
+	if id == "" {
+		log.Error("NPCs::FindByID - id is empty")
+		return nil, errors.New("empty id")
+	}
+	result, err := repo.genericRepo.FindByID(id)
+	if err == nil {
+		return result.(*npc.NPC), nil
+	}
+	return nil, err
+}
+
+func (repo *npcsRepository) FindByName(name string) ([]*npc.NPC, error) {
+	if name == "" {
+		log.Error("NPCs::FindByName - name is empty")
+		return nil, errors.New("empty name")
+	}
+	results := make([]*npc.NPC, 0)
+	params := db.NewQueryParams().With(db.QueryParam{Key: "name", Value: name})
+	if err := repo.genericRepo.FindAllWithParam(params, func(elem interface{}) {
+		results = append(results, elem.(*npc.NPC))
+	}); err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+func (repo *npcsRepository) FindByRoom(roomID string) ([]*npc.NPC, error) {
+	if roomID == "" {
+		log.Error("NPCs::FindByRoom - roomID is empty")
+		return nil, errors.New("empty roomID")
+	}
+	results := make([]*npc.NPC, 0)
+	params := db.NewQueryParams().With(db.QueryParam{Key: "currentRoom", Value: roomID})
+	if err := repo.genericRepo.FindAllWithParam(params, func(elem interface{}) {
+		results = append(results, elem.(*npc.NPC))
+	}); err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+func (repo *npcsRepository) Store(n *npc.NPC) (*npc.NPC, error) {
+	n.Entity = entities.NewEntity()
+	return repo.Import(n)
+}
+
+func (repo *npcsRepository) Import(n *npc.NPC) (*npc.NPC, error) {
+	if _, err := repo.genericRepo.Store(n); err != nil {
+		return nil, err
+	}
+	return n, nil
+}
+
+func (repo *npcsRepository) Update(id string, n *npc.NPC) error {
+	return repo.genericRepo.Update(n, id)
+}
+
+func (repo *npcsRepository) Delete(id string) error {
+	return repo.genericRepo.Delete(id)
+}
+
+func (repo *npcsRepository) Drop() error {
+	return repo.genericRepo.DropCollection()
+}
@@ -0,0 +1,99 @@
+package repository
+
+import (
+	"errors"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/talesmud/talesmud/pkg/db"
+	"github.com/talesmud/talesmud/pkg/entities"
+	npc "github.com/talesmud/talesmud/pkg/entities/npcs"
+)
+
+type npcSpawnersRepository struct {
+	*genericRepo
+}
+
+// NewNPCSpawnersRepository creates a new NPC spawners repository.
+func NewNPCSpawnersRepository(client db.Client) NPCSpawnersRepository {
+	return &npcSpawnersRepository{
+		genericRepo: newGenericRepo(client, "npc_spawners", func() interface{} {
+			return &npc.NPCSpawner{}
+		}),
+	}
+}
+
+func (repo *npcSpawnersRepository) FindAll() ([]*npc.NPCSpawner, error) {
+	results := make([]*npc.NPCSpawner, 0)
+	if err := repo.genericRepo.FindAll(func(elem interface{}) {
+		results = append(results, elem.(*npc.NPCSpawner))
+	}); err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+func (repo *npcSpawnersRepository) FindByID(id string) (*npc.NPCSpawner, error) {
+	if id == "" {
+		log.Error("NPCSpawners::FindByID - id is empty")
+		return nil, errors.New("empty id")
+	}
+	result, err := repo.genericRepo.FindByID(id)
+	if err == nil {
+		return result.(*npc.NPCSpawner), nil
+	}
+	return nil, err
+}
+
+func (repo *npcSpawnersRepository) FindByRoom(roomID string) ([]*npc.NPCSpawner, error) {
+	if roomID == "" {
+		log.Error("NPCSpawners::FindByRoom - roomID is empty")
+		return nil, errors.New("empty roomID")
+	}
+	results := make([]*npc.NPCSpawner, 0)
+	params := db.NewQueryParams().With(db.QueryParam{Key: "roomId", Value: roomID})
+	if err := repo.genericRepo.FindAllWithParam(params, func(elem interface{}) {
+		results = append(results, elem.(*npc.NPCSpawner))
+	}); err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+func (repo *npcSpawnersRepository) FindByTemplate(templateID string) ([]*npc.NPCSpawner, error) {
+	if templateID == "" {
+		log.Error("NPCSpawners::FindByTemplate - templateID is empty")
+		return nil, errors.New("empty templateID")
+	}
+	results := make([]*npc.NPCSpawner, 0)
+	params := db.NewQueryParams().With(db.QueryParam{Key: "templateId", Value: templateID})
+	if err := repo.genericRepo.FindAllWithParam(params, func(elem interface{}) {
+		results = append(results, elem.(*npc.NPCSpawner))
+	}); err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+func (repo *npcSpawnersRepository) Store(s *npc.NPCSpawner) (*npc.NPCSpawner, error) {
+	s.Entity = entities.NewEntity()
+	return repo.Import(s)
+}
+
+func (repo *npcSpawnersRepository) Import(s *npc.NPCSpawner) (*npc.NPCSpawner, error) {
+	if _, err := repo.genericRepo.Store(s); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+func (repo *npcSpawnersRepository) Update(id string, s *npc.NPCSpawner) error {
+	return repo.genericRepo.Update(s, id)
+}
+
+func (repo *npcSpawnersRepository) Delete(id string) error {
+	return repo.genericRepo.Delete(id)
+}
+
+func (repo *npcSpawnersRepository) Drop() error {
+	return repo.genericRepo.DropCollection()
+}
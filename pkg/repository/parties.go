@@ -0,0 +1,51 @@
+package repository
+
+import (
+	"github.com/talesmud/talesmud/pkg/db"
+	e "github.com/talesmud/talesmud/pkg/entities"
+)
+
+type partiesRepository struct {
+	*genericRepo
+}
+
+// NewPartiesRepository creates a new parties repository.
+func NewPartiesRepository(client db.Client) PartiesRepository {
+	return &partiesRepository{
+		genericRepo: newGenericRepo(client, "parties", func() interface{} {
+			return &e.Party{}
+		}),
+	}
+}
+
+func (repo *partiesRepository) FindAll() ([]*e.Party, error) {
+	results := make([]*e.Party, 0)
+	if err := repo.genericRepo.FindAll(func(elem interface{}) {
+		results = append(results, elem.(*e.Party))
+	}); err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+func (repo *partiesRepository) Store(party *e.Party) (*e.Party, error) {
+	party.Entity = e.NewEntity()
+	result, err := repo.genericRepo.Store(party)
+	if result == nil {
+		return nil, err
+	}
+	return result.(*e.Party), nil
+}
+
+func (repo *partiesRepository) FindByID(id string) (*e.Party, error) {
+	result, err := repo.genericRepo.FindByID(id)
+	return result.(*e.Party), err
+}
+
+func (repo *partiesRepository) Update(id string, party *e.Party) error {
+	return repo.genericRepo.Update(party, id)
+}
+
+func (repo *partiesRepository) Delete(id string) error {
+	return repo.genericRepo.Delete(id)
+}
@@ -0,0 +1,13 @@
+package repository
+
+import "github.com/talesmud/talesmud/pkg/db/postgres"
+
+// PostgresFactory builds repositories backed by PostgreSQL.
+type PostgresFactory struct {
+	*clientFactory
+}
+
+// NewPostgresFactory returns a PostgreSQL-backed repository factory.
+func NewPostgresFactory(client *postgres.Client) *PostgresFactory {
+	return &PostgresFactory{clientFactory: &clientFactory{client: client}}
+}
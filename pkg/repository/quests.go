@@ -0,0 +1,80 @@
+package repository
+
+import (
+	"errors"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/talesmud/talesmud/pkg/db"
+	"github.com/talesmud/talesmud/pkg/entities"
+	"github.com/talesmud/talesmud/pkg/entities/quests"
+)
+
+type questsRepository struct {
+	*genericRepo
+}
+
+// NewQuestsRepository creates a new quests repository.
+func NewQuestsRepository(client db.Client) QuestsRepository {
+	return &questsRepository{
+		genericRepo: newGenericRepo(client, "quests", func() interface{} {
+			return &quests.Quest{}
+		}),
+	}
+}
+
+func (repo *questsRepository) Drop() error {
+	return repo.genericRepo.DropCollection()
+}
+
+func (repo *questsRepository) FindByID(id string) (*quests.Quest, error) {
+	if id == "" {
+		log.Error("Quests::FindByID - id is empty")
+		return nil, errors.New("empty id")
+	}
+	result, err := repo.genericRepo.FindByID(id)
+	if err == nil {
+		return result.(*quests.Quest), nil
+	}
+	return nil, err
+}
+
+func (repo *questsRepository) FindByName(name string) ([]*quests.Quest, error) {
+	results := make([]*quests.Quest, 0)
+	_ = repo.genericRepo.FindAllWithParam(
+		db.NewQueryParams(db.QueryParam{Key: "name", Value: name}),
+		func(elem interface{}) {
+			results = append(results, elem.(*quests.Quest))
+		})
+	return results, nil
+}
+
+func (repo *questsRepository) FindAll() ([]*quests.Quest, error) {
+	results := make([]*quests.Quest, 0)
+	if err := repo.genericRepo.FindAll(func(elem interface{}) {
+		results = append(results, elem.(*quests.Quest))
+	}); err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+func (repo *questsRepository) Update(id string, quest *quests.Quest) error {
+	return repo.genericRepo.Update(quest, id)
+}
+
+func (repo *questsRepository) Delete(id string) error {
+	return repo.genericRepo.Delete(id)
+}
+
+func (repo *questsRepository) Store(quest *quests.Quest) (*quests.Quest, error) {
+	quest.Entity = entities.NewEntity()
+	return repo.Import(quest)
+}
+
+func (repo *questsRepository) Import(quest *quests.Quest) (*quests.Quest, error) {
+	result, err := repo.genericRepo.Store(quest)
+	if result == nil {
+		return nil, err
+	}
+	return result.(*quests.Quest), nil
+}
@@ -0,0 +1,104 @@
+package repository
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/talesmud/talesmud/pkg/db"
+	r "github.com/talesmud/talesmud/pkg/entities/rooms"
+)
+
+type roomsRepository struct {
+	*genericRepo
+}
+
+// NewRoomsRepository creates a new rooms repository.
+func NewRoomsRepository(client db.Client) RoomsRepository {
+	return &roomsRepository{
+		genericRepo: newGenericRepo(client, "rooms", func() interface{} {
+			return &r.Room{}
+		}),
+	}
+}
+
+func (repo *roomsRepository) Drop() error {
+	return repo.genericRepo.DropCollection()
+}
+
+func (repo *roomsRepository) FindByID(id string) (*r.Room, error) {
+	if id == "" {
+		log.Error("Rooms::FindByID - id is empty")
+		return nil, errors.New("empty id")
+	}
+	result, err := repo.genericRepo.FindByID(id)
+	if err == nil {
+		return result.(*r.Room), nil
+	}
+	return nil, err
+}
+
+func (repo *roomsRepository) FindByName(name string) ([]*r.Room, error) {
+	results := make([]*r.Room, 0)
+	if err := repo.genericRepo.FindAllWithParam(
+		db.NewQueryParams(db.QueryParam{Key: "name", Value: name}),
+		func(elem interface{}) {
+			results = append(results, elem.(*r.Room))
+		}); err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+func (repo *roomsRepository) FindAll() ([]*r.Room, error) {
+	results := make([]*r.Room, 0)
+	if err := repo.genericRepo.FindAll(func(elem interface{}) {
+		results = append(results, elem.(*r.Room))
+	}); err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+func (repo *roomsRepository) FindAllWithQuery(query RoomsQuery) ([]*r.Room, error) {
+	results := make([]*r.Room, 0)
+	params := []db.QueryParam{}
+
+	v := reflect.ValueOf(query)
+	for i := 0; i < v.NumField(); i++ {
+		if v.Field(i).Interface() != nil {
+			p := db.QueryParam{Key: strings.ToLower(v.Type().Field(i).Name), Value: v.Field(i).Interface()}
+			if p.Value != "" {
+				params = append(params, p)
+			}
+		}
+	}
+
+	if err := repo.genericRepo.FindAllWithParam(db.NewQueryParams(params...), func(elem interface{}) {
+		results = append(results, elem.(*r.Room))
+	}); err != nil {
+		return results, nil
+	}
+	return results, nil
+}
+
+func (repo *roomsRepository) Update(id string, room *r.Room) error {
+	return repo.genericRepo.Update(room, id)
+}
+
+func (repo *roomsRepository) Delete(id string) error {
+	return repo.genericRepo.Delete(id)
+}
+
+func (repo *roomsRepository) Store(rep *r.Room) (*r.Room, error) {
+	return repo.Import(rep)
+}
+
+func (repo *roomsRepository) Import(rep *r.Room) (*r.Room, error) {
+	result, err := repo.genericRepo.Store(rep)
+	if result == nil {
+		return nil, err
+	}
+	return result.(*r.Room), nil
+}
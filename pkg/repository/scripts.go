@@ -0,0 +1,80 @@
+package repository
+
+import (
+	"errors"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/talesmud/talesmud/pkg/db"
+	"github.com/talesmud/talesmud/pkg/entities"
+	s "github.com/talesmud/talesmud/pkg/scripts"
+)
+
+type scriptsRepository struct {
+	*genericRepo
+}
+
+// NewScriptsRepository creates a new scripts repository.
+func NewScriptsRepository(client db.Client) ScriptsRepository {
+	return &scriptsRepository{
+		genericRepo: newGenericRepo(client, "scripts", func() interface{} {
+			return &s.Script{}
+		}),
+	}
+}
+
+func (repo *scriptsRepository) Drop() error {
+	return repo.genericRepo.DropCollection()
+}
+
+func (repo *scriptsRepository) FindByID(id string) (*s.Script, error) {
+	if id == "" {
+		log.Error("Scripts::FindByID - id is empty")
+		return nil, errors.New("empty id")
+	}
+	result, err := repo.genericRepo.FindByID(id)
+	if err == nil {
+		return result.(*s.Script), nil
+	}
+	return nil, err
+}
+
+func (repo *scriptsRepository) FindByName(name string) ([]*s.Script, error) {
+	results := make([]*s.Script, 0)
+	_ = repo.genericRepo.FindAllWithParam(
+		db.NewQueryParams(db.QueryParam{Key: "name", Value: name}),
+		func(elem interface{}) {
+			results = append(results, elem.(*s.Script))
+		})
+	return results, nil
+}
+
+func (repo *scriptsRepository) FindAll() ([]*s.Script, error) {
+	results := make([]*s.Script, 0)
+	if err := repo.genericRepo.FindAll(func(elem interface{}) {
+		results = append(results, elem.(*s.Script))
+	}); err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+func (repo *scriptsRepository) Update(id string, script *s.Script) error {
+	return repo.genericRepo.Update(script, id)
+}
+
+func (repo *scriptsRepository) Delete(id string) error {
+	return repo.genericRepo.Delete(id)
+}
+
+func (repo *scriptsRepository) Store(script *s.Script) (*s.Script, error) {
+	script.Entity = entities.NewEntity()
+	return repo.Import(script)
+}
+
+func (repo *scriptsRepository) Import(script *s.Script) (*s.Script, error) {
+	result, err := repo.genericRepo.Store(script)
+	if result == nil {
+		return nil, err
+	}
+	return result.(*s.Script), nil
+}
@@ -0,0 +1,38 @@
+package repository
+
+import (
+	"github.com/talesmud/talesmud/pkg/db"
+	"github.com/talesmud/talesmud/pkg/entities"
+	"github.com/talesmud/talesmud/pkg/entities/settings"
+)
+
+const serverSettingsID = "server-settings"
+
+type serverSettingsRepository struct {
+	*genericRepo
+}
+
+// NewServerSettingsRepository creates a new server settings repository.
+func NewServerSettingsRepository(client db.Client) ServerSettingsRepository {
+	return &serverSettingsRepository{
+		genericRepo: newGenericRepo(client, "server_settings", func() interface{} {
+			return &settings.ServerSettings{}
+		}),
+	}
+}
+
+func (repo *serverSettingsRepository) Get() (*settings.ServerSettings, error) {
+	result, err := repo.genericRepo.FindByID(serverSettingsID)
+	if err != nil {
+		return settings.NewDefaultServerSettings(), nil
+	}
+	return result.(*settings.ServerSettings), nil
+}
+
+func (repo *serverSettingsRepository) Upsert(s *settings.ServerSettings) error {
+	if s.Entity == nil {
+		s.Entity = entities.NewEntity()
+	}
+	s.Entity.ID = serverSettingsID
+	return repo.upsert(serverSettingsID, s)
+}
@@ -0,0 +1,80 @@
+package repository
+
+import (
+	"errors"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/talesmud/talesmud/pkg/db"
+	"github.com/talesmud/talesmud/pkg/entities"
+	"github.com/talesmud/talesmud/pkg/entities/skills"
+)
+
+type skillsRepository struct {
+	*genericRepo
+}
+
+// NewSkillsRepository creates a new skills repository.
+func NewSkillsRepository(client db.Client) SkillsRepository {
+	return &skillsRepository{
+		genericRepo: newGenericRepo(client, "skills", func() interface{} {
+			return &skills.Skill{}
+		}),
+	}
+}
+
+func (repo *skillsRepository) Drop() error {
+	return repo.genericRepo.DropCollection()
+}
+
+func (repo *skillsRepository) FindByID(id string) (*skills.Skill, error) {
+	if id == "" {
+		log.Error("Skills::FindByID - id is empty")
+		return nil, errors.New("empty id")
+	}
+	result, err := repo.genericRepo.FindByID(id)
+	if err == nil {
+		return result.(*skills.Skill), nil
+	}
+	return nil, err
+}
+
+func (repo *skillsRepository) FindByName(name string) ([]*skills.Skill, error) {
+	results := make([]*skills.Skill, 0)
+	_ = repo.genericRepo.FindAllWithParam(
+		db.NewQueryParams(db.QueryParam{Key: "name", Value: name}),
+		func(elem interface{}) {
+			results = append(results, elem.(*skills.Skill))
+		})
+	return results, nil
+}
+
+func (repo *skillsRepository) FindAll() ([]*skills.Skill, error) {
+	results := make([]*skills.Skill, 0)
+	if err := repo.genericRepo.FindAll(func(elem interface{}) {
+		results = append(results, elem.(*skills.Skill))
+	}); err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+func (repo *skillsRepository) Update(id string, skill *skills.Skill) error {
+	return repo.genericRepo.Update(skill, id)
+}
+
+func (repo *skillsRepository) Delete(id string) error {
+	return repo.genericRepo.Delete(id)
+}
+
+func (repo *skillsRepository) Store(skill *skills.Skill) (*skills.Skill, error) {
+	skill.Entity = entities.NewEntity()
+	return repo.Import(skill)
+}
+
+func (repo *skillsRepository) Import(skill *skills.Skill) (*skills.Skill, error) {
+	result, err := repo.genericRepo.Store(skill)
+	if result == nil {
+		return nil, err
+	}
+	return result.(*skills.Skill), nil
+}
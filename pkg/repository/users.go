@@ -0,0 +1,108 @@
+package repository
+
+import (
+	"errors"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/talesmud/talesmud/pkg/db"
+	e "github.com/talesmud/talesmud/pkg/entities"
+)
+
+type usersRepository struct {
+	*genericRepo
+}
+
+// NewUsersRepository creates a new users repository.
+func NewUsersRepository(client db.Client) UsersRepository {
+	return &usersRepository{
+		genericRepo: newGenericRepo(client, "users", func() interface{} {
+			return &e.User{}
+		}),
+	}
+}
+
+func (repo *usersRepository) Drop() error {
+	return repo.genericRepo.DropCollection()
+}
+
+func (repo *usersRepository) Import(user *e.User) (*e.User, error) {
+	result, err := repo.genericRepo.Store(user)
+	if result == nil {
+		return nil, err
+	}
+	return result.(*e.User), nil
+}
+
+func (repo *usersRepository) Create(user *e.User) (*e.User, error) {
+	user.Entity = e.NewEntity()
+	return repo.Import(user)
+}
+
+func (repo *usersRepository) FindAll() ([]*e.User, error) {
+	results := make([]*e.User, 0)
+	if err := repo.genericRepo.FindAll(func(elem interface{}) {
+		results = append(results, elem.(*e.User))
+	}); err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+func (repo *usersRepository) FindAllOnline() ([]*e.User, error) {
+	results := make([]*e.User, 0)
+	if err := repo.genericRepo.FindAllWithParam(
+		db.NewQueryParams(db.QueryParam{Key: "isOnline", Value: true}),
+		func(elem interface{}) {
+			results = append(results, elem.(*e.User))
+		}); err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+func (repo *usersRepository) Update(refID string, user *e.User) error {
+	return repo.genericRepo.UpdateByField(user, "refid", refID)
+}
+
+func (repo *usersRepository) FindByID(id string) (*e.User, error) {
+	if id == "" {
+		log.Error("Users::FindByID - ID is empty")
+		return nil, errors.New("empty ID")
+	}
+
+	result, err := repo.genericRepo.FindByID(id)
+	if user, ok := result.(*e.User); ok {
+		return user, nil
+	}
+	return nil, err
+}
+
+func (repo *usersRepository) FindByRefID(refID string) (*e.User, error) {
+	if refID == "" {
+		log.Error("Users::FindByRefID - refID is empty")
+		return nil, errors.New("empty refID")
+	}
+
+	result, err := repo.genericRepo.FindByField("refid", refID)
+	if user, ok := result.(*e.User); ok {
+		return user, nil
+	}
+	return nil, err
+}
+
+func (repo *usersRepository) FindByNickname(nickname string) (*e.User, error) {
+	if nickname == "" {
+		log.Error("Users::FindByNickname - nickname is empty")
+		return nil, errors.New("empty nickname")
+	}
+
+	result, err := repo.genericRepo.FindByField("nickname", nickname)
+	if user, ok := result.(*e.User); ok {
+		return user, nil
+	}
+	return nil, err
+}
+
+func (repo *usersRepository) Delete(id string) error {
+	return repo.genericRepo.Delete(id)
+}
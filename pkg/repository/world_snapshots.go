@@ -0,0 +1,41 @@
+package repository
+
+import (
+	"github.com/talesmud/talesmud/pkg/db"
+	"github.com/talesmud/talesmud/pkg/entities"
+	"github.com/talesmud/talesmud/pkg/entities/snapshots"
+)
+
+type worldSnapshotsRepository struct {
+	*genericRepo
+}
+
+// NewWorldSnapshotsRepository creates a new world snapshot repository.
+func NewWorldSnapshotsRepository(client db.Client) WorldSnapshotsRepository {
+	return &worldSnapshotsRepository{
+		genericRepo: newGenericRepo(client, "world_snapshots", func() interface{} {
+			return &snapshots.WorldSnapshot{}
+		}),
+	}
+}
+
+func (repo *worldSnapshotsRepository) Get() (*snapshots.WorldSnapshot, error) {
+	result, err := repo.genericRepo.FindByID(snapshots.WorldSnapshotID)
+	if err != nil {
+		// no snapshot stored yet
+		return nil, nil
+	}
+	return result.(*snapshots.WorldSnapshot), nil
+}
+
+func (repo *worldSnapshotsRepository) Upsert(s *snapshots.WorldSnapshot) error {
+	if s.Entity == nil {
+		s.Entity = entities.NewEntity()
+	}
+	s.Entity.ID = snapshots.WorldSnapshotID
+	return repo.upsert(snapshots.WorldSnapshotID, s)
+}
+
+func (repo *worldSnapshotsRepository) Drop() error {
+	return repo.genericRepo.DropCollection()
+}
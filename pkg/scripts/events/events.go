@@ -29,6 +29,8 @@ const (
 	EventNPCSpawn  EventType = "npc.spawn"
 	EventNPCUpdate EventType = "npc.update"
 	EventNPCIdle   EventType = "npc.idle"
+	EventNPCAggro  EventType = "npc.aggro"
+	EventNPCFlee   EventType = "npc.flee"
 )
 
 // Dialog events
@@ -83,6 +85,8 @@ func AllEventTypes() []EventType {
 		EventNPCSpawn,
 		EventNPCUpdate,
 		EventNPCIdle,
+		EventNPCAggro,
+		EventNPCFlee,
 		EventDialogStart,
 		EventDialogEnd,
 		EventDialogOption,
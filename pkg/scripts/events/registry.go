@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/sirupsen/logrus"
+	"github.com/talesmud/talesmud/pkg/entities"
 	"github.com/talesmud/talesmud/pkg/scripts"
 )
 
@@ -60,6 +61,13 @@ func (r *EventRegistry) Register(eventType EventType, handler ScriptHandler) {
 	r.mu.Lock()
 	defer r.mu.Unlock()
 
+	addHandler(r.handlers, eventType, handler)
+
+	logrus.WithField("event", eventType).WithField("script", handler.ScriptID).Info("Registered event handler")
+}
+
+// addHandler adds a handler with the default settings to the handler map, sorted by priority
+func addHandler(handlers map[EventType][]ScriptHandler, eventType EventType, handler ScriptHandler) {
 	if handler.Priority == 0 {
 		handler.Priority = 100 // Default priority
 	}
@@ -67,14 +75,12 @@ func (r *EventRegistry) Register(eventType EventType, handler ScriptHandler) {
 		handler.Enabled = true
 	}
 
-	r.handlers[eventType] = append(r.handlers[eventType], handler)
+	handlers[eventType] = append(handlers[eventType], handler)
 
 	// Sort by priority
-	sort.Slice(r.handlers[eventType], func(i, j int) bool {
-		return r.handlers[eventType][i].Priority < r.handlers[eventType][j].Priority
+	sort.Slice(handlers[eventType], func(i, j int) bool {
+		return handlers[eventType][i].Priority < handlers[eventType][j].Priority
 	})
-
-	logrus.WithField("event", eventType).WithField("script", handler.ScriptID).Info("Registered event handler")
 }
 
 // Unregister removes a script handler for an event
@@ -119,6 +125,52 @@ func (r *EventRegistry) GetHandlers(eventType EventType) []ScriptHandler {
 	return result
 }
 
+// RegisterScript registers all event bindings of an event script
+func (r *EventRegistry) RegisterScript(script *scripts.Script) {
+	if script == nil || script.Entity == nil || script.Type != scripts.ScriptTypeEvent {
+		return
+	}
+
+	for _, binding := range script.Events {
+		if binding.Event == "" {
+			continue
+		}
+		r.Register(EventType(binding.Event), bindingHandler(script, binding))
+	}
+}
+
+// LoadScripts replaces all handlers with the event bindings of the given scripts
+// The new handlers are built first and swapped in at once, so dispatches never see a partial set
+func (r *EventRegistry) LoadScripts(all []*scripts.Script) {
+	handlers := make(map[EventType][]ScriptHandler)
+	for _, script := range all {
+		if script == nil || script.Entity == nil || script.Type != scripts.ScriptTypeEvent {
+			continue
+		}
+		for _, binding := range script.Events {
+			if binding.Event == "" {
+				continue
+			}
+			addHandler(handlers, EventType(binding.Event), bindingHandler(script, binding))
+		}
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.handlers = handlers
+}
+
+// bindingHandler creates the handler for an event binding of a script
+func bindingHandler(script *scripts.Script, binding scripts.ScriptEventHandler) ScriptHandler {
+	return ScriptHandler{
+		ScriptID: script.ID,
+		Priority: binding.Priority,
+		Filter:   binding.Filter,
+		Async:    binding.Async,
+		Enabled:  true,
+	}
+}
+
 // Dispatch dispatches an event to all registered handlers
 func (r *EventRegistry) Dispatch(eventType EventType, ctx *EventContext) []ScriptResult {
 	handlers := r.GetHandlers(eventType)
@@ -126,21 +178,28 @@ func (r *EventRegistry) Dispatch(eventType EventType, ctx *EventContext) []Scrip
 		return nil
 	}
 
-	if r.scripts == nil {
+	r.mu.RLock()
+	repo := r.scripts
+	r.mu.RUnlock()
+
+	if repo == nil {
 		logrus.Warn("Event registry has no scripts repository, cannot dispatch events")
 		return nil
 	}
 
 	results := make([]ScriptResult, 0, len(handlers))
-	var mu sync.Mutex
 
 	for _, handler := range handlers {
 		if !handler.Enabled {
 			continue
 		}
 
+		if !r.matchesFilter(handler, ctx) {
+			continue
+		}
+
 		// Load the script
-		script, err := r.scripts.FindByID(handler.ScriptID)
+		script, err := repo.FindByID(handler.ScriptID)
 		if err != nil || script == nil {
 			results = append(results, ScriptResult{
 				ScriptID: handler.ScriptID,
@@ -152,13 +211,13 @@ func (r *EventRegistry) Dispatch(eventType EventType, ctx *EventContext) []Scrip
 
 		// Execute the script
 		if handler.Async {
-			// Run asynchronously
-			go func(h ScriptHandler, s *scripts.Script) {
+			// Run asynchronously, the result is only logged as the event has already been dispatched
+			go func(s *scripts.Script) {
 				result := r.executeScript(s, ctx)
-				mu.Lock()
-				results = append(results, result)
-				mu.Unlock()
-			}(handler, script)
+				if !result.Success {
+					logrus.WithField("event", eventType).WithField("script", s.Name).WithField("error", result.Error).Warn("Async event handler failed")
+				}
+			}(script)
 		} else {
 			// Run synchronously
 			result := r.executeScript(script, ctx)
@@ -174,6 +233,45 @@ func (r *EventRegistry) Dispatch(eventType EventType, ctx *EventContext) []Scrip
 	return results
 }
 
+// matchesFilter evaluates the optional Lua filter expression of a handler against the event context
+func (r *EventRegistry) matchesFilter(handler ScriptHandler, ctx *EventContext) bool {
+	if handler.Filter == "" {
+		return true
+	}
+
+	filter := scripts.Script{
+		Entity:   &entities.Entity{ID: handler.ScriptID + ":filter"},
+		Name:     "filter:" + handler.ScriptID,
+		Code:     "return (" + handler.Filter + ")",
+		Language: scripts.ScriptLanguageLua,
+	}
+
+	scriptCtx := scripts.NewScriptContext()
+	for k, v := range ctx.ToMap() {
+		scriptCtx.Set(k, v)
+	}
+
+	result := r.runner.RunWithResult(filter, scriptCtx)
+	if !result.Success {
+		logrus.WithField("script", handler.ScriptID).WithField("filter", handler.Filter).WithField("error", result.Error).
+			Warn("Event handler filter failed")
+		return false
+	}
+
+	matches, ok := result.Result.(bool)
+	return ok && matches
+}
+
+// IsCanceled returns true if one of the synchronous handlers requested cancellation of the event
+func IsCanceled(results []ScriptResult) bool {
+	for _, result := range results {
+		if result.Canceled {
+			return true
+		}
+	}
+	return false
+}
+
 // executeScript executes a single script with the event context
 func (r *EventRegistry) executeScript(script *scripts.Script, ctx *EventContext) ScriptResult {
 	start := time.Now()
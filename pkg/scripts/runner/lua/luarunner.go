@@ -361,6 +361,11 @@ func (r *LuaRunner) getReturnValue(L *lua.LState) interface{} {
 	return nil
 }
 
+// ToGoValue converts a Lua value to a Go value (tables become maps or slices), used by modules
+func ToGoValue(val lua.LValue) interface{} {
+	return luaValueToGo(val)
+}
+
 // luaValueToGo converts a Lua value to a Go value
 func luaValueToGo(val lua.LValue) interface{} {
 	switch v := val.(type) {
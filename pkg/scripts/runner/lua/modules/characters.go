@@ -1,9 +1,12 @@
 package modules
 
 import (
+	"time"
+
 	lua "github.com/yuin/gopher-lua"
 	luar "layeh.com/gopher-luar"
 
+	"github.com/talesmud/talesmud/pkg/entities/effects"
 	luarunner "github.com/talesmud/talesmud/pkg/scripts/runner/lua"
 )
 
@@ -203,12 +206,106 @@ func RegisterCharactersModule(L *lua.LState, runner *luarunner.LuaRunner) int {
 			return 1
 		}
 
-		character.XP += int32(amount)
+		levelUps := facade.CharactersService().GainXP(character, int32(amount), "script")
 		err = facade.CharactersService().Update(id, character)
+		if game := runner.GetGame(); err == nil && game != nil {
+			game.NotifyLevelUps(character, levelUps)
+		}
 		L.Push(lua.LBool(err == nil))
 		return 1
 	}))
 
+	// tales.characters.applyEffect(id, effect) - Apply a status effect (preset ID or table), in combat to the combatant
+	mod.RawSetString("applyEffect", L.NewFunction(func(L *lua.LState) int {
+		id := L.CheckString(1)
+		effect, ok := effects.Parse(luarunner.ToGoValue(L.CheckAny(2)))
+		facade := runner.GetFacade()
+		game := runner.GetGame()
+		if !ok || facade == nil || game == nil {
+			L.Push(lua.LBool(false))
+			return 1
+		}
+
+		character, err := facade.CharactersService().FindByID(id)
+		if err != nil || character == nil {
+			L.Push(lua.LBool(false))
+			return 1
+		}
+
+		// while fighting the effect goes to the combatant, the instance writes it back when combat ends
+		if inCombat, applied := game.GetCombatEngine().ApplyStatusEffect(id, effect); inCombat {
+			L.Push(lua.LBool(applied))
+			return 1
+		}
+
+		character.StatusEffects.Prune(time.Now())
+		applied := character.StatusEffects.Apply(effect, time.Now())
+		if applied {
+			err = facade.CharactersService().Update(id, character)
+		}
+		L.Push(lua.LBool(applied && err == nil))
+		return 1
+	}))
+
+	// tales.characters.removeEffect(id, effectIdOrType) - Remove a status effect
+	mod.RawSetString("removeEffect", L.NewFunction(func(L *lua.LState) int {
+		id := L.CheckString(1)
+		idOrType := L.CheckString(2)
+		facade := runner.GetFacade()
+		game := runner.GetGame()
+		if facade == nil || game == nil {
+			L.Push(lua.LBool(false))
+			return 1
+		}
+
+		character, err := facade.CharactersService().FindByID(id)
+		if err != nil || character == nil {
+			L.Push(lua.LBool(false))
+			return 1
+		}
+
+		if inCombat, removed := game.GetCombatEngine().RemoveStatusEffect(id, idOrType); inCombat {
+			L.Push(lua.LBool(removed))
+			return 1
+		}
+
+		removed := character.StatusEffects.Remove(idOrType)
+		if removed {
+			facade.CharactersService().Update(id, character)
+		}
+		L.Push(lua.LBool(removed))
+		return 1
+	}))
+
+	// tales.characters.hasEffect(id, effectType) - Check if a status effect type is active
+	mod.RawSetString("hasEffect", L.NewFunction(func(L *lua.LState) int {
+		id := L.CheckString(1)
+		effectType := effects.EffectType(L.CheckString(2))
+		facade := runner.GetFacade()
+		if facade == nil {
+			L.Push(lua.LBool(false))
+			return 1
+		}
+
+		// while fighting the combat instance holds the active effects
+		if game := runner.GetGame(); game != nil {
+			if instance := game.GetCombatEngine().GetCombatInstance(id); instance != nil {
+				if player := instance.GetPlayerByID(id); player != nil {
+					L.Push(lua.LBool(player.Effects.Has(effectType, time.Now())))
+					return 1
+				}
+			}
+		}
+
+		character, err := facade.CharactersService().FindByID(id)
+		if err != nil || character == nil {
+			L.Push(lua.LBool(false))
+			return 1
+		}
+		L.Push(lua.LBool(character.StatusEffects.Has(effectType, time.Now())))
+		return 1
+	}))
+
 	L.Push(mod)
 	return 1
 }
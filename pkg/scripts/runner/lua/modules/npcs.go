@@ -1,9 +1,12 @@
 package modules
 
 import (
+	"time"
+
 	lua "github.com/yuin/gopher-lua"
 	luar "layeh.com/gopher-luar"
 
+	"github.com/talesmud/talesmud/pkg/entities/effects"
 	npc "github.com/talesmud/talesmud/pkg/entities/npcs"
 	luarunner "github.com/talesmud/talesmud/pkg/scripts/runner/lua"
 )
@@ -488,6 +491,67 @@ func RegisterNPCsModule(L *lua.LState, runner *luarunner.LuaRunner) int {
 		return 1
 	}))
 
+	// tales.npcs.applyEffect(id, effect) - Apply a status effect (preset ID or table) to an instance
+	mod.RawSetString("applyEffect", L.NewFunction(func(L *lua.LState) int {
+		id := L.CheckString(1)
+		effect, ok := effects.Parse(luarunner.ToGoValue(L.CheckAny(2)))
+
+		game := runner.GetGame()
+		if !ok || game == nil {
+			L.Push(lua.LBool(false))
+			return 1
+		}
+
+		if inCombat, applied := game.GetCombatEngine().ApplyStatusEffect(id, effect); inCombat {
+			L.Push(lua.LBool(applied))
+			return 1
+		}
+
+		npcMgr := game.GetNPCInstanceManager()
+		if npcMgr == nil {
+			L.Push(lua.LBool(false))
+			return 1
+		}
+
+		applied := false
+		npcMgr.UpdateInstance(id, func(n *npc.NPC) {
+			n.StatusEffects.Prune(time.Now())
+			applied = n.StatusEffects.Apply(effect, time.Now())
+		})
+		L.Push(lua.LBool(applied))
+		return 1
+	}))
+
+	// tales.npcs.removeEffect(id, effectIdOrType) - Remove a status effect from an instance
+	mod.RawSetString("removeEffect", L.NewFunction(func(L *lua.LState) int {
+		id := L.CheckString(1)
+		idOrType := L.CheckString(2)
+
+		game := runner.GetGame()
+		if game == nil {
+			L.Push(lua.LBool(false))
+			return 1
+		}
+
+		if inCombat, removed := game.GetCombatEngine().RemoveStatusEffect(id, idOrType); inCombat {
+			L.Push(lua.LBool(removed))
+			return 1
+		}
+
+		npcMgr := game.GetNPCInstanceManager()
+		if npcMgr == nil {
+			L.Push(lua.LBool(false))
+			return 1
+		}
+
+		removed := false
+		npcMgr.UpdateInstance(id, func(n *npc.NPC) {
+			removed = n.StatusEffects.Remove(idOrType)
+		})
+		L.Push(lua.LBool(removed))
+		return 1
+	}))
+
 	L.Push(mod)
 	return 1
 }
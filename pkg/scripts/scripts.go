@@ -26,6 +26,14 @@ const (
 	ScriptLanguageJavaScript ScriptLanguage = "javascript"
 )
 
+// ScriptEventHandler binds an event script to a game event
+type ScriptEventHandler struct {
+	Event    string `bson:"event" json:"event"`                       // event type, e.g. "item.pickup"
+	Priority int    `bson:"priority,omitempty" json:"priority"`       // Lower = runs first
+	Filter   string `bson:"filter,omitempty" json:"filter,omitempty"` // Optional Lua expression, handler only runs if it evaluates to true
+	Async    bool   `bson:"async,omitempty" json:"async"`             // Run in goroutine, async handlers cannot cancel events
+}
+
 // Script ...
 type Script struct {
 	*entities.Entity `bson:",inline"`
@@ -35,6 +43,9 @@ type Script struct {
 	Code        string         `bson:"code,omitempty" json:"code"`
 	Type        ScriptType     `bson:"type,omitempty" json:"type"`
 	Language    ScriptLanguage `bson:"language,omitempty" json:"language"`
+
+	// Events the script handles, only used for scripts of type "event"
+	Events []ScriptEventHandler `bson:"events,omitempty" json:"events,omitempty"`
 }
 
 // GetLanguage returns the script language, defaulting to JavaScript for backward compatibility
@@ -0,0 +1,34 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/talesmud/talesmud/pkg/service"
+)
+
+// AuditHandler lets admins read the audit log of staff commands
+type AuditHandler struct {
+	Service service.AuditService
+}
+
+// GetAuditLog returns the newest audit entries first, optionally filtered by userId and limited by limit (default 100, 0 = all)
+func (h *AuditHandler) GetAuditLog(c *gin.Context) {
+	limit := 100
+	if value := c.Query("limit"); value != "" {
+		parsed, err := strconv.Atoi(value)
+		if err != nil || parsed < 0 {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "limit must be a positive number"})
+			return
+		}
+		limit = parsed
+	}
+
+	entries, err := h.Service.FindRecent(c.Query("userId"), limit)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to load audit log"})
+		return
+	}
+	c.JSON(http.StatusOK, entries)
+}
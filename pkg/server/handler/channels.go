@@ -0,0 +1,120 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	log "github.com/sirupsen/logrus"
+	"github.com/talesmud/talesmud/pkg/entities/channels"
+	"github.com/talesmud/talesmud/pkg/mudserver/game/def"
+	"github.com/talesmud/talesmud/pkg/service"
+)
+
+// ChannelsHandler handles HTTP requests for chat channels
+type ChannelsHandler struct {
+	Service service.ChannelsService
+	// Game caches the channels, it reloads them after changes
+	Game def.GameCtrl
+}
+
+// validateName checks that the channel name is valid and not used by another channel
+func (h *ChannelsHandler) validateName(c *gin.Context, channel *channels.Channel, id string) bool {
+	if err := channel.Validate(); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return false
+	}
+	if existing, _ := h.Service.FindChannel(channel.Name); existing != nil && existing.ID != id {
+		c.JSON(http.StatusConflict, gin.H{"error": "a channel with this name already exists"})
+		return false
+	}
+	return true
+}
+
+// reloadChannels lets the running game pick up changed channels
+func (h *ChannelsHandler) reloadChannels() {
+	if h.Game != nil {
+		h.Game.GetChannelManager().ReloadChannels()
+	}
+}
+
+// GetChannels returns all chat channels
+func (h *ChannelsHandler) GetChannels(c *gin.Context) {
+	result, err := h.Service.FindAll()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, result)
+}
+
+// GetChannelByID returns a chat channel by ID
+func (h *ChannelsHandler) GetChannelByID(c *gin.Context) {
+	id := c.Param("id")
+
+	channel, err := h.Service.FindByID(id)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	if channel == nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "channel not found"})
+		return
+	}
+	c.JSON(http.StatusOK, channel)
+}
+
+// PostChannel creates a new chat channel
+func (h *ChannelsHandler) PostChannel(c *gin.Context) {
+	var channel channels.Channel
+	if err := c.ShouldBindJSON(&channel); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if !h.validateName(c, &channel, "") {
+		return
+	}
+
+	log.WithField("name", channel.Name).Info("Creating new channel")
+
+	newChannel, err := h.Service.Store(&channel)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	h.reloadChannels()
+	c.JSON(http.StatusOK, newChannel)
+}
+
+// UpdateChannelByID updates a chat channel
+func (h *ChannelsHandler) UpdateChannelByID(c *gin.Context) {
+	id := c.Param("id")
+	var channel channels.Channel
+	if err := c.ShouldBindJSON(&channel); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	if !h.validateName(c, &channel, id) {
+		return
+	}
+
+	log.WithField("name", channel.Name).Info("Updating channel")
+
+	if err := h.Service.Update(id, &channel); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	h.reloadChannels()
+	c.JSON(http.StatusOK, gin.H{"status": "updated channel"})
+}
+
+// DeleteChannelByID deletes a chat channel
+func (h *ChannelsHandler) DeleteChannelByID(c *gin.Context) {
+	id := c.Param("id")
+
+	if err := h.Service.Delete(id); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	h.reloadChannels()
+	c.JSON(http.StatusOK, gin.H{"status": "deleted"})
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/gin-gonic/gin"
 	"github.com/talesmud/talesmud/pkg/exporter"
+	"github.com/talesmud/talesmud/pkg/mudserver/game/def"
 	"github.com/talesmud/talesmud/pkg/repository"
 	"github.com/talesmud/talesmud/pkg/service"
 )
@@ -19,6 +20,10 @@ type ExportHandler struct {
 	NPCsService       service.NPCsService
 	DialogsService    service.DialogsService
 	PartiesService    service.PartiesService
+	QuestsService     service.QuestsService
+	SkillsService     service.SkillsService
+	// Game reloads the event handlers of the imported scripts
+	Game def.GameCtrl
 }
 
 // Export Exports all data structures as JSON
@@ -34,6 +39,8 @@ func (handler *ExportHandler) Export(c *gin.Context) {
 	d.NPCs, _ = handler.NPCsService.FindAll()
 	d.Dialogs, _ = handler.DialogsService.FindAll()
 	d.Parties, _ = handler.PartiesService.FindAll()
+	d.Quests, _ = handler.QuestsService.FindAll()
+	d.Skills, _ = handler.SkillsService.FindAll()
 
 	c.IndentedJSON(http.StatusOK, d)
 }
@@ -49,6 +56,8 @@ func (handler *ExportHandler) Import(c *gin.Context) {
 	handler.ScriptService.Drop()
 	handler.NPCsService.Drop()
 	handler.DialogsService.Drop()
+	handler.QuestsService.Drop()
+	handler.SkillsService.Drop()
 
 	var data exporter.Data
 	if err := c.ShouldBindJSON(&data); err != nil {
@@ -84,6 +93,15 @@ func (handler *ExportHandler) Import(c *gin.Context) {
 	for _, party := range data.Parties {
 		handler.PartiesService.Store(party)
 	}
+	for _, quest := range data.Quests {
+		handler.QuestsService.Import(quest)
+	}
+	for _, skill := range data.Skills {
+		handler.SkillsService.Import(skill)
+	}
+	if handler.Game != nil {
+		handler.Game.ReloadEventHandlers()
+	}
 
 	c.JSON(http.StatusOK, gin.H{"status": "Import successful"})
 }
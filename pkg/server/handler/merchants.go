@@ -0,0 +1,132 @@
+package handler
+
+import (
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	log "github.com/sirupsen/logrus"
+	npc "github.com/talesmud/talesmud/pkg/entities/npcs"
+	"github.com/talesmud/talesmud/pkg/mudserver/game/def"
+)
+
+// MerchantsHandler lets admins inspect and reset the stock of live merchant instances
+type MerchantsHandler struct {
+	Game def.GameCtrl
+}
+
+// merchantStock is the stock of one merchant instance
+type merchantStock struct {
+	InstanceID      string             `json:"instanceId"`
+	Name            string             `json:"name"`
+	TemplateID      string             `json:"templateId,omitempty"`
+	RoomID          string             `json:"roomId"`
+	MerchantType    string             `json:"merchantType"`
+	RestockMinutes  int32              `json:"restockMinutes"`
+	RestockVariance int32              `json:"restockVariance"`
+	LastRestock     time.Time          `json:"lastRestock"`
+	NextRestock     time.Time          `json:"nextRestock"`
+	Inventory       []npc.MerchantItem `json:"inventory"`
+}
+
+// newMerchantStock copies the stock of a merchant instance
+func newMerchantStock(n *npc.NPC) merchantStock {
+	return merchantStock{
+		InstanceID:      n.Entity.ID,
+		Name:            n.GetDisplayName(),
+		TemplateID:      n.TemplateID,
+		RoomID:          n.CurrentRoomID,
+		MerchantType:    n.MerchantTrait.MerchantType,
+		RestockMinutes:  n.MerchantTrait.RestockMinutes,
+		RestockVariance: n.MerchantTrait.RestockVariance,
+		LastRestock:     n.MerchantTrait.LastRestock,
+		NextRestock:     n.MerchantTrait.NextRestock,
+		Inventory:       append([]npc.MerchantItem(nil), n.MerchantTrait.Inventory...),
+	}
+}
+
+// GetMerchantStock returns the stock of all merchant instances, optionally filtered by roomId or templateId
+func (h *MerchantsHandler) GetMerchantStock(c *gin.Context) {
+	manager := h.Game.GetNPCInstanceManager()
+	if manager == nil {
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "NPC instances are not loaded"})
+		return
+	}
+
+	roomID := c.Query("roomId")
+	templateID := c.Query("templateId")
+
+	result := make([]merchantStock, 0)
+	for _, inst := range manager.GetAllInstances() {
+		if (roomID != "" && inst.CurrentRoomID != roomID) || (templateID != "" && inst.TemplateID != templateID) {
+			continue
+		}
+		var stock *merchantStock
+		manager.UpdateInstance(inst.Entity.ID, func(n *npc.NPC) {
+			if n.IsMerchant() {
+				s := newMerchantStock(n)
+				stock = &s
+			}
+		})
+		if stock != nil {
+			result = append(result, *stock)
+		}
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Name < result[j].Name
+	})
+	c.JSON(http.StatusOK, result)
+}
+
+// GetMerchantStockByID returns the stock of a single merchant instance
+func (h *MerchantsHandler) GetMerchantStockByID(c *gin.Context) {
+	manager := h.Game.GetNPCInstanceManager()
+	if manager == nil {
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "NPC instances are not loaded"})
+		return
+	}
+
+	var stock *merchantStock
+	manager.UpdateInstance(c.Param("id"), func(n *npc.NPC) {
+		if n.IsMerchant() {
+			s := newMerchantStock(n)
+			stock = &s
+		}
+	})
+	if stock == nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Merchant instance not found"})
+		return
+	}
+	c.JSON(http.StatusOK, stock)
+}
+
+// ResetMerchantStock refills the stock of a merchant instance and schedules its next restock
+func (h *MerchantsHandler) ResetMerchantStock(c *gin.Context) {
+	manager := h.Game.GetNPCInstanceManager()
+	if manager == nil {
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "NPC instances are not loaded"})
+		return
+	}
+
+	id := c.Param("id")
+	var stock *merchantStock
+	manager.UpdateInstance(id, func(n *npc.NPC) {
+		if n.IsMerchant() {
+			n.MerchantTrait.Restock()
+			s := newMerchantStock(n)
+			stock = &s
+		}
+	})
+	if stock == nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "Merchant instance not found"})
+		return
+	}
+
+	log.WithFields(log.Fields{
+		"instance": id,
+		"name":     stock.Name,
+	}).Info("Merchant stock reset")
+	c.JSON(http.StatusOK, stock)
+}
@@ -0,0 +1,93 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	log "github.com/sirupsen/logrus"
+	"github.com/talesmud/talesmud/pkg/entities/quests"
+	"github.com/talesmud/talesmud/pkg/service"
+)
+
+// QuestsHandler handles HTTP requests for quests
+type QuestsHandler struct {
+	Service service.QuestsService
+}
+
+// GetQuests returns all quests
+func (h *QuestsHandler) GetQuests(c *gin.Context) {
+	result, err := h.Service.FindAll()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, result)
+}
+
+// GetQuestByID returns a quest by ID
+func (h *QuestsHandler) GetQuestByID(c *gin.Context) {
+	id := c.Param("id")
+
+	quest, err := h.Service.FindByID(id)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	if quest == nil {
+		c.JSON(http.StatusNotFound, gin.H{"error": "quest not found"})
+		return
+	}
+	c.JSON(http.StatusOK, quest)
+}
+
+// PostQuest creates a new quest
+func (h *QuestsHandler) PostQuest(c *gin.Context) {
+	var quest quests.Quest
+	if err := c.ShouldBindJSON(&quest); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	log.WithField("name", quest.Name).Info("Creating new quest")
+
+	newQuest, err := h.Service.Store(&quest)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, newQuest)
+}
+
+// UpdateQuestByID updates a quest
+func (h *QuestsHandler) UpdateQuestByID(c *gin.Context) {
+	id := c.Param("id")
+	var quest quests.Quest
+	if err := c.ShouldBindJSON(&quest); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	log.WithField("name", quest.Name).Info("Updating quest")
+
+	if err := h.Service.Update(id, &quest); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"status": "updated quest"})
+}
+
+// DeleteQuestByID deletes a quest
+func (h *QuestsHandler) DeleteQuestByID(c *gin.Context) {
+	id := c.Param("id")
+
+	if err := h.Service.Delete(id); err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"status": "deleted"})
+}
+
+// GetObjectiveTypes returns all available quest objective types
+func (h *QuestsHandler) GetObjectiveTypes(c *gin.Context) {
+	c.JSON(http.StatusOK, quests.ObjectiveTypes())
+}
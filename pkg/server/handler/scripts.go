@@ -8,6 +8,7 @@ import (
 	"github.com/gin-gonic/gin"
 	log "github.com/sirupsen/logrus"
 
+	"github.com/talesmud/talesmud/pkg/mudserver/game/def"
 	"github.com/talesmud/talesmud/pkg/scripts"
 	s "github.com/talesmud/talesmud/pkg/scripts"
 	"github.com/talesmud/talesmud/pkg/service"
@@ -17,6 +18,15 @@ import (
 type ScriptsHandler struct {
 	Service service.ScriptsService
 	Runner  s.ScriptRunner
+	// Game reloads the event handlers after scripts changed
+	Game def.GameCtrl
+}
+
+// reloadEventHandlers lets the running game pick up changed event bindings
+func (handler *ScriptsHandler) reloadEventHandlers() {
+	if handler.Game != nil {
+		handler.Game.ReloadEventHandlers()
+	}
 }
 
 //GetScripts returns the list of scripts
@@ -48,6 +58,7 @@ func (handler *ScriptsHandler) PostScript(c *gin.Context) {
 	log.WithField("script", script.Name).Info("Creating new script")
 
 	if script, err := handler.Service.Store(&script); err == nil {
+		handler.reloadEventHandlers()
 		c.JSON(http.StatusOK, script)
 	} else {
 		c.Error(err)
@@ -125,6 +136,7 @@ func (handler *ScriptsHandler) PutScript(c *gin.Context) {
 	log.WithField("script", script.Name).Info("Updating script")
 
 	if err := handler.Service.Update(id, &script); err == nil {
+		handler.reloadEventHandlers()
 		c.JSON(http.StatusOK, gin.H{"status": "updated script"})
 	} else {
 		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
@@ -139,6 +151,7 @@ func (handler *ScriptsHandler) DeleteScript(c *gin.Context) {
 	log.WithField("script", id).Info("Deleting script")
 
 	if err := handler.Service.Delete(id); err == nil {
+		handler.reloadEventHandlers()
 		c.JSON(http.StatusOK, gin.H{"status": "deleted script"})
 	} else {
 		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
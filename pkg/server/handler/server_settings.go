@@ -32,6 +32,13 @@ func (h *ServerSettingsHandler) UpdateServerSettings(c *gin.Context) {
 		return
 	}
 
+	if s.Progression != nil {
+		if err := s.Progression.Validate(); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid progression: " + err.Error()})
+			return
+		}
+	}
+
 	log.Info("Updating server settings")
 
 	if err := h.Service.Update(&s); err != nil {
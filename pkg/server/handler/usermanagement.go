@@ -4,6 +4,7 @@ import (
 	"net/http"
 
 	"github.com/gin-gonic/gin"
+	"github.com/talesmud/talesmud/pkg/entities"
 	"github.com/talesmud/talesmud/pkg/service"
 )
 
@@ -19,7 +20,11 @@ func (h *UserManagementHandler) GetAllUsers(c *gin.Context) {
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to load users"})
 		return
 	}
-	c.JSON(http.StatusOK, users)
+	result := make([]*entities.User, 0, len(users))
+	for _, user := range users {
+		result = append(result, withoutSecrets(user))
+	}
+	c.JSON(http.StatusOK, result)
 }
 
 // roleRequest is the JSON body for role update requests.
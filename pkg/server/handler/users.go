@@ -14,12 +14,22 @@ type UsersHandler struct {
 	Service service.UsersService
 }
 
+// withoutSecrets returns a copy of the user that can be sent to clients, the telnet password hash is removed
+func withoutSecrets(user *e.User) *e.User {
+	if user == nil {
+		return nil
+	}
+	copied := *user
+	copied.TelnetPasswordHash = ""
+	return &copied
+}
+
 //GetUser returns the user info
 func (handler *UsersHandler) GetUser(c *gin.Context) {
 
 	if userid, ok := c.Get("userid"); ok {
 		if user, err := handler.Service.FindByRefID(userid.(string)); err == nil {
-			c.JSON(http.StatusOK, user)
+			c.JSON(http.StatusOK, withoutSecrets(user))
 			return
 		} else {
 			c.Error(err)
@@ -45,6 +55,16 @@ func (handler *UsersHandler) UpdateUser(c *gin.Context) {
 			return
 		}
 
+		// the telnet password is only set in game, keep the stored hash
+		stored, err := handler.Service.FindByRefID(userid.(string))
+		if err != nil {
+			c.Error(err)
+			return
+		}
+		if stored != nil {
+			user.TelnetPasswordHash = stored.TelnetPasswordHash
+		}
+
 		if err := handler.Service.Update(userid.(string), &user); err == nil {
 			c.JSON(http.StatusOK, "User updated")
 			return
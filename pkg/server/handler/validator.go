@@ -0,0 +1,25 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/talesmud/talesmud/pkg/repository"
+	"github.com/talesmud/talesmud/pkg/validator"
+)
+
+// ValidatorHandler checks the referential integrity of the world
+type ValidatorHandler struct {
+	Repos repository.Factory
+}
+
+// Validate returns the validation report of the stored world, the start room can be set with startRoomId
+func (h *ValidatorHandler) Validate(c *gin.Context) {
+	world, err := validator.Load(h.Repos)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to load world data"})
+		return
+	}
+
+	c.JSON(http.StatusOK, validator.Validate(world, c.Query("startRoomId")))
+}
@@ -62,6 +62,9 @@ type GraphEdge struct {
 	ExitType   string `json:"exitType"`
 	IsHidden   bool   `json:"isHidden"`
 	IsCardinal bool   `json:"isCardinal"`
+	// DoorState is open, closed or locked, empty if the exit has no door
+	DoorState string `json:"doorState,omitempty"`
+	DoorName  string `json:"doorName,omitempty"`
 }
 
 // GraphData represents the complete graph structure
@@ -328,6 +331,10 @@ func (handler *WorldRendererHandler) RenderGraphData(c *gin.Context) {
 					IsHidden:   exit.Hidden,
 					IsCardinal: isCardinalDirection(exit.Name),
 				}
+				if exit.Door != nil {
+					edge.DoorState = string(exit.Door.State())
+					edge.DoorName = exit.Door.DisplayName()
+				}
 				edges = append(edges, edge)
 				edgeID++
 			}
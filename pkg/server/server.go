@@ -4,13 +4,14 @@ import (
 	"fmt"
 	"net/http"
 	"os"
+	"os/signal"
 	"strings"
+	"syscall"
 
 	"github.com/gin-gonic/gin"
 	"github.com/gorilla/handlers"
 	log "github.com/sirupsen/logrus"
 
-	dbsqlite "github.com/talesmud/talesmud/pkg/db/sqlite"
 	mud "github.com/talesmud/talesmud/pkg/mudserver"
 	"github.com/talesmud/talesmud/pkg/repository"
 	"github.com/talesmud/talesmud/pkg/scripts/runner"
@@ -28,21 +29,18 @@ type App interface {
 type app struct {
 	Router *gin.Engine
 	Facade service.Facade
+	repos  repository.Factory
 	mud    mud.MUDServer
 }
 
 // NewApp returns an application instance
 // this is the primary stateless server providing an API interface
 func NewApp() App {
-	path := strings.TrimSpace(os.Getenv("SQLITE_PATH"))
-	if path == "" {
-		path = "talesmud.db"
-	}
-	client, err := dbsqlite.Open(path)
+	dbConfig := repository.ConfigFromEnv()
+	repos, err := repository.Open(dbConfig)
 	if err != nil {
-		log.WithError(err).Fatal("Failed to open SQLite database")
+		log.WithError(err).WithField("database", dbConfig.String()).Fatal("Failed to open database")
 	}
-	repos := repository.NewSQLiteFactory(client)
 
 	r := gin.New()
 	r.Use(gin.Logger())
@@ -56,6 +54,7 @@ func NewApp() App {
 	return &app{
 		Router: r,
 		Facade: facade,
+		repos:  repos,
 		mud:    mud,
 	}
 }
@@ -84,6 +83,7 @@ func (app *app) setupRoutes() {
 	scripts := &handler.ScriptsHandler{
 		app.Facade.ScriptsService(),
 		app.Facade.Runner(),
+		app.mud.GameCtrl(),
 	}
 
 	npcs := &handler.NPCsHandler{
@@ -107,6 +107,19 @@ func (app *app) setupRoutes() {
 		Service: app.Facade.LootTablesService(),
 	}
 
+	questsHandler := &handler.QuestsHandler{
+		Service: app.Facade.QuestsService(),
+	}
+
+	skillsHandler := &handler.SkillsHandler{
+		Service: app.Facade.SkillsService(),
+	}
+
+	channelsHandler := &handler.ChannelsHandler{
+		Service: app.Facade.ChannelsService(),
+		Game:    app.mud.GameCtrl(),
+	}
+
 	backgroundsPath := strings.TrimSpace(os.Getenv("BACKGROUNDS_PATH"))
 	if backgroundsPath == "" {
 		backgroundsPath = "./uploads/backgrounds"
@@ -124,6 +137,13 @@ func (app *app) setupRoutes() {
 		NPCsService:       app.Facade.NPCsService(),
 		DialogsService:    app.Facade.DialogsService(),
 		PartiesService:    app.Facade.PartiesService(),
+		QuestsService:     app.Facade.QuestsService(),
+		SkillsService:     app.Facade.SkillsService(),
+		Game:              app.mud.GameCtrl(),
+	}
+
+	worldValidator := &handler.ValidatorHandler{
+		Repos: app.repos,
 	}
 
 	worldRenderer := &handler.WorldRendererHandler{
@@ -138,6 +158,14 @@ func (app *app) setupRoutes() {
 		Service: app.Facade.UsersService(),
 	}
 
+	merchants := &handler.MerchantsHandler{
+		Game: app.mud.GameCtrl(),
+	}
+
+	auditHandler := &handler.AuditHandler{
+		Service: app.Facade.AuditService(),
+	}
+
 	r.GET("/health", func(c *gin.Context) {
 		c.String(http.StatusOK, "API is up and running")
 	})
@@ -190,6 +218,12 @@ func (app *app) setupRoutes() {
 		protected.GET("character-templates/presets", charTemplates.GetCharacterTemplatePresets)
 		protected.GET("loottables", lootTables.GetLootTables)
 		protected.GET("loottables/:id", lootTables.GetLootTableByID)
+		protected.GET("quests", questsHandler.GetQuests)
+		protected.GET("quests/:id", questsHandler.GetQuestByID)
+		protected.GET("skills", skillsHandler.GetSkills)
+		protected.GET("skills/:id", skillsHandler.GetSkillByID)
+		protected.GET("channels", channelsHandler.GetChannels)
+		protected.GET("channels/:id", channelsHandler.GetChannelByID)
 		protected.GET("backgrounds", backgrounds.ListBackgrounds)
 		protected.GET("settings", serverSettings.GetServerSettings)
 
@@ -246,12 +280,30 @@ func (app *app) setupRoutes() {
 			creator.DELETE("loottables/:id", lootTables.DeleteLootTableByID)
 			creator.POST("loottables/:id/roll", lootTables.RollLootTable)
 
+			// Quests
+			creator.POST("quests", questsHandler.PostQuest)
+			creator.PUT("quests/:id", questsHandler.UpdateQuestByID)
+			creator.DELETE("quests/:id", questsHandler.DeleteQuestByID)
+
+			// Skills
+			creator.POST("skills", skillsHandler.PostSkill)
+			creator.PUT("skills/:id", skillsHandler.UpdateSkillByID)
+			creator.DELETE("skills/:id", skillsHandler.DeleteSkillByID)
+
+			// Chat Channels
+			creator.POST("channels", channelsHandler.PostChannel)
+			creator.PUT("channels/:id", channelsHandler.UpdateChannelByID)
+			creator.DELETE("channels/:id", channelsHandler.DeleteChannelByID)
+
 			// Backgrounds
 			creator.POST("backgrounds/upload", backgrounds.UploadBackground)
 			creator.DELETE("backgrounds/:filename", backgrounds.DeleteBackground)
 
 			// Server Settings
 			creator.PUT("settings", serverSettings.UpdateServerSettings)
+
+			// World validation
+			creator.GET("world/validate", worldValidator.Validate)
 		}
 
 		// Admin-level routes (admin role required)
@@ -263,6 +315,14 @@ func (app *app) setupRoutes() {
 			adminAPI.POST("users/:id/ban", userMgmt.BanUser)
 			adminAPI.POST("users/:id/unban", userMgmt.UnbanUser)
 			adminAPI.DELETE("users/:id", userMgmt.DeleteUser)
+
+			// Live merchant stock
+			adminAPI.GET("merchants", merchants.GetMerchantStock)
+			adminAPI.GET("merchants/:id", merchants.GetMerchantStockByID)
+			adminAPI.POST("merchants/:id/reset", merchants.ResetMerchantStock)
+
+			// Audit log of in-game staff commands
+			adminAPI.GET("audit", auditHandler.GetAuditLog)
 		}
 	}
 
@@ -277,6 +337,8 @@ func (app *app) setupRoutes() {
 		public.GET("item-qualities", items.GetItemQualities)
 		public.GET("item-types", items.GetItemTypes)
 		public.GET("item-subtypes", items.GetItemSubTypes)
+		public.GET("quest-objective-types", questsHandler.GetObjectiveTypes)
+		public.GET("skill-types", skillsHandler.GetSkillTypes)
 
 		public.GET("room-of-the-day", rooms.GetRoomOfTheDay)
 
@@ -313,6 +375,24 @@ func (app *app) Run() {
 
 	app.setupRoutes()
 
+	// optional telnet listener for classic MUD clients
+	if telnetPort := strings.TrimSpace(os.Getenv("TELNET_PORT")); telnetPort != "" {
+		go func() {
+			if err := app.mud.ListenTelnet(fmt.Sprintf("0.0.0.0:%v", telnetPort)); err != nil {
+				log.WithError(err).WithField("TELNET_PORT", telnetPort).Error("Telnet listener failed")
+			}
+		}()
+	}
+
+	// save the live world state when the process is stopped
+	go func() {
+		stop := make(chan os.Signal, 1)
+		signal.Notify(stop, os.Interrupt, syscall.SIGTERM)
+		<-stop
+		app.mud.Shutdown()
+		os.Exit(0)
+	}()
+
 	// read port from env file
 	port := os.Getenv("PORT")
 
@@ -0,0 +1,59 @@
+package service
+
+import (
+	"sort"
+	"time"
+
+	"github.com/talesmud/talesmud/pkg/entities/audit"
+	r "github.com/talesmud/talesmud/pkg/repository"
+)
+
+// AuditService delivers logical functions on top of the audit log repository
+type AuditService interface {
+	r.AuditRepository
+
+	// Record stores an audit entry with the current time
+	Record(entry *audit.Entry) error
+
+	// FindRecent returns the newest entries first, optionally only those of one user (limit 0 = all)
+	FindRecent(userID string, limit int) ([]*audit.Entry, error)
+}
+
+type auditService struct {
+	r.AuditRepository
+}
+
+// NewAuditService creates a new audit service
+func NewAuditService(auditRepo r.AuditRepository) AuditService {
+	return &auditService{
+		AuditRepository: auditRepo,
+	}
+}
+
+// Record implements AuditService.Record
+func (srv *auditService) Record(entry *audit.Entry) error {
+	entry.Time = time.Now()
+	_, err := srv.Store(entry)
+	return err
+}
+
+// FindRecent implements AuditService.FindRecent
+func (srv *auditService) FindRecent(userID string, limit int) ([]*audit.Entry, error) {
+	var entries []*audit.Entry
+	var err error
+	if userID != "" {
+		entries, err = srv.FindAllForUser(userID)
+	} else {
+		entries, err = srv.FindAll()
+	}
+	if err != nil {
+		return nil, err
+	}
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].Time.After(entries[j].Time)
+	})
+	if limit > 0 && len(entries) > limit {
+		entries = entries[:limit]
+	}
+	return entries, nil
+}
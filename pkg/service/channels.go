@@ -0,0 +1,63 @@
+package service
+
+import (
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/talesmud/talesmud/pkg/entities/channels"
+	r "github.com/talesmud/talesmud/pkg/repository"
+)
+
+// ChannelsService delivers logical functions on top of the chat channels repository
+type ChannelsService interface {
+	r.ChannelsRepository
+
+	// FindChannel finds a channel by its case insensitive name, nil if there is none
+	FindChannel(name string) (*channels.Channel, error)
+
+	// EnsureDefaults stores the default channels (ooc, newbie, trade) that don't exist yet
+	EnsureDefaults() error
+}
+
+type channelsService struct {
+	r.ChannelsRepository
+}
+
+// NewChannelsService creates a new chat channels service
+func NewChannelsService(channelsRepo r.ChannelsRepository) ChannelsService {
+	return &channelsService{
+		ChannelsRepository: channelsRepo,
+	}
+}
+
+// FindChannel implements ChannelsService.FindChannel
+func (srv *channelsService) FindChannel(name string) (*channels.Channel, error) {
+	all, err := srv.FindAll()
+	if err != nil {
+		return nil, err
+	}
+	for _, channel := range all {
+		if strings.EqualFold(channel.Name, name) {
+			return channel, nil
+		}
+	}
+	return nil, nil
+}
+
+// EnsureDefaults implements ChannelsService.EnsureDefaults
+func (srv *channelsService) EnsureDefaults() error {
+	for _, channel := range channels.Defaults() {
+		existing, err := srv.FindChannel(channel.Name)
+		if err != nil {
+			return err
+		}
+		if existing != nil {
+			continue
+		}
+		if _, err := srv.Store(channel); err != nil {
+			return err
+		}
+		log.WithField("channel", channel.Name).Info("Created default channel")
+	}
+	return nil
+}
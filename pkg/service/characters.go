@@ -20,6 +20,11 @@ type CharactersService interface {
 	GetCharacterTemplates() []*characters.CharacterTemplate
 
 	CreateNewCharacter(dto *dto.CreateCharacterDTO) (*characters.Character, error)
+
+	// ProgressionCurve returns the XP curve configured in the server settings
+	ProgressionCurve() *characters.ProgressionCurve
+	// GainXP adds XP to the character and applies level-ups, the caller persists the character
+	GainXP(character *characters.Character, amount int32, source string) []characters.LevelUpRecord
 }
 
 //--- Implementations
@@ -27,13 +32,15 @@ type CharactersService interface {
 type charactersService struct {
 	r.CharactersRepository
 	templatesRepo r.CharacterTemplatesRepository
+	settingsRepo  r.ServerSettingsRepository
 }
 
 //NewCharactersService creates a new item service
-func NewCharactersService(charactersRepo r.CharactersRepository, templatesRepo r.CharacterTemplatesRepository) CharactersService {
+func NewCharactersService(charactersRepo r.CharactersRepository, templatesRepo r.CharacterTemplatesRepository, settingsRepo r.ServerSettingsRepository) CharactersService {
 	return &charactersService{
 		CharactersRepository: charactersRepo,
 		templatesRepo:        templatesRepo,
+		settingsRepo:         settingsRepo,
 	}
 }
 func (srv *charactersService) CreateNewCharacter(dto *dto.CreateCharacterDTO) (*characters.Character, error) {
@@ -63,7 +70,7 @@ func (srv *charactersService) CreateNewCharacter(dto *dto.CreateCharacterDTO) (*
 }
 
 func characterFromTemplate(template *characters.CharacterTemplate) *characters.Character {
-	return &characters.Character{
+	character := &characters.Character{
 		Race:             template.Race,
 		Class:            template.Class,
 		CurrentHitPoints: template.CurrentHitPoints,
@@ -72,6 +79,8 @@ func characterFromTemplate(template *characters.CharacterTemplate) *characters.C
 		Level:            template.Level,
 		Attributes:       template.Attributes,
 	}
+	character.InitResources()
+	return character
 }
 
 //IsCharacterNameTaken ...
@@ -95,6 +104,30 @@ func (srv *charactersService) Store(character *characters.Character) (*character
 	return srv.CharactersRepository.Store(character)
 }
 
+//ProgressionCurve ...
+func (srv *charactersService) ProgressionCurve() *characters.ProgressionCurve {
+	serverSettings, err := srv.settingsRepo.Get()
+	if err != nil {
+		log.WithError(err).Warn("Failed to load server settings, using default XP curve")
+		return characters.DefaultProgressionCurve()
+	}
+	return serverSettings.ProgressionCurve()
+}
+
+//GainXP ...
+func (srv *charactersService) GainXP(character *characters.Character, amount int32, source string) []characters.LevelUpRecord {
+	levelUps := character.GainXP(amount, srv.ProgressionCurve(), source)
+	for _, levelUp := range levelUps {
+		log.WithField("character", character.Name).
+			WithField("level", levelUp.Level).
+			WithField("xp", levelUp.XP).
+			WithField("source", source).
+			WithField("seconds", levelUp.SecondsSincePrevious).
+			Info("Character reached a new level")
+	}
+	return levelUps
+}
+
 func (srv *charactersService) GetCharacterTemplates() []*characters.CharacterTemplate {
 	templates, err := srv.templatesRepo.FindAll()
 	if err != nil {
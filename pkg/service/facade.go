@@ -18,7 +18,13 @@ type Facade interface {
 	DialogsService() DialogsService
 	ConversationsService() ConversationsService
 	LootTablesService() LootTablesService
+	QuestsService() QuestsService
+	SkillsService() SkillsService
 	ServerSettingsService() ServerSettingsService
+	WorldSnapshotsService() WorldSnapshotsService
+	ChannelsService() ChannelsService
+	MailService() MailService
+	AuditService() AuditService
 	CharacterTemplatesRepo() repository.CharacterTemplatesRepository
 
 	Runner() scripts.ScriptRunner
@@ -36,7 +42,13 @@ type facade struct {
 	ds    DialogsService
 	convs ConversationsService
 	lts   LootTablesService
+	qs    QuestsService
+	sks   SkillsService
 	sss   ServerSettingsService
+	wss   WorldSnapshotsService
+	chs   ChannelsService
+	ms    MailService
+	aus   AuditService
 	sr    scripts.ScriptRunner
 	repos repository.Factory
 }
@@ -56,15 +68,23 @@ func NewFacade(repos repository.Factory, runner scripts.ScriptRunner) Facade {
 	conversationsRepo := repos.Conversations()
 	characterTemplatesRepo := repos.CharacterTemplates()
 	lootTablesRepo := repos.LootTables()
+	questsRepo := repos.Quests()
+	skillsRepo := repos.Skills()
 	serverSettingsRepo := repos.ServerSettings()
+	worldSnapshotsRepo := repos.WorldSnapshots()
+	channelsRepo := repos.Channels()
+	mailRepo := repos.Mail()
+	auditRepo := repos.Audit()
 
 	// Create services
 	ss := NewScriptsService(scriptsRepo)
 	is := NewItemsService(itemsRepo)
 	lts := NewLootTablesService(lootTablesRepo, is)
+	css := NewCharactersService(charactersRepo, characterTemplatesRepo, serverSettingsRepo)
+	qs := NewQuestsService(questsRepo, is, css)
 
 	return &facade{
-		css:   NewCharactersService(charactersRepo, characterTemplatesRepo),
+		css:   css,
 		ps:    NewPartiesService(partiesRepo),
 		us:    NewUsersService(usersRepo),
 		rs:    NewRoomsService(roomsRepo),
@@ -75,7 +95,13 @@ func NewFacade(repos repository.Factory, runner scripts.ScriptRunner) Facade {
 		ds:    NewDialogsService(dialogsRepo),
 		convs: NewConversationsService(conversationsRepo),
 		lts:   lts,
+		qs:    qs,
+		sks:   NewSkillsService(skillsRepo),
 		sss:   NewServerSettingsService(serverSettingsRepo),
+		wss:   NewWorldSnapshotsService(worldSnapshotsRepo),
+		chs:   NewChannelsService(channelsRepo),
+		ms:    NewMailService(mailRepo),
+		aus:   NewAuditService(auditRepo),
 		sr:    runner,
 		repos: repos,
 	}
@@ -123,10 +149,34 @@ func (f *facade) LootTablesService() LootTablesService {
 	return f.lts
 }
 
+func (f *facade) QuestsService() QuestsService {
+	return f.qs
+}
+
+func (f *facade) SkillsService() SkillsService {
+	return f.sks
+}
+
 func (f *facade) ServerSettingsService() ServerSettingsService {
 	return f.sss
 }
 
+func (f *facade) WorldSnapshotsService() WorldSnapshotsService {
+	return f.wss
+}
+
+func (f *facade) ChannelsService() ChannelsService {
+	return f.chs
+}
+
+func (f *facade) MailService() MailService {
+	return f.ms
+}
+
+func (f *facade) AuditService() AuditService {
+	return f.aus
+}
+
 func (f *facade) CharacterTemplatesRepo() repository.CharacterTemplatesRepository {
 	return f.repos.CharacterTemplates()
 }
@@ -134,6 +134,7 @@ func (srv *itemsService) CreateInstanceFromTemplate(templateID string) (*items.I
 	instance.Locked = template.Locked
 	instance.LockedBy = template.LockedBy
 	instance.MaxItems = template.MaxItems
+	instance.Items = copyContainerItems(template.Items)
 
 	// Copy consumable/stacking fields
 	instance.Consumable = template.Consumable
@@ -162,6 +163,21 @@ func (srv *itemsService) CreateInstanceFromTemplate(templateID string) (*items.I
 	return savedInstance, nil
 }
 
+// copyContainerItems copies the contents of a container template, every copied item gets a new ID
+func copyContainerItems(contents items.Items) items.Items {
+	if len(contents) == 0 {
+		return nil
+	}
+	result := make(items.Items, 0, len(contents))
+	for _, content := range contents {
+		copied := *content
+		copied.Entity = entities.NewEntity()
+		copied.Items = copyContainerItems(content.Items)
+		result = append(result, &copied)
+	}
+	return result
+}
+
 // copyMap creates a shallow copy of a map
 func copyMap(m map[string]interface{}) map[string]interface{} {
 	if m == nil {
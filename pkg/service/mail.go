@@ -0,0 +1,57 @@
+package service
+
+import (
+	"sort"
+
+	"github.com/talesmud/talesmud/pkg/entities/mail"
+	r "github.com/talesmud/talesmud/pkg/repository"
+)
+
+// MailService delivers logical functions on top of the mail repository
+type MailService interface {
+	r.MailRepository
+
+	// FindInbox returns the mail of the character, oldest first
+	FindInbox(characterID string) ([]*mail.Mail, error)
+
+	// CountUnread returns the number of unread mails of the character
+	CountUnread(characterID string) (int, error)
+}
+
+type mailService struct {
+	r.MailRepository
+}
+
+// NewMailService creates a new mail service
+func NewMailService(mailRepo r.MailRepository) MailService {
+	return &mailService{
+		MailRepository: mailRepo,
+	}
+}
+
+// FindInbox implements MailService.FindInbox
+func (srv *mailService) FindInbox(characterID string) ([]*mail.Mail, error) {
+	inbox, err := srv.FindAllForCharacter(characterID)
+	if err != nil {
+		return nil, err
+	}
+	sort.SliceStable(inbox, func(i, j int) bool {
+		return inbox[i].Sent.Before(inbox[j].Sent)
+	})
+	return inbox, nil
+}
+
+// CountUnread implements MailService.CountUnread
+func (srv *mailService) CountUnread(characterID string) (int, error) {
+	inbox, err := srv.FindAllForCharacter(characterID)
+	if err != nil {
+		return 0, err
+	}
+	unread := 0
+	for _, m := range inbox {
+		if !m.Read {
+			unread++
+		}
+	}
+	return unread, nil
+}
@@ -135,6 +135,8 @@ func (srv *npcsService) SpawnFromTemplate(templateID, roomID string) (*npc.NPC,
 		RespawnTime:  template.RespawnTime,
 		WanderRadius: template.WanderRadius,
 		PatrolPath:   template.PatrolPath,
+		PatrolMode:   template.PatrolMode,
+		MoveInterval: template.MoveInterval,
 
 		// Initial state
 		IsDead: false,
@@ -145,9 +147,9 @@ func (srv *npcsService) SpawnFromTemplate(templateID, roomID string) (*npc.NPC,
 		IdleDialogID:      template.IdleDialogID,
 		IdleDialogTimeout: template.IdleDialogTimeout,
 
-		// Copy traits
+		// Copy traits, every instance keeps its own merchant stock
 		EnemyTrait:    template.EnemyTrait,
-		MerchantTrait: template.MerchantTrait,
+		MerchantTrait: template.MerchantTrait.Copy(),
 
 		Created: time.Now(),
 	}
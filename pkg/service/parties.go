@@ -1,6 +1,9 @@
 package service
 
 import (
+	"errors"
+	"time"
+
 	"github.com/talesmud/talesmud/pkg/entities"
 	e "github.com/talesmud/talesmud/pkg/entities"
 	"github.com/talesmud/talesmud/pkg/entities/characters"
@@ -18,6 +21,13 @@ type PartiesService interface {
 	Store(party *e.Party) (*e.Party, error)
 
 	AddCharacterToParty(party *e.Party, character *characters.Character) error
+
+	// FindByCharacterID returns the party the character is a member of, nil if the character has no party
+	FindByCharacterID(characterID string) (*e.Party, error)
+	// FindInvitesFor returns all parties the character has been invited to
+	FindInvitesFor(characterID string) ([]*e.Party, error)
+	// RemoveCharacterFromParty removes the character, parties with less than two members are disbanded
+	RemoveCharacterFromParty(party *e.Party, characterID string) (disbanded bool, err error)
 }
 
 type partiesService struct {
@@ -47,6 +57,10 @@ func (s *partiesService) CreateParty(createParty *CreatePartyDTO) (*e.Party, err
 	var party entities.Party
 	party.Name = createParty.Name
 	party.Characters = createParty.Characters
+	party.Created = time.Now()
+	if len(party.Characters) > 0 {
+		party.LeaderID = party.Characters[0]
+	}
 
 	return s.repo.Store(&party)
 }
@@ -64,5 +78,51 @@ func (s *partiesService) Store(party *e.Party) (*e.Party, error) {
 }
 
 func (s *partiesService) AddCharacterToParty(party *e.Party, character *characters.Character) error {
-	return nil
+	if existing, err := s.FindByCharacterID(character.ID); err != nil {
+		return err
+	} else if existing != nil && existing.ID != party.ID {
+		return errors.New("character is already in another party")
+	}
+
+	party.AddMember(character.ID)
+	if party.LeaderID == "" {
+		party.LeaderID = character.ID
+	}
+	return s.repo.Update(party.ID, party)
+}
+
+func (s *partiesService) FindByCharacterID(characterID string) (*e.Party, error) {
+	parties, err := s.repo.FindAll()
+	if err != nil {
+		return nil, err
+	}
+	for _, party := range parties {
+		if party.HasMember(characterID) {
+			return party, nil
+		}
+	}
+	return nil, nil
+}
+
+func (s *partiesService) FindInvitesFor(characterID string) ([]*e.Party, error) {
+	parties, err := s.repo.FindAll()
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*e.Party, 0)
+	for _, party := range parties {
+		if party.IsInvited(characterID) {
+			result = append(result, party)
+		}
+	}
+	return result, nil
+}
+
+func (s *partiesService) RemoveCharacterFromParty(party *e.Party, characterID string) (bool, error) {
+	party.RemoveMember(characterID)
+
+	if len(party.Characters) < 2 {
+		return true, s.repo.Delete(party.ID)
+	}
+	return false, s.repo.Update(party.ID, party)
 }
@@ -0,0 +1,258 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/talesmud/talesmud/pkg/entities/characters"
+	"github.com/talesmud/talesmud/pkg/entities/items"
+	"github.com/talesmud/talesmud/pkg/entities/quests"
+	r "github.com/talesmud/talesmud/pkg/repository"
+)
+
+// QuestUpdate describes a change of quest progress caused by a player action
+type QuestUpdate struct {
+	Quest     *quests.Quest
+	Objective *quests.Objective
+	Count     int32
+	// Completed is true if the update completed the quest, rewards have been granted
+	Completed bool
+	// RewardItems contains the reward items added to the inventory
+	RewardItems []*items.Item
+	// DroppedItems contains the reward items that didn't fit into the inventory, the caller puts them into the room
+	DroppedItems []*items.Item
+	// LevelUps contains the level-ups caused by the reward XP
+	LevelUps []characters.LevelUpRecord
+}
+
+// QuestsService delivers logical functions on top of the quests repository
+type QuestsService interface {
+	r.QuestsRepository
+
+	// FindByGiver returns all quests offered by one of the given NPC IDs (template or unique NPC)
+	FindByGiver(npcIDs ...string) ([]*quests.Quest, error)
+
+	// CanAccept returns an error describing why the character cannot accept the quest
+	CanAccept(character *characters.Character, quest *quests.Quest) error
+
+	// Accept adds the quest to the character's quest log, the caller persists the character
+	Accept(character *characters.Character, quest *quests.Quest) error
+
+	// Abandon removes an active quest from the character's quest log, the caller persists the character
+	Abandon(character *characters.Character, questID string) error
+
+	// Progress advances all active objectives of the given type that match one of the target IDs
+	// Collect objectives count the carried items instead of the amount
+	// Quests are completed and rewarded automatically, the caller persists the character
+	Progress(character *characters.Character, objectiveType quests.ObjectiveType, amount int32, targetIDs ...string) []*QuestUpdate
+
+	// Complete marks an active quest as completed and grants its rewards
+	// Reward items that don't fit into the inventory are returned as dropped items
+	Complete(character *characters.Character, quest *quests.Quest) (rewardItems []*items.Item, droppedItems []*items.Item, levelUps []characters.LevelUpRecord, err error)
+}
+
+type questsService struct {
+	r.QuestsRepository
+	itemsService      ItemsService
+	charactersService CharactersService
+}
+
+// NewQuestsService creates a new quests service
+func NewQuestsService(questsRepo r.QuestsRepository, itemsService ItemsService, charactersService CharactersService) QuestsService {
+	return &questsService{
+		QuestsRepository:  questsRepo,
+		itemsService:      itemsService,
+		charactersService: charactersService,
+	}
+}
+
+// FindByGiver implements QuestsService.FindByGiver
+func (srv *questsService) FindByGiver(npcIDs ...string) ([]*quests.Quest, error) {
+	all, err := srv.FindAll()
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*quests.Quest, 0)
+	for _, quest := range all {
+		if quest.GiverNPCID != "" && containsID(npcIDs, quest.GiverNPCID) {
+			result = append(result, quest)
+		}
+	}
+	return result, nil
+}
+
+// CanAccept implements QuestsService.CanAccept
+func (srv *questsService) CanAccept(character *characters.Character, quest *quests.Quest) error {
+	if progress := character.QuestLog.Find(quest.ID); progress != nil {
+		switch {
+		case progress.State == quests.QuestStateActive:
+			return errors.New("you are already on this quest")
+		case progress.State == quests.QuestStateCompleted && !quest.Repeatable:
+			return errors.New("you have already completed this quest")
+		}
+	}
+
+	if quest.MinLevel > 0 && character.Level < quest.MinLevel {
+		return fmt.Errorf("you need to be level %d to accept this quest", quest.MinLevel)
+	}
+
+	for _, prerequisite := range quest.PrerequisiteQuestIDs {
+		if !character.QuestLog.HasCompleted(prerequisite) {
+			return errors.New("you are not ready for this quest yet")
+		}
+	}
+
+	return nil
+}
+
+// Accept implements QuestsService.Accept
+func (srv *questsService) Accept(character *characters.Character, quest *quests.Quest) error {
+	if err := srv.CanAccept(character, quest); err != nil {
+		return err
+	}
+
+	// repeatable quests replace their previous progress
+	character.QuestLog = append(character.QuestLog.Remove(quest.ID), quests.NewQuestProgress(quest.ID))
+	return nil
+}
+
+// Abandon implements QuestsService.Abandon
+func (srv *questsService) Abandon(character *characters.Character, questID string) error {
+	progress := character.QuestLog.Find(questID)
+	if progress == nil || progress.State != quests.QuestStateActive {
+		return errors.New("you are not on this quest")
+	}
+
+	character.QuestLog = character.QuestLog.Remove(questID)
+	return nil
+}
+
+// Progress implements QuestsService.Progress
+func (srv *questsService) Progress(character *characters.Character, objectiveType quests.ObjectiveType, amount int32, targetIDs ...string) []*QuestUpdate {
+	updates := make([]*QuestUpdate, 0)
+
+	for _, progress := range character.QuestLog.Active() {
+		quest, err := srv.FindByID(progress.QuestID)
+		if err != nil || quest == nil {
+			continue
+		}
+
+		var last *QuestUpdate
+		for i := range quest.Objectives {
+			objective := &quest.Objectives[i]
+			if objective.Type != objectiveType || !containsID(targetIDs, objective.TargetID) || progress.IsObjectiveDone(objective) {
+				continue
+			}
+
+			count := progress.Objectives[objective.ID] + amount
+			if objective.Type == quests.ObjectiveTypeCollect {
+				count = carriedCount(character, objective.TargetID)
+			}
+			if count > objective.RequiredCount() {
+				count = objective.RequiredCount()
+			}
+			progress.Objectives[objective.ID] = count
+
+			last = &QuestUpdate{
+				Quest:     quest,
+				Objective: objective,
+				Count:     count,
+			}
+			updates = append(updates, last)
+		}
+
+		if last == nil {
+			continue
+		}
+		// items dropped after picking them up no longer count
+		countCollected(character, quest, progress)
+		if progress.IsComplete(quest) {
+			rewardItems, droppedItems, levelUps, err := srv.Complete(character, quest)
+			if err != nil {
+				log.WithError(err).WithField("quest", quest.ID).Error("Failed to complete quest")
+				continue
+			}
+			last.Completed = true
+			last.RewardItems = rewardItems
+			last.DroppedItems = droppedItems
+			last.LevelUps = levelUps
+		}
+	}
+
+	return updates
+}
+
+// Complete implements QuestsService.Complete
+func (srv *questsService) Complete(character *characters.Character, quest *quests.Quest) ([]*items.Item, []*items.Item, []characters.LevelUpRecord, error) {
+	progress := character.QuestLog.Find(quest.ID)
+	if progress == nil || progress.State != quests.QuestStateActive {
+		return nil, nil, nil, errors.New("quest is not active")
+	}
+
+	progress.State = quests.QuestStateCompleted
+	progress.CompletedAt = time.Now()
+
+	levelUps := srv.charactersService.GainXP(character, quest.Rewards.XP, "quest:"+quest.ID)
+	character.Gold += quest.Rewards.Gold
+	character.AllTimeStats.QuestsCompleted++
+
+	rewardItems := make([]*items.Item, 0)
+	droppedItems := make([]*items.Item, 0)
+	for _, templateID := range quest.Rewards.ItemTemplateIDs {
+		item, err := srv.itemsService.CreateInstanceFromTemplate(templateID)
+		if err != nil {
+			log.WithError(err).WithField("template", templateID).Warn("Failed to create quest reward item")
+			continue
+		}
+		if err := character.Inventory.AddItem(item); err != nil {
+			droppedItems = append(droppedItems, item)
+			continue
+		}
+		rewardItems = append(rewardItems, item)
+	}
+
+	return rewardItems, droppedItems, levelUps, nil
+}
+
+// countCollected sets the collect objectives of the quest to the number of items the character carries
+func countCollected(character *characters.Character, quest *quests.Quest, progress *quests.QuestProgress) {
+	for i := range quest.Objectives {
+		objective := &quest.Objectives[i]
+		if objective.Type != quests.ObjectiveTypeCollect {
+			continue
+		}
+		count := carriedCount(character, objective.TargetID)
+		if count > objective.RequiredCount() {
+			count = objective.RequiredCount()
+		}
+		progress.Objectives[objective.ID] = count
+	}
+}
+
+// carriedCount returns how many items of the template (or the unique item) are in the inventory
+func carriedCount(character *characters.Character, targetID string) int32 {
+	count := int32(0)
+	for _, item := range character.Inventory.Items {
+		if item.TemplateID != targetID && item.ID != targetID {
+			continue
+		}
+		if item.Quantity > 1 {
+			count += item.Quantity
+		} else {
+			count++
+		}
+	}
+	return count
+}
+
+func containsID(ids []string, id string) bool {
+	for _, candidate := range ids {
+		if candidate != "" && candidate == id {
+			return true
+		}
+	}
+	return false
+}
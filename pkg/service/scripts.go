@@ -28,6 +28,7 @@ func (service *scriptsService) ScriptTypes() scripts.ScriptTypes {
 		scripts.ScriptTypeRoom,
 		scripts.ScriptTypeQuest,
 		scripts.ScriptTypeNPC,
+		scripts.ScriptTypeEvent,
 	}
 }
 
@@ -0,0 +1,68 @@
+package service
+
+import (
+	"strings"
+
+	"github.com/talesmud/talesmud/pkg/entities/characters"
+	"github.com/talesmud/talesmud/pkg/entities/skills"
+	r "github.com/talesmud/talesmud/pkg/repository"
+)
+
+// SkillsService delivers logical functions on top of the skills repository
+type SkillsService interface {
+	r.SkillsRepository
+
+	// FindAvailable returns all skills the character can use based on class and level
+	FindAvailable(character *characters.Character) ([]*skills.Skill, error)
+
+	// FindAvailableByName finds an available skill by exact or prefix name match
+	FindAvailableByName(character *characters.Character, name string) (*skills.Skill, error)
+}
+
+type skillsService struct {
+	r.SkillsRepository
+}
+
+// NewSkillsService creates a new skills service
+func NewSkillsService(skillsRepo r.SkillsRepository) SkillsService {
+	return &skillsService{
+		SkillsRepository: skillsRepo,
+	}
+}
+
+// FindAvailable implements SkillsService.FindAvailable
+func (srv *skillsService) FindAvailable(character *characters.Character) ([]*skills.Skill, error) {
+	all, err := srv.FindAll()
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*skills.Skill, 0)
+	for _, skill := range all {
+		if skill.IsAvailableTo(character.Class.ID, character.Level) {
+			result = append(result, skill)
+		}
+	}
+	return result, nil
+}
+
+// FindAvailableByName implements SkillsService.FindAvailableByName
+func (srv *skillsService) FindAvailableByName(character *characters.Character, name string) (*skills.Skill, error) {
+	available, err := srv.FindAvailable(character)
+	if err != nil {
+		return nil, err
+	}
+
+	nameLower := strings.ToLower(name)
+	var prefixMatch *skills.Skill
+	for _, skill := range available {
+		skillName := strings.ToLower(skill.Name)
+		if skillName == nameLower {
+			return skill, nil
+		}
+		if prefixMatch == nil && strings.HasPrefix(skillName, nameLower) {
+			prefixMatch = skill
+		}
+	}
+	return prefixMatch, nil
+}
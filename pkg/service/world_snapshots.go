@@ -0,0 +1,40 @@
+package service
+
+import (
+	"time"
+
+	"github.com/talesmud/talesmud/pkg/entities/snapshots"
+	r "github.com/talesmud/talesmud/pkg/repository"
+)
+
+// WorldSnapshotsService stores and loads the live world state between server restarts
+type WorldSnapshotsService interface {
+	// Get returns the last saved snapshot, nil if no snapshot exists
+	Get() (*snapshots.WorldSnapshot, error)
+	// Save replaces the stored snapshot
+	Save(s *snapshots.WorldSnapshot) error
+	// Clear removes the stored snapshot, e.g. after the world data was replaced
+	Clear() error
+}
+
+type worldSnapshotsService struct {
+	repo r.WorldSnapshotsRepository
+}
+
+// NewWorldSnapshotsService creates a new world snapshots service
+func NewWorldSnapshotsService(repo r.WorldSnapshotsRepository) WorldSnapshotsService {
+	return &worldSnapshotsService{repo: repo}
+}
+
+func (srv *worldSnapshotsService) Get() (*snapshots.WorldSnapshot, error) {
+	return srv.repo.Get()
+}
+
+func (srv *worldSnapshotsService) Save(s *snapshots.WorldSnapshot) error {
+	s.SavedAt = time.Now()
+	return srv.repo.Upsert(s)
+}
+
+func (srv *worldSnapshotsService) Clear() error {
+	return srv.repo.Drop()
+}
@@ -0,0 +1,43 @@
+package validator
+
+import (
+	"fmt"
+
+	"github.com/talesmud/talesmud/pkg/repository"
+)
+
+// Load reads the stored world, only item templates are loaded
+func Load(repos repository.Factory) (*World, error) {
+	var err error
+	world := &World{}
+
+	if world.Rooms, err = repos.Rooms().FindAll(); err != nil {
+		return nil, fmt.Errorf("failed to load rooms: %w", err)
+	}
+	if world.Items, err = repos.Items().FindAllTemplates(repository.ItemsQuery{}); err != nil {
+		return nil, fmt.Errorf("failed to load items: %w", err)
+	}
+	if world.NPCs, err = repos.NPCs().FindAll(); err != nil {
+		return nil, fmt.Errorf("failed to load NPCs: %w", err)
+	}
+	if world.Scripts, err = repos.Scripts().FindAll(); err != nil {
+		return nil, fmt.Errorf("failed to load scripts: %w", err)
+	}
+	if world.Dialogs, err = repos.Dialogs().FindAll(); err != nil {
+		return nil, fmt.Errorf("failed to load dialogs: %w", err)
+	}
+	if world.LootTables, err = repos.LootTables().FindAll(); err != nil {
+		return nil, fmt.Errorf("failed to load loot tables: %w", err)
+	}
+	if world.Spawners, err = repos.NPCSpawners().FindAll(); err != nil {
+		return nil, fmt.Errorf("failed to load NPC spawners: %w", err)
+	}
+	if world.CharacterTemplates, err = repos.CharacterTemplates().FindAll(); err != nil {
+		return nil, fmt.Errorf("failed to load character templates: %w", err)
+	}
+	if world.Quests, err = repos.Quests().FindAll(); err != nil {
+		return nil, fmt.Errorf("failed to load quests: %w", err)
+	}
+
+	return world, nil
+}
@@ -0,0 +1,399 @@
+package validator
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/talesmud/talesmud/pkg/entities/characters"
+	"github.com/talesmud/talesmud/pkg/entities/dialogs"
+	"github.com/talesmud/talesmud/pkg/entities/items"
+	npc "github.com/talesmud/talesmud/pkg/entities/npcs"
+	"github.com/talesmud/talesmud/pkg/entities/quests"
+	"github.com/talesmud/talesmud/pkg/entities/rooms"
+	"github.com/talesmud/talesmud/pkg/scripts"
+)
+
+// DefaultStartRoomID is the room new and relocated characters start in
+const DefaultStartRoomID = "R0001"
+
+// Severity of an issue
+type Severity string
+
+const (
+	// SeverityError marks references that break the game at runtime
+	SeverityError Severity = "error"
+	// SeverityWarning marks content players can't get to
+	SeverityWarning Severity = "warning"
+	// SeverityInfo marks things that are often intended but worth a look
+	SeverityInfo Severity = "info"
+)
+
+// Issue codes
+const (
+	CodeDanglingReference = "dangling_reference"
+	CodeMissingStartRoom  = "missing_start_room"
+	CodeUnreachableRoom   = "unreachable_room"
+	CodeOneWayExit        = "one_way_exit"
+	CodeOrphanedTemplate  = "orphaned_template"
+)
+
+// Issue is a single finding of the validator
+type Issue struct {
+	Severity Severity `json:"severity"`
+	Code     string   `json:"code"`
+	Kind     string   `json:"kind"`
+	ID       string   `json:"id"`
+	Name     string   `json:"name,omitempty"`
+	Field    string   `json:"field,omitempty"`
+	Target   string   `json:"target,omitempty"`
+	Message  string   `json:"message"`
+}
+
+// Report contains all issues sorted by severity, kind and ID
+type Report struct {
+	Issues   []Issue `json:"issues"`
+	Errors   int     `json:"errors"`
+	Warnings int     `json:"warnings"`
+	Infos    int     `json:"infos"`
+}
+
+// HasErrors returns true if the report contains at least one error
+func (r *Report) HasErrors() bool {
+	return r.Errors > 0
+}
+
+// World is the content that is validated, items are item templates
+type World struct {
+	Rooms              []*rooms.Room
+	Items              []*items.Item
+	NPCs               []*npc.NPC
+	Scripts            []*scripts.Script
+	Dialogs            []*dialogs.Dialog
+	LootTables         []*items.LootTable
+	Spawners           []*npc.NPCSpawner
+	CharacterTemplates []*characters.CharacterTemplate
+	Quests             []*quests.Quest
+}
+
+// validation holds the lookup tables of one run
+type validation struct {
+	world  *World
+	issues []Issue
+
+	rooms      map[string]*rooms.Room
+	roomIDs    map[string]bool
+	items      map[string]bool
+	npcs       map[string]bool
+	scripts    map[string]bool
+	dialogs    map[string]bool
+	lootTables map[string]bool
+
+	// referenced marks the referenced IDs by kind, to find orphaned templates
+	referenced map[string]map[string]bool
+}
+
+// Validate checks the references between the world entities, reachability from the start room,
+// one-way exits and templates nothing refers to
+func Validate(world *World, startRoomID string) *Report {
+	if startRoomID == "" {
+		startRoomID = DefaultStartRoomID
+	}
+
+	v := &validation{
+		world:      world,
+		rooms:      make(map[string]*rooms.Room),
+		roomIDs:    make(map[string]bool),
+		items:      make(map[string]bool),
+		npcs:       make(map[string]bool),
+		scripts:    make(map[string]bool),
+		dialogs:    make(map[string]bool),
+		lootTables: make(map[string]bool),
+		referenced: map[string]map[string]bool{
+			"item":       make(map[string]bool),
+			"npc":        make(map[string]bool),
+			"dialog":     make(map[string]bool),
+			"loot table": make(map[string]bool),
+		},
+	}
+	for _, r := range world.Rooms {
+		v.rooms[r.ID] = r
+		v.roomIDs[r.ID] = true
+	}
+	for _, i := range world.Items {
+		v.items[i.ID] = true
+	}
+	for _, n := range world.NPCs {
+		v.npcs[n.ID] = true
+	}
+	for _, s := range world.Scripts {
+		v.scripts[s.ID] = true
+	}
+	for _, d := range world.Dialogs {
+		v.dialogs[d.ID] = true
+	}
+	for _, lt := range world.LootTables {
+		v.lootTables[lt.ID] = true
+	}
+
+	v.checkRooms()
+	v.checkNPCs()
+	v.checkLootTables()
+	v.checkSpawners()
+	v.checkCharacterTemplates()
+	v.countQuestReferences()
+	v.checkReachability(startRoomID)
+	v.checkOrphans()
+
+	return newReport(v.issues)
+}
+
+func newReport(issues []Issue) *Report {
+	severityOrder := map[Severity]int{SeverityError: 0, SeverityWarning: 1, SeverityInfo: 2}
+	sort.SliceStable(issues, func(i, j int) bool {
+		a, b := issues[i], issues[j]
+		if a.Severity != b.Severity {
+			return severityOrder[a.Severity] < severityOrder[b.Severity]
+		}
+		if a.Kind != b.Kind {
+			return a.Kind < b.Kind
+		}
+		return a.ID < b.ID
+	})
+
+	report := &Report{Issues: issues}
+	if report.Issues == nil {
+		report.Issues = make([]Issue, 0)
+	}
+	for _, issue := range issues {
+		switch issue.Severity {
+		case SeverityError:
+			report.Errors++
+		case SeverityWarning:
+			report.Warnings++
+		default:
+			report.Infos++
+		}
+	}
+	return report
+}
+
+func (v *validation) add(issue Issue) {
+	v.issues = append(v.issues, issue)
+}
+
+// reference checks a reference from an entity to another one, empty references are optional and skipped
+func (v *validation) reference(kind, id, name, field, targetKind, target string, exists map[string]bool) {
+	if target == "" {
+		return
+	}
+	if refs, ok := v.referenced[targetKind]; ok {
+		refs[target] = true
+	}
+	if exists[target] {
+		return
+	}
+	v.add(Issue{
+		Severity: SeverityError,
+		Code:     CodeDanglingReference,
+		Kind:     kind,
+		ID:       id,
+		Name:     name,
+		Field:    field,
+		Target:   target,
+		Message:  fmt.Sprintf("%s refers to missing %s %s", field, targetKind, target),
+	})
+}
+
+func (v *validation) checkRooms() {
+	for _, room := range v.world.Rooms {
+		v.reference("room", room.ID, room.Name, "onEnterScriptId", "script", room.OnEnterScriptID, v.scripts)
+		if room.Exits == nil {
+			continue
+		}
+		for _, exit := range *room.Exits {
+			field := fmt.Sprintf("exits[%s].target", exit.Name)
+			v.reference("room", room.ID, room.Name, field, "room", exit.Target, v.roomIDs)
+
+			target, ok := v.rooms[exit.Target]
+			if !ok || leadsTo(target, room.ID) {
+				continue
+			}
+			v.add(Issue{
+				Severity: SeverityInfo,
+				Code:     CodeOneWayExit,
+				Kind:     "room",
+				ID:       room.ID,
+				Name:     room.Name,
+				Field:    fmt.Sprintf("exits[%s]", exit.Name),
+				Target:   exit.Target,
+				Message:  fmt.Sprintf("exit %s leads to %s which has no exit back", exit.Name, exit.Target),
+			})
+		}
+	}
+}
+
+// leadsTo returns true if one of the exits of the room has the target
+func leadsTo(room *rooms.Room, target string) bool {
+	if room.Exits == nil {
+		return false
+	}
+	for _, exit := range *room.Exits {
+		if exit.Target == target {
+			return true
+		}
+	}
+	return false
+}
+
+func (v *validation) checkNPCs() {
+	for _, n := range v.world.NPCs {
+		v.reference("npc", n.ID, n.Name, "dialogId", "dialog", n.DialogID, v.dialogs)
+		v.reference("npc", n.ID, n.Name, "idleDialogId", "dialog", n.IdleDialogID, v.dialogs)
+
+		if n.EnemyTrait != nil {
+			v.reference("npc", n.ID, n.Name, "enemyTrait.lootTableId", "loot table", n.EnemyTrait.LootTableID, v.lootTables)
+			for _, itemID := range n.EnemyTrait.GuaranteedLoot {
+				v.reference("npc", n.ID, n.Name, "enemyTrait.guaranteedLoot", "item", itemID, v.items)
+			}
+		}
+		if n.MerchantTrait != nil {
+			for _, item := range n.MerchantTrait.Inventory {
+				v.reference("npc", n.ID, n.Name, "merchantTrait.inventory", "item", item.ItemTemplateID, v.items)
+			}
+		}
+	}
+}
+
+func (v *validation) checkLootTables() {
+	for _, lt := range v.world.LootTables {
+		for _, entry := range lt.Entries {
+			v.reference("loot table", lt.ID, lt.Name, "entries.itemTemplateId", "item", entry.ItemTemplateID, v.items)
+		}
+	}
+}
+
+func (v *validation) checkSpawners() {
+	for _, s := range v.world.Spawners {
+		v.reference("spawner", s.ID, "", "templateId", "npc", s.TemplateID, v.npcs)
+		v.reference("spawner", s.ID, "", "roomId", "room", s.RoomID, v.roomIDs)
+	}
+}
+
+func (v *validation) checkCharacterTemplates() {
+	for _, t := range v.world.CharacterTemplates {
+		for _, item := range t.StartingItems {
+			v.reference("character template", t.ID, t.Name, "startingItems.itemTemplateId", "item", item.ItemTemplateID, v.items)
+		}
+	}
+}
+
+// countQuestReferences marks the NPCs and items quests refer to, quest references are not validated
+func (v *validation) countQuestReferences() {
+	for _, q := range v.world.Quests {
+		if q.GiverNPCID != "" {
+			v.referenced["npc"][q.GiverNPCID] = true
+		}
+		for _, o := range q.Objectives {
+			if o.TargetID != "" {
+				v.referenced["npc"][o.TargetID] = true
+				v.referenced["item"][o.TargetID] = true
+			}
+		}
+		for _, itemID := range q.Rewards.ItemTemplateIDs {
+			v.referenced["item"][itemID] = true
+		}
+	}
+	// door keys refer to item templates as well
+	for _, room := range v.world.Rooms {
+		if room.Exits == nil {
+			continue
+		}
+		for _, exit := range *room.Exits {
+			if exit.Door != nil && exit.Door.KeyID != "" {
+				v.referenced["item"][exit.Door.KeyID] = true
+			}
+		}
+	}
+}
+
+// checkReachability walks the exits from the start room, hidden exits and doors count as passable
+func (v *validation) checkReachability(startRoomID string) {
+	if len(v.rooms) == 0 {
+		return
+	}
+	if _, ok := v.rooms[startRoomID]; !ok {
+		v.add(Issue{
+			Severity: SeverityError,
+			Code:     CodeMissingStartRoom,
+			Kind:     "room",
+			ID:       startRoomID,
+			Message:  fmt.Sprintf("start room %s does not exist, reachability was not checked", startRoomID),
+		})
+		return
+	}
+
+	reached := map[string]bool{startRoomID: true}
+	queue := []string{startRoomID}
+	for len(queue) > 0 {
+		room := v.rooms[queue[0]]
+		queue = queue[1:]
+		if room.Exits == nil {
+			continue
+		}
+		for _, exit := range *room.Exits {
+			if _, ok := v.rooms[exit.Target]; ok && !reached[exit.Target] {
+				reached[exit.Target] = true
+				queue = append(queue, exit.Target)
+			}
+		}
+	}
+
+	for _, room := range v.world.Rooms {
+		if reached[room.ID] {
+			continue
+		}
+		v.add(Issue{
+			Severity: SeverityWarning,
+			Code:     CodeUnreachableRoom,
+			Kind:     "room",
+			ID:       room.ID,
+			Name:     room.Name,
+			Message:  fmt.Sprintf("room can't be reached from the start room %s", startRoomID),
+		})
+	}
+}
+
+// checkOrphans reports templates, dialogs and loot tables nothing refers to
+func (v *validation) checkOrphans() {
+	for _, i := range v.world.Items {
+		if !v.referenced["item"][i.ID] {
+			v.orphan("item", i.ID, i.Name)
+		}
+	}
+	for _, n := range v.world.NPCs {
+		if n.IsTemplate && !v.referenced["npc"][n.ID] {
+			v.orphan("npc", n.ID, n.Name)
+		}
+	}
+	for _, d := range v.world.Dialogs {
+		if !v.referenced["dialog"][d.ID] {
+			v.orphan("dialog", d.ID, d.Name)
+		}
+	}
+	for _, lt := range v.world.LootTables {
+		if !v.referenced["loot table"][lt.ID] {
+			v.orphan("loot table", lt.ID, lt.Name)
+		}
+	}
+}
+
+func (v *validation) orphan(kind, id, name string) {
+	v.add(Issue{
+		Severity: SeverityInfo,
+		Code:     CodeOrphanedTemplate,
+		Kind:     kind,
+		ID:       id,
+		Name:     name,
+		Message:  fmt.Sprintf("%s is not referenced anywhere", kind),
+	})
+}
@@ -149,6 +149,30 @@ function createClient(renderer, characterCreator, muxStore) {
     }
   };
 
+  // Trade message handlers - the trade window is shown in the terminal and as overlay
+  messageHandlers["tradeUpdate"] = (msg) => {
+    renderer(msg.message);
+    if (mux) {
+      mux.setTrade(msg.tradeId, msg.partner, msg.own, msg.their);
+    }
+  };
+
+  messageHandlers["tradeEnd"] = (msg) => {
+    renderer(msg.message);
+    if (mux) {
+      mux.clearTrade();
+    }
+  };
+
+  messageHandlers["levelUp"] = (msg) => {
+    renderer(msg.message);
+    if (currentCharacter) {
+      currentCharacter.level = msg.level;
+      currentCharacter.xp = msg.xp;
+      currentCharacter.maxHitPoints = msg.maxHitPoints;
+    }
+  };
+
   const setWSClient = async (wscl) => {
     ws = wscl;
     wsurl = ws.url;
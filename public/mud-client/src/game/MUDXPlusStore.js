@@ -21,6 +21,13 @@ function createStore() {
     dialogOptions: [],
     dialogConversationID: "",
 
+    // Trade state
+    tradeActive: false,
+    tradeID: "",
+    tradePartner: "",
+    tradeOwn: null,
+    tradeTheir: null,
+
     // Game context flags
     inCombat: false,
     hasItems: false,
@@ -94,6 +101,28 @@ function createStore() {
       });
     },
 
+    // Trade methods
+    setTrade: (tradeID, partner, own, their) => {
+      update((state) => {
+        state.tradeActive = true;
+        state.tradeID = tradeID || "";
+        state.tradePartner = partner || "";
+        state.tradeOwn = own || null;
+        state.tradeTheir = their || null;
+        return state;
+      });
+    },
+    clearTrade: () => {
+      update((state) => {
+        state.tradeActive = false;
+        state.tradeID = "";
+        state.tradePartner = "";
+        state.tradeOwn = null;
+        state.tradeTheir = null;
+        return state;
+      });
+    },
+
     // Inventory methods
     setInventory: (inventory, equippedItems, gold) => {
       update((state) => {